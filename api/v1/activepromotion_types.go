@@ -34,8 +34,10 @@ const (
 	ActivePromotionDeployingComponents       ActivePromotionState = "DeployingStableComponents"
 	ActivePromotionTestingPreActive          ActivePromotionState = "TestingPreActiveEnvironment"
 	ActivePromotionCollectingPreActiveResult ActivePromotionState = "CollectingPreActiveResult"
+	ActivePromotionWaitingForApproval        ActivePromotionState = "WaitingForApproval"
 	ActivePromotionDemoting                  ActivePromotionState = "DemotingActiveEnvironment"
 	ActivePromotionActiveEnvironment         ActivePromotionState = "PromotingActiveEnvironment"
+	ActivePromotionVerifyingActive           ActivePromotionState = "VerifyingActiveEnvironment"
 	ActivePromotionDestroyingPreviousActive  ActivePromotionState = "DestroyingPreviousActiveEnvironment"
 	ActivePromotionDestroyingPreActive       ActivePromotionState = "DestroyingPreActiveEnvironment"
 	ActivePromotionFinished                  ActivePromotionState = "Finished"
@@ -91,6 +93,8 @@ const (
 	ActivePromotionCondVerified ActivePromotionConditionType = "PreActiveVerified"
 	// ActivePromotionCondResultCollected means the result of active promotion has been collected
 	ActivePromotionCondResultCollected ActivePromotionConditionType = "ResultCollected"
+	// ActivePromotionCondApproved means the active promotion has been approved by team owners
+	ActivePromotionCondApproved ActivePromotionConditionType = "Approved"
 	// ActivePromotionCondActiveDemotionStarted means start demoting a previous active namespace
 	ActivePromotionCondActiveDemotionStarted ActivePromotionConditionType = "ActiveDemotionStarted"
 	// ActivePromotionCondActiveDemotionFinished means a previous active environment has been demoted
@@ -99,6 +103,10 @@ const (
 	// ActivePromotionCondActivePromoted means the pre-active namespace has been promoted to be a new active
 	// In case of successful promoting
 	ActivePromotionCondActivePromoted ActivePromotionConditionType = "ActivePromoted"
+	// ActivePromotionCondActiveVerificationStarted means start verifying the new active environment
+	ActivePromotionCondActiveVerificationStarted ActivePromotionConditionType = "ActiveVerificationStarted"
+	// ActivePromotionCondActiveVerified means the new active environment has been verified
+	ActivePromotionCondActiveVerified ActivePromotionConditionType = "ActiveVerified"
 	// ActivePromotionCondPreviousActiveDestroyed means previous active namespace has been destroyed
 	// In case of successful promoting
 	ActivePromotionCondPreviousActiveDestroyed ActivePromotionConditionType = "PreviousActiveDestroyed"
@@ -111,8 +119,51 @@ const (
 
 	// ActivePromotionCondRollbackStarted means the rollback process has been started
 	ActivePromotionCondRollbackStarted ActivePromotionConditionType = "Rollback"
+
+	// ActivePromotionCondOutdatedWarning means the active promotion would leave components outdated
+	// longer than the warning duration
+	ActivePromotionCondOutdatedWarning ActivePromotionConditionType = "OutdatedWarning"
+)
+
+// InPlaceReleaseState represents a state of release upgrading in place
+type InPlaceReleaseState string
+
+const (
+	InPlaceReleasePending    InPlaceReleaseState = "Pending"
+	InPlaceReleaseUpgrading  InPlaceReleaseState = "Upgrading"
+	InPlaceReleaseUpgraded   InPlaceReleaseState = "Upgraded"
+	InPlaceReleaseFailed     InPlaceReleaseState = "Failed"
+	InPlaceReleaseRolledBack InPlaceReleaseState = "RolledBack"
 )
 
+// ActivePromotionInPlace represents releases upgraded in the active namespace
+type ActivePromotionInPlace struct {
+	// Releases represents releases to be upgraded in dependency order
+	// +optional
+	Releases []InPlaceRelease `json:"releases,omitempty"`
+	// PreviousComponents represents stable components of the active namespace before upgrading
+	// +optional
+	PreviousComponents map[string]StableComponent `json:"previousComponents,omitempty"`
+}
+
+// InPlaceRelease represents a release of a parent component upgraded in place
+type InPlaceRelease struct {
+	// Component represents a parent component name
+	Component string `json:"component"`
+	// ReleaseName represents a release name of the component
+	ReleaseName string `json:"releaseName"`
+	// PreviousRevision represents a revision of the release before upgrading,
+	// 0 means the release has not been installed
+	// +optional
+	PreviousRevision int `json:"previousRevision,omitempty"`
+	// State represents a state of the release upgrading
+	// +optional
+	State InPlaceReleaseState `json:"state,omitempty"`
+	// Message represents details of the release upgrading
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // ActivePromotionSpec defines the desired state of ActivePromotion
 type ActivePromotionSpec struct {
 	// TearDownDuration represents duration before tear down the previous active namespace
@@ -130,6 +181,22 @@ type ActivePromotionSpec struct {
 	// PromotedBy represents a person who promoted the ActivePromotion
 	// +optional
 	PromotedBy string `json:"promotedBy,omitempty"`
+
+	// Components represents names of components to be promoted,
+	// the other components are carried over at their current active versions
+	// or promoted from staging if they do not exist in active.
+	// All components are promoted if not defined
+	// +optional
+	Components []string `json:"components,omitempty"`
+
+	// RetriedFrom represents a name of the active promotion history which is retried manually
+	// +optional
+	RetriedFrom string `json:"retriedFrom,omitempty"`
+}
+
+// IsPartialPromotion returns true if only a subset of components is promoted
+func (s *ActivePromotionSpec) IsPartialPromotion() bool {
+	return len(s.Components) > 0
 }
 
 func (s *ActivePromotionSpec) SetTearDownDuration(d metav1.Duration) {
@@ -174,6 +241,13 @@ type ActivePromotionStatus struct {
 	// ActiveComponents represents a list of promoted active components
 	// +optional
 	ActiveComponents map[string]StableComponent `json:"activeComponents,omitempty"`
+	// PromotedComponents represents names of components which have been promoted from staging
+	// in case of promoting a subset of components
+	// +optional
+	PromotedComponents []string `json:"promotedComponents,omitempty"`
+	// InPlace represents releases upgraded in the active namespace in case of in-place promotion
+	// +optional
+	InPlace *ActivePromotionInPlace `json:"inPlace,omitempty"`
 	// OutdatedComponents represents map of outdated components
 	// +optional
 	OutdatedComponents map[string]OutdatedComponent `json:"outdatedComponents,omitempty"`
@@ -186,6 +260,15 @@ type ActivePromotionStatus struct {
 	// PreActiveQueue represents a pre-active queue status
 	// +optional
 	PreActiveQueue QueueStatus `json:"preActiveQueue,omitempty"`
+	// Approval represents a status of approvals before switching the pre-active to be active
+	// +optional
+	Approval *ActivePromotionApproval `json:"approval,omitempty"`
+	// Diff represents differences between the active and pre-active environments before promoting
+	// +optional
+	Diff *ActivePromotionDiff `json:"diff,omitempty"`
+	// CanceledBy represents a person who canceled the active promotion
+	// +optional
+	CanceledBy string `json:"canceledBy,omitempty"`
 
 	// Conditions contains observations of the resource's state e.g.,
 	// Queue deployed, being tested
@@ -195,6 +278,109 @@ type ActivePromotionStatus struct {
 	Conditions []ActivePromotionCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 }
 
+// ActivePromotionDiff represents differences between the active and pre-active environments
+type ActivePromotionDiff struct {
+	// ActiveNamespace represents the active namespace which is compared
+	// +optional
+	ActiveNamespace string `json:"activeNamespace,omitempty"`
+	// PreActiveNamespace represents the pre-active namespace which is compared
+	// +optional
+	PreActiveNamespace string `json:"preActiveNamespace,omitempty"`
+	// Components represents components which versions are different
+	// +optional
+	Components []ComponentDiff `json:"components,omitempty"`
+	// Releases represents releases which chart versions or values are different
+	// +optional
+	Releases []ReleaseDiff `json:"releases,omitempty"`
+	// CreatedAt represents time at which the diff has been created
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// ComponentDiff represents a difference of component between active and pre-active environments
+type ComponentDiff struct {
+	Name string `json:"name"`
+	// +optional
+	ActiveRepository string `json:"activeRepository,omitempty"`
+	// +optional
+	ActiveVersion string `json:"activeVersion,omitempty"`
+	// +optional
+	PreActiveRepository string `json:"preActiveRepository,omitempty"`
+	// +optional
+	PreActiveVersion string `json:"preActiveVersion,omitempty"`
+}
+
+// ReleaseDiff represents a difference of release between active and pre-active environments
+type ReleaseDiff struct {
+	// Component represents a parent component name of the release
+	Component string `json:"component"`
+	// +optional
+	ActiveChartVersion string `json:"activeChartVersion,omitempty"`
+	// +optional
+	PreActiveChartVersion string `json:"preActiveChartVersion,omitempty"`
+	// Values represents rendered values which are different, values are in json format
+	// +optional
+	Values []ValuesDiff `json:"values,omitempty"`
+	// IsValuesTruncated defines whether the values diff has been truncated due to its size
+	// +optional
+	IsValuesTruncated bool `json:"isValuesTruncated,omitempty"`
+}
+
+// ValuesDiff represents a difference of a value between active and pre-active releases
+type ValuesDiff struct {
+	// Path represents a path of the value e.g. "image.tag"
+	Path string `json:"path"`
+	// +optional
+	Active string `json:"active,omitempty"`
+	// +optional
+	PreActive string `json:"preActive,omitempty"`
+}
+
+// ActivePromotionApproval represents a status of approvals of the active promotion
+type ActivePromotionApproval struct {
+	// RequiredApprovals represents a number of approvals required before switching to active
+	RequiredApprovals int `json:"requiredApprovals"`
+	// Approvers represents a list of team owners who have approved the active promotion
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+	// StartedAt represents time at which the active promotion started waiting for approvals
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// ApprovedAt represents time at which the active promotion has been approved
+	// +optional
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+}
+
+// IsApproved returns true if the number of approvers reaches the required approvals
+func (a *ActivePromotionApproval) IsApproved() bool {
+	return len(a.Approvers) >= a.RequiredApprovals
+}
+
+// AddApprover adds the approver, false will be returned if the approver has already approved
+func (a *ActivePromotionApproval) AddApprover(approver string) bool {
+	for _, name := range a.Approvers {
+		if name == approver {
+			return false
+		}
+	}
+
+	a.Approvers = append(a.Approvers, approver)
+	return true
+}
+
+// GetWaitingDuration returns how long the active promotion has been waiting for approvals
+func (a *ActivePromotionApproval) GetWaitingDuration(now time.Time) time.Duration {
+	if a.StartedAt == nil {
+		return 0
+	}
+
+	if a.ApprovedAt != nil {
+		return a.ApprovedAt.Sub(a.StartedAt.Time)
+	}
+
+	return now.Sub(a.StartedAt.Time)
+}
+
 func (s *ActivePromotionStatus) SetNamespace(targetNs, currentActiveNs string) {
 	s.TargetNamespace = targetNs
 	s.PreviousActiveNamespace = currentActiveNs
@@ -238,6 +424,12 @@ func (s *ActivePromotionStatus) SetActiveComponents(comps []StableComponent) {
 	}
 }
 
+// IsInPlacePromotion returns true if releases are upgraded in the active namespace
+// instead of switching to a new namespace
+func (s *ActivePromotionStatus) IsInPlacePromotion() bool {
+	return s.InPlace != nil
+}
+
 func (s *ActivePromotionStatus) GetConditionLatestTime(cond ActivePromotionConditionType) *metav1.Time {
 	for _, c := range s.Conditions {
 		if c.Type == cond {
@@ -0,0 +1,40 @@
+package v1_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Active promotion approval", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should be approved after required approvers approve", func() {
+		approval := s2hv1.ActivePromotionApproval{RequiredApprovals: 2}
+		g.Expect(approval.IsApproved()).To(BeFalse())
+
+		g.Expect(approval.AddApprover("user1")).To(BeTrue())
+		g.Expect(approval.AddApprover("user1")).To(BeFalse(), "same approver should be counted once")
+		g.Expect(approval.IsApproved()).To(BeFalse())
+
+		g.Expect(approval.AddApprover("user2")).To(BeTrue())
+		g.Expect(approval.IsApproved()).To(BeTrue())
+		g.Expect(approval.Approvers).To(Equal([]string{"user1", "user2"}))
+	})
+
+	It("should correctly calculate waiting duration", func() {
+		startedAt := time.Date(2020, 10, 10, 4, 0, 0, 0, time.UTC)
+		approval := s2hv1.ActivePromotionApproval{}
+		g.Expect(approval.GetWaitingDuration(startedAt.Add(time.Hour))).To(Equal(time.Duration(0)))
+
+		approval.StartedAt = &metav1.Time{Time: startedAt}
+		g.Expect(approval.GetWaitingDuration(startedAt.Add(time.Hour))).To(Equal(time.Hour))
+
+		approval.ApprovedAt = &metav1.Time{Time: startedAt.Add(30 * time.Minute)}
+		g.Expect(approval.GetWaitingDuration(startedAt.Add(time.Hour))).To(Equal(30 * time.Minute))
+	})
+})
@@ -41,6 +41,9 @@ type Component struct {
 	Schedules []string `json:"schedules,omitempty"`
 	// +optional
 	Dependencies []*Dependency `json:"dependencies,omitempty"`
+	// DependsOn represents a list of component names which have to be verified before this component
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
 }
 
 // Dependency represents a chart of dependency
@@ -67,14 +70,58 @@ type ComponentImage struct {
 	Tag string `json:"tag,omitempty"`
 	// +optional
 	Pattern string `json:"pattern,omitempty"`
+	// GitRef represents a git branch which the latest commit will be used for generating image tag,
+	// required when source is `git`
+	// +optional
+	GitRef *ComponentGitRef `json:"gitRef,omitempty"`
 }
 
-// ComponentChart represents a chart repository, name and version
+// GitProvider represents a provider of git repository
+type GitProvider string
+
+const (
+	// GitProviderGithub means getting the latest commit via Github REST API
+	GitProviderGithub GitProvider = "github"
+	// GitProviderGitlab means getting the latest commit via Gitlab REST API
+	GitProviderGitlab GitProvider = "gitlab"
+	// GitProviderLocal means getting the latest commit from local bare repository
+	GitProviderLocal GitProvider = "local"
+)
+
+// ComponentGitRef represents a git branch which the latest commit will be used for generating image tag
+type ComponentGitRef struct {
+	// Provider represents a provider of git repository, `github`, `gitlab` or `local`
+	// +kubebuilder:validation:Enum=github;gitlab;local
+	Provider GitProvider `json:"provider"`
+	// Repository represents a git repository, e.g. `agoda-com/samsahai` for github or gitlab
+	// and path of bare repository for local
+	Repository string `json:"repository"`
+	// Branch represents a git branch which the latest commit will be used
+	Branch string `json:"branch"`
+	// URL represents a base url of git server, default is public github or gitlab
+	// +optional
+	URL string `json:"url,omitempty"`
+	// TagTemplate represents a template for generating image tag from the commit,
+	// `{{ .Commit }}`, `{{ .ShortCommit }}` and `{{ .Branch }}` are available, default is `{{ .Commit }}`
+	// +optional
+	TagTemplate string `json:"tagTemplate,omitempty"`
+	// ImageSource represents a source for ensuring the generated image tag exists
+	// +optional
+	ImageSource *UpdatingSource `json:"imageSource,omitempty"`
+}
+
+// ComponentChart represents a chart repository, name, version and pattern which is a regex of version
 type ComponentChart struct {
 	Repository string `json:"repository"`
 	Name       string `json:"name"`
 	// +optional
 	Version string `json:"version,omitempty"`
+	// +optional
+	Pattern string `json:"pattern,omitempty"`
+	// Source represents source for checking desired chart version,
+	// if defined, the chart version will be tracked the same as image version
+	// +optional
+	Source *UpdatingSource `json:"source,omitempty"`
 }
 
 // ConfigBundles represents a group of component for each bundle
@@ -94,6 +141,39 @@ type ConfigStaging struct {
 	// MaxHistoryDays defines maximum days of QueueHistory stored
 	// +optional
 	MaxHistoryDays int `json:"maxHistoryDays,omitempty"`
+
+	// Batch enables verifying multiple waiting component upgrades together,
+	// the failure batch will be bisected for finding the failure components
+	// +optional
+	Batch *ConfigBatch `json:"batch,omitempty"`
+
+	// QueueAgeing enables promoting waiting queues by their waiting time,
+	// so low priority queues are not starved behind priority queues
+	// +optional
+	QueueAgeing *ConfigQueueAgeing `json:"queueAgeing,omitempty"`
+}
+
+// ConfigQueueAgeing represents configuration about promoting waiting queues by their waiting time
+type ConfigQueueAgeing struct {
+	// Interval defines how long a queue has to wait to be moved ahead by one order
+	// +optional
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// MaxWaitTime defines the waiting time SLO of queues,
+	// the queues exceeding the maximum waiting time are moved to the top of queues
+	// +optional
+	MaxWaitTime metav1.Duration `json:"maxWaitTime,omitempty"`
+
+	// Report enables sending a report when a queue exceeds the maximum waiting time
+	// +optional
+	Report bool `json:"report,omitempty"`
+}
+
+// ConfigBatch represents configuration about verifying component upgrades in a batch
+type ConfigBatch struct {
+	// MaxSize defines maximum number of queues which are verified together
+	// +kubebuilder:validation:Minimum=2
+	MaxSize int `json:"maxSize"`
 }
 
 type ConfigDeploy struct {
@@ -130,6 +210,58 @@ type ConfigTestRunner struct {
 	Teamcity *ConfigTeamcity `json:"teamcity,omitempty"`
 	// +optional
 	TestMock *ConfigTestMock `json:"testMock,omitempty"`
+	// Stages defines test stages which are run in order e.g. smoke test then full regression test,
+	// test runners above are used by the stages which do not define their own test runners.
+	// If stages are not defined, all test runners above are run together
+	// +optional
+	Stages []ConfigTestStage `json:"stages,omitempty"`
+}
+
+// ConfigTestStage represents configuration about a test stage
+type ConfigTestStage struct {
+	// Name represents a name of test stage
+	Name string `json:"name"`
+	// Timeout defines maximum duration of the test stage, timeout of test runner is used if not defined
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// +optional
+	PollingTime metav1.Duration `json:"pollingTime,omitempty"`
+	// +optional
+	Gitlab *ConfigGitlab `json:"gitlab,omitempty"`
+	// +optional
+	Teamcity *ConfigTeamcity `json:"teamcity,omitempty"`
+	// +optional
+	TestMock *ConfigTestMock `json:"testMock,omitempty"`
+	// ContinueOnFailure defines whether the next stages are run when this stage fails,
+	// the next stages are skipped by default (fail-fast). The testing result is failed in both cases
+	// +optional
+	ContinueOnFailure bool `json:"continueOnFailure,omitempty"`
+}
+
+// GetStageTestRunner returns test runner configuration of the stage,
+// undefined timeout, polling time and test runners of the stage are taken from this configuration
+func (c *ConfigTestRunner) GetStageTestRunner(stage ConfigTestStage) *ConfigTestRunner {
+	testConfig := &ConfigTestRunner{
+		Timeout:     stage.Timeout,
+		PollingTime: stage.PollingTime,
+		Gitlab:      stage.Gitlab,
+		Teamcity:    stage.Teamcity,
+		TestMock:    stage.TestMock,
+	}
+
+	if testConfig.Timeout.Duration == 0 {
+		testConfig.Timeout = c.Timeout
+	}
+	if testConfig.PollingTime.Duration == 0 {
+		testConfig.PollingTime = c.PollingTime
+	}
+	if stage.Gitlab == nil && stage.Teamcity == nil && stage.TestMock == nil {
+		testConfig.Gitlab = c.Gitlab
+		testConfig.Teamcity = c.Teamcity
+		testConfig.TestMock = c.TestMock
+	}
+
+	return testConfig
 }
 
 // ConfigTeamcity defines a http rest configuration of teamcity
@@ -152,6 +284,11 @@ type ConfigTestMock struct {
 
 // ConfigActivePromotion represents configuration about active promotion
 type ConfigActivePromotion struct {
+	// Strategy defines how the active environment is promoted.
+	// Default is BlueGreen
+	// +optional
+	Strategy ActivePromotionStrategy `json:"strategy,omitempty"`
+
 	// Timeout defines maximum duration for doing active promotion
 	// +optional
 	Timeout metav1.Duration `json:"timeout,omitempty"`
@@ -176,6 +313,13 @@ type ConfigActivePromotion struct {
 	// +optional
 	TearDownDuration metav1.Duration `json:"tearDownDuration,omitempty"`
 
+	// RetainedPreviousActives defines a number of previous active namespaces to be kept for rollback,
+	// the previous active namespace is scaled down to zero replicas instead of being destroyed after TearDownDuration.
+	// Default is 0
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RetainedPreviousActives int `json:"retainedPreviousActives,omitempty"`
+
 	// OutdatedNotification defines a configuration of outdated notification
 	// +optional
 	OutdatedNotification *OutdatedNotification `json:"outdatedNotification,omitempty"`
@@ -183,6 +327,161 @@ type ConfigActivePromotion struct {
 	// Deployment represents configuration about deploy
 	// +optional
 	Deployment *ConfigDeploy `json:"deployment,omitempty"`
+
+	// Schedule defines when the active promotion is created automatically
+	// +optional
+	Schedule *ActivePromotionSchedule `json:"schedule,omitempty"`
+
+	// FreezeWindows represents recurring code-freeze periods that active promotions are not started
+	// +optional
+	FreezeWindows []MaintenanceWindow `json:"freezeWindows,omitempty"`
+
+	// Approval defines approvals required from team owners before switching the pre-active to be active
+	// +optional
+	Approval *ConfigActivePromotionApproval `json:"approval,omitempty"`
+
+	// PostActiveVerification defines how the new active environment is verified after promoting
+	// before the previous active environment is destroyed, the active promotion is rolled back on failure
+	// +optional
+	PostActiveVerification *ConfigPostActiveVerification `json:"postActiveVerification,omitempty"`
+
+	// Gateway defines a fixed namespace containing aliases of the active namespace services,
+	// the aliases are re-pointed to the new active namespace after promoting
+	// +optional
+	Gateway *ConfigActiveGateway `json:"gateway,omitempty"`
+}
+
+// ConfigActiveGateway defines a fixed namespace containing aliases of the active namespace services
+type ConfigActiveGateway struct {
+	// Namespace represents a name of the gateway namespace,
+	// the namespace is created by samsahai and an existing namespace of other owners is rejected
+	Namespace string `json:"namespace"`
+
+	// Ingresses represents ingresses to be created in the gateway namespace backed by the service aliases
+	// +optional
+	Ingresses []ActiveGatewayIngress `json:"ingresses,omitempty"`
+}
+
+// ActiveGatewayIngress represents an ingress rule in the gateway namespace
+type ActiveGatewayIngress struct {
+	// Name represents a name of the ingress
+	Name string `json:"name"`
+
+	// Host represents a host of the ingress rule
+	Host string `json:"host"`
+
+	// Path represents a path of the ingress rule
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// ServiceName represents a service alias name which is a service name in the active namespace
+	// without the release name prefix
+	ServiceName string `json:"serviceName"`
+
+	// ServicePort represents a port of the service
+	ServicePort int32 `json:"servicePort"`
+
+	// Annotations represents annotations of the ingress e.g. ingress class
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ActivePromotionStrategy represents how the active environment is promoted
+// +kubebuilder:validation:Enum=BlueGreen;InPlace
+type ActivePromotionStrategy string
+
+const (
+	// ActivePromotionStrategyBlueGreen deploys components into a new pre-active namespace
+	// and switches it to be the active namespace
+	ActivePromotionStrategyBlueGreen ActivePromotionStrategy = "BlueGreen"
+	// ActivePromotionStrategyInPlace upgrades releases in the current active namespace
+	// component by component following dependsOn of components
+	ActivePromotionStrategyInPlace ActivePromotionStrategy = "InPlace"
+)
+
+// ConfigActivePromotionApproval defines approvals required before switching the pre-active to be active,
+// approvers are identified by their approver tokens in the team credential
+type ConfigActivePromotionApproval struct {
+	// MinApprovals defines a number of team owners required to approve the active promotion.
+	// Default is 1
+	// +optional
+	MinApprovals int `json:"minApprovals,omitempty"`
+
+	// Timeout defines maximum duration for waiting approvals
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// CancelOnTimeout defines whether the active promotion is canceled instead of failed
+	// when the approval has been timeout
+	// +optional
+	CancelOnTimeout bool `json:"cancelOnTimeout,omitempty"`
+}
+
+// ConfigPostActiveVerification defines checks of the new active environment after promoting,
+// all checks are retried until they pass or the verification has been timeout
+type ConfigPostActiveVerification struct {
+	// Timeout defines maximum duration for verifying the new active environment.
+	// Default is 10m
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// InitialDelay defines duration to wait after promoting before starting the checks
+	// +optional
+	InitialDelay metav1.Duration `json:"initialDelay,omitempty"`
+
+	// Readiness defines whether all pods in the new active namespace have to be ready
+	// +optional
+	Readiness bool `json:"readiness,omitempty"`
+
+	// Probes defines http endpoints of the new active environment which have to respond successfully
+	// +optional
+	Probes []PostActiveProbe `json:"probes,omitempty"`
+
+	// Metrics defines prometheus queries whose results have to satisfy the thresholds
+	// +optional
+	Metrics []PostActiveMetric `json:"metrics,omitempty"`
+}
+
+// PostActiveProbe defines a http probe of the new active environment
+type PostActiveProbe struct {
+	// URL represents a http endpoint, supports template e.g. "http://app.{{ .Namespace }}/healthz"
+	URL string `json:"url"`
+
+	// ExpectedStatusCode represents an expected http status code, any 2xx status code is accepted if not defined
+	// +optional
+	ExpectedStatusCode int `json:"expectedStatusCode,omitempty"`
+}
+
+// PostActiveMetric defines a prometheus query checked against the new active environment
+type PostActiveMetric struct {
+	// Name represents a name of the metric check
+	Name string `json:"name"`
+
+	// URL represents a base url of the prometheus server e.g. "http://prometheus.monitoring:9090"
+	URL string `json:"url"`
+
+	// Query represents a prometheus query, supports template e.g. "sum(rate(errors{namespace='{{ .Namespace }}'}[1m]))".
+	// Every returned sample has to satisfy the threshold, an empty result is considered as failure
+	Query string `json:"query"`
+
+	// Operator represents how the query result is compared with the threshold
+	// +kubebuilder:validation:Enum="<";"<=";">";">=";"==";"!="
+	Operator string `json:"operator"`
+
+	// Threshold represents a number which the query result is compared with e.g. "0.05"
+	Threshold string `json:"threshold"`
+}
+
+// ActivePromotionSchedule defines when the active promotion is created automatically,
+// the scheduled run is skipped if stable components are the same as active components
+type ActivePromotionSchedule struct {
+	// Cron represents when the active promotion is created in cron format e.g. "0 2 * * *"
+	Cron string `json:"cron"`
+
+	// TimeZone represents a time zone of the schedule e.g. "Asia/Bangkok".
+	// Default is UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 // OutdatedNotification defines a configuration of outdated notification
@@ -191,6 +490,39 @@ type OutdatedNotification struct {
 	ExceedDuration metav1.Duration `json:"exceedDuration,omitempty"`
 	// +optional
 	ExcludeWeekendCalculation bool `json:"excludeWeekendCalculation,omitempty"`
+
+	// Holidays defines dates in YYYY-MM-DD format which are excluded from outdated duration calculation
+	// +optional
+	Holidays []string `json:"holidays,omitempty"`
+
+	// MaxOutdatedDuration defines a maximum outdated duration of active components,
+	// an active promotion is created automatically when any component exceeds this duration
+	// +optional
+	MaxOutdatedDuration metav1.Duration `json:"maxOutdatedDuration,omitempty"`
+
+	// ForcedPromotionBackoff defines how long the active promotion is not forced again
+	// after the forced active promotion failed. Default is MaxOutdatedDuration
+	// +optional
+	ForcedPromotionBackoff metav1.Duration `json:"forcedPromotionBackoff,omitempty"`
+
+	// WarningDuration defines an outdated duration that a warning is raised
+	// when the active promotion would leave any component outdated longer than this duration
+	// +optional
+	WarningDuration metav1.Duration `json:"warningDuration,omitempty"`
+
+	// Components defines outdated policies per component which override the team policies
+	// +optional
+	Components map[string]OutdatedComponentPolicy `json:"components,omitempty"`
+}
+
+// OutdatedComponentPolicy defines outdated policies of a component
+type OutdatedComponentPolicy struct {
+	// +optional
+	ExceedDuration *metav1.Duration `json:"exceedDuration,omitempty"`
+	// +optional
+	MaxOutdatedDuration *metav1.Duration `json:"maxOutdatedDuration,omitempty"`
+	// +optional
+	WarningDuration *metav1.Duration `json:"warningDuration,omitempty"`
 }
 
 // ConfigReporter represents configuration about sending notification
@@ -241,6 +573,8 @@ const (
 	ConfigUsedUpdated ConfigConditionType = "ConfigUsedUpdated"
 	// ConfigRequiredFieldsValidated means the required fields have been validated
 	ConfigRequiredFieldsValidated ConfigConditionType = "ConfigRequiredFieldsValidated"
+	// ConfigComponentDependenciesValidated means the dependencies between components have been validated
+	ConfigComponentDependenciesValidated ConfigConditionType = "ConfigComponentDependenciesValidated"
 )
 
 // ReporterSlack defines a configuration of slack
@@ -316,6 +650,12 @@ type ReporterRest struct {
 	PullRequestTrigger *RestObject `json:"pullRequestTrigger,omitempty"`
 	// +optional
 	PullRequestQueue *RestObject `json:"pullRequestQueue,omitempty"`
+	// +optional
+	QueueAction *RestObject `json:"queueAction,omitempty"`
+	// +optional
+	QueueWaitSLOBreached *RestObject `json:"queueWaitSLOBreached,omitempty"`
+	// +optional
+	ActivePromotionApproval *RestObject `json:"activePromotionApproval,omitempty"`
 }
 
 type RestObject struct {
@@ -336,6 +676,12 @@ type ReporterShell struct {
 	PullRequestQueue *CommandAndArgs `json:"pullRequestQueue,omitempty"`
 	// +optional
 	ActiveEnvironmentDeleted *CommandAndArgs `json:"activeEnvironmentDeleted,omitempty"`
+	// +optional
+	QueueAction *CommandAndArgs `json:"queueAction,omitempty"`
+	// +optional
+	QueueWaitSLOBreached *CommandAndArgs `json:"queueWaitSLOBreached,omitempty"`
+	// +optional
+	ActivePromotionApproval *CommandAndArgs `json:"activePromotionApproval,omitempty"`
 }
 
 // CommandAndArgs defines commands and args
@@ -524,6 +870,20 @@ type ConfigList struct {
 	Items           []Config `json:"items"`
 }
 
+// GetComponentDependencies returns a map of component name and names of components which it depends on
+func (cs *ConfigSpec) GetComponentDependencies() map[string][]string {
+	deps := make(map[string][]string)
+	for _, comp := range cs.Components {
+		if comp == nil || len(comp.DependsOn) == 0 {
+			continue
+		}
+
+		deps[comp.Name] = comp.DependsOn
+	}
+
+	return deps
+}
+
 func (cs *ConfigStatus) IsConditionTrue(cond ConfigConditionType) bool {
 	for i, c := range cs.Conditions {
 		if c.Type == cond {
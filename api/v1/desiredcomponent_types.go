@@ -26,6 +26,10 @@ type DesiredComponentSpec struct {
 	Version    string `json:"version"`
 	Repository string `json:"repository"`
 
+	// ChartVersion represents desired chart version, empty means using chart version in config
+	// +optional
+	ChartVersion string `json:"chartVersion,omitempty"`
+
 	// +Optional
 	Bundle string `json:"bundle,omitempty"`
 }
@@ -51,6 +55,7 @@ func (c *DesiredComponent) IsSame(d *DesiredComponent) bool {
 	return c.Spec.Name == d.Spec.Name &&
 		c.Spec.Repository == d.Spec.Repository &&
 		c.Spec.Version == d.Spec.Version &&
+		c.Spec.ChartVersion == d.Spec.ChartVersion &&
 		c.Spec.Bundle == d.Spec.Bundle
 }
 
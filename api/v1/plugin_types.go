@@ -0,0 +1,119 @@
+/*
+Copyright 2019 Agoda DevOps Container.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PluginSpec defines the desired state of Plugin
+type PluginSpec struct {
+	// Endpoint represents an url of plugin server,
+	// `http://` or `https://` for HTTP (Twirp) and `grpc://` or `grpcs://` for gRPC
+	Endpoint string `json:"endpoint"`
+
+	// Timeout represents a timeout of each request to plugin server, default is 60s
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// AuthTokenSecretRef represents a secret key of bearer token for authenticating with plugin server,
+	// the secret has to be in the same namespace as Samsahai
+	// +optional
+	AuthTokenSecretRef *corev1.SecretKeySelector `json:"authTokenSecretRef,omitempty"`
+}
+
+// PluginStatus defines the observed state of Plugin
+type PluginStatus struct {
+	// Conditions contains observations of the state
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []PluginCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+type PluginCondition struct {
+	Type   PluginConditionType    `json:"type"`
+	Status corev1.ConditionStatus `json:"status"`
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type PluginConditionType string
+
+const (
+	// PluginRegistered means the plugin has been verified and registered as a checker
+	PluginRegistered PluginConditionType = "PluginRegistered"
+)
+
+func (ps *PluginStatus) IsConditionTrue(cond PluginConditionType) bool {
+	for i, c := range ps.Conditions {
+		if c.Type == cond {
+			return ps.Conditions[i].Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func (ps *PluginStatus) SetCondition(cond PluginConditionType, status corev1.ConditionStatus, message string) {
+	for i, c := range ps.Conditions {
+		if c.Type == cond {
+			ps.Conditions[i].Status = status
+			ps.Conditions[i].LastTransitionTime = metav1.Now()
+			ps.Conditions[i].Message = message
+			return
+		}
+	}
+
+	ps.Conditions = append(ps.Conditions, PluginCondition{
+		Type:               cond,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Message:            message,
+	})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+
+// Plugin is the Schema for the plugins API,
+// the name of Plugin is used as `source` of components
+type Plugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PluginSpec   `json:"spec,omitempty"`
+	Status PluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PluginList contains a list of Plugin
+type PluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Plugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Plugin{}, &PluginList{})
+}
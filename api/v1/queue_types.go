@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"fmt"
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
@@ -120,6 +121,29 @@ type QueueSpec struct {
 	// SkipTestRunner represents a flag for skipping running test
 	// +optional
 	SkipTestRunner bool `json:"skipTestRunner,omitempty"`
+
+	// Pin represents a flag for pinning the component versions as stable after the queue has been verified,
+	// the pinned components will not be upgraded by new versions from checkers until they are unpinned
+	// +optional
+	Pin bool `json:"pin,omitempty"`
+
+	// Batch represents original queues which are verified together in this queue,
+	// a queue containing only one item is a result of bisecting the failure batch
+	// +optional
+	Batch []QueueBatchItem `json:"batch,omitempty"`
+}
+
+// QueueBatchItem represents an original queue which is verified in a batch queue
+type QueueBatchItem struct {
+	// Name represents a Component name or bundle name of original queue
+	Name string `json:"name"`
+
+	// Bundle represents a bundle name of original queue
+	// +optional
+	Bundle string `json:"bundle,omitempty"`
+
+	// Components represents a list of components of original queue
+	Components QueueComponents `json:"components"`
 }
 
 type Image struct {
@@ -138,6 +162,10 @@ type QueueComponent struct {
 
 	// Version represents Docker image tag version
 	Version string `json:"version"`
+
+	// ChartVersion represents Helm chart version, empty means using chart version in config
+	// +optional
+	ChartVersion string `json:"chartVersion,omitempty"`
 }
 
 type QueueCondition struct {
@@ -240,6 +268,8 @@ const (
 	QueueTeamcityTestResult QueueConditionType = "QueueTeamcityTestResult"
 	// QueueGitlabTestResult means the test result of Gitlab
 	QueueGitlabTestResult QueueConditionType = "QueueGitlabTestResult"
+	// QueueTestStageResultPrefix is a prefix of condition type representing the test result of each stage
+	QueueTestStageResultPrefix = "QueueTestStageResult-"
 	// QueueCleaningBeforeStarted means cleaning namespace before running task has been started
 	QueueCleaningBeforeStarted QueueConditionType = "QueueCleaningBeforeStarted"
 	// QueueCleanedBefore means the namespace has been cleaned before running task
@@ -304,6 +334,93 @@ type QueueStatus struct {
 
 	// DeployEngine represents engine using during installation
 	DeployEngine string `json:"deployEngine,omitempty"`
+
+	// StagingNamespace represents the staging slot namespace which this queue has been picked by
+	// +optional
+	StagingNamespace string `json:"stagingNamespace,omitempty"`
+
+	// TestStages represents results of test stages following the order in test runner configuration
+	// +optional
+	TestStages []QueueTestStage `json:"testStages,omitempty"`
+
+	// WaitSLOBreachedAt represents time when the queue has exceeded the maximum waiting time
+	// +optional
+	WaitSLOBreachedAt *metav1.Time `json:"waitSLOBreachedAt,omitempty"`
+}
+
+// TestStageResult represents a result of test stage
+type TestStageResult string
+
+const (
+	TestStagePassed  TestStageResult = "passed"
+	TestStageFailed  TestStageResult = "failed"
+	TestStageTimeout TestStageResult = "timeout"
+	TestStageSkipped TestStageResult = "skipped"
+)
+
+// QueueTestStage represents a status of test stage
+type QueueTestStage struct {
+	// Name represents a name of test stage
+	Name string `json:"name"`
+
+	// Result represents a result of test stage, empty means the stage is being tested
+	// +optional
+	Result TestStageResult `json:"result,omitempty"`
+
+	// Message represents a detail of the result
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartedAt represents the time when the stage has been started
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// TriggeredAt represents the time when test runners of the stage have been triggered
+	// +optional
+	TriggeredAt *metav1.Time `json:"triggeredAt,omitempty"`
+
+	// FinishedAt represents the time when the stage has been finished
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// TestRunner defines the test runner of the stage
+	// +optional
+	TestRunner TestRunner `json:"testRunners,omitempty"`
+}
+
+// IsFinished returns true if the stage has a result
+func (s *QueueTestStage) IsFinished() bool {
+	return s.Result != ""
+}
+
+// IsSuccess returns true if the stage has been passed
+func (s *QueueTestStage) IsSuccess() bool {
+	return s.Result == TestStagePassed
+}
+
+// GetTestStageConditionType returns a condition type of the test stage
+func GetTestStageConditionType(stageName string) QueueConditionType {
+	return QueueConditionType(fmt.Sprintf("%s%s", QueueTestStageResultPrefix, stageName))
+}
+
+// GetCurrentTestStage returns the stage which is being tested, nil will be returned if there is no running stage
+func (qs *QueueStatus) GetCurrentTestStage() *QueueTestStage {
+	if n := len(qs.TestStages); n > 0 && !qs.TestStages[n-1].IsFinished() {
+		return &qs.TestStages[n-1]
+	}
+
+	return nil
+}
+
+// GetFailedTestStage returns the first failed test stage, nil will be returned if there is no failed stage
+func (qs *QueueStatus) GetFailedTestStage() *QueueTestStage {
+	for i := range qs.TestStages {
+		if stage := &qs.TestStages[i]; stage.IsFinished() && !stage.IsSuccess() && stage.Result != TestStageSkipped {
+			return stage
+		}
+	}
+
+	return nil
 }
 
 func (qs *QueueStatus) SetDeploymentIssues(deploymentIssues []DeploymentIssue) {
@@ -323,6 +440,16 @@ func (qs *QueueStatus) IsConditionTrue(cond QueueConditionType) bool {
 	return false
 }
 
+// IsConditionUnknown returns true if the condition exists with unknown status
+func (qs *QueueStatus) IsConditionUnknown(cond QueueConditionType) bool {
+	for i, c := range qs.Conditions {
+		if c.Type == cond {
+			return qs.Conditions[i].Status == corev1.ConditionUnknown
+		}
+	}
+	return false
+}
+
 func (qs *QueueStatus) SetCondition(cond QueueConditionType, status corev1.ConditionStatus, message string) {
 	for i, c := range qs.Conditions {
 		if c.Type == cond {
@@ -370,7 +497,8 @@ func (q *Queue) ContainSameComponent(dName string, dComp *QueueComponent) bool {
 	for _, qComp := range q.Spec.Components {
 		if qComp.Name == dComp.Name &&
 			qComp.Repository == dComp.Repository &&
-			qComp.Version == dComp.Version {
+			qComp.Version == dComp.Version &&
+			qComp.ChartVersion == dComp.ChartVersion {
 			return true
 		}
 	}
@@ -378,6 +506,33 @@ func (q *Queue) ContainSameComponent(dName string, dComp *QueueComponent) bool {
 	return false
 }
 
+// GetStagingNamespace returns namespace where the queue is verified,
+// the queue is verified in its own namespace if it has not been picked by any staging slot
+func (q *Queue) GetStagingNamespace() string {
+	if q.Status.StagingNamespace != "" {
+		return q.Status.StagingNamespace
+	}
+
+	return q.Namespace
+}
+
+// IsConflicted returns true if the queue contains the same component as the given queue
+func (q *Queue) IsConflicted(target *Queue) bool {
+	if q.Name == target.Name {
+		return true
+	}
+
+	for _, qComp := range q.Spec.Components {
+		for _, tComp := range target.Spec.Components {
+			if qComp.Name == tComp.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (q *Queue) SetState(state QueueState) {
 	now := metav1.Now()
 	q.Status.UpdatedAt = &now
@@ -418,6 +573,43 @@ func (q *Queue) IsPullRequestQueue() bool {
 	return q.Spec.Type == QueueTypePullRequest
 }
 
+// IsBatchQueue returns true if the queue verifies multiple original queues together
+func (q *Queue) IsBatchQueue() bool {
+	return len(q.Spec.Batch) > 1
+}
+
+// IsBatchable returns true if the queue can be verified together with other queues in a batch
+func (q *Queue) IsBatchable() bool {
+	return q.Spec.Type == QueueTypeUpgrade && q.Spec.NoOfRetry == 0 && len(q.Spec.Batch) == 0
+}
+
+// SyncBatch removes components which no longer exist in the queue from batch items
+func (q *Queue) SyncBatch() {
+	if len(q.Spec.Batch) == 0 {
+		return
+	}
+
+	items := make([]QueueBatchItem, 0)
+	for _, item := range q.Spec.Batch {
+		comps := make(QueueComponents, 0)
+		for _, comp := range item.Components {
+			for _, qComp := range q.Spec.Components {
+				if qComp.Name == comp.Name && qComp.Version == comp.Version {
+					comps = append(comps, comp)
+					break
+				}
+			}
+		}
+
+		if len(comps) > 0 {
+			item.Components = comps
+			items = append(items, item)
+		}
+	}
+
+	q.Spec.Batch = items
+}
+
 // GetEnvType returns environment type for connection based on Queue.Spec.Type
 func (q *Queue) GetEnvType() string {
 	switch q.Spec.Type {
@@ -501,6 +693,92 @@ func (ql *QueueList) First() *Queue {
 	return &ql.Items[0]
 }
 
+// FirstInSlot returns the processing Queue of staging slot, if any,
+// otherwise returns the first Queue which has not been picked by other slots, does not conflict with
+// the processing Queues of other slots and does not wait for Queues of components which it depends on
+func (ql *QueueList) FirstInSlot(slotNamespace string, dependencies map[string][]string) *Queue {
+	if len(ql.Items) == 0 {
+		return nil
+	}
+
+	ql.Sort()
+
+	// return non-waiting Queue of the slot, if any
+	processing := make([]*Queue, 0)
+	for i, q := range ql.Items {
+		// Queue which has just been picked by other slot is still waiting but will be processed
+		isPickedByOtherSlot := q.Status.StagingNamespace != "" && q.Status.StagingNamespace != slotNamespace
+		if q.Status.State == Waiting && !isPickedByOtherSlot {
+			continue
+		}
+		if q.GetStagingNamespace() == slotNamespace {
+			return &ql.Items[i]
+		}
+
+		processing = append(processing, &ql.Items[i])
+	}
+
+	var first *Queue
+	now := metav1.Now()
+	for i, q := range ql.Items {
+		if q.Status.State != Waiting ||
+			(q.Status.StagingNamespace != "" && q.Status.StagingNamespace != slotNamespace) {
+			continue
+		}
+
+		isConflicted := false
+		for _, pq := range processing {
+			if pq.IsConflicted(&ql.Items[i]) {
+				isConflicted = true
+				break
+			}
+		}
+		if isConflicted || ql.HasPrerequisiteQueue(&ql.Items[i], dependencies) {
+			continue
+		}
+
+		if q.Spec.NextProcessAt == nil || q.Spec.NextProcessAt.Before(&now) {
+			return &ql.Items[i]
+		}
+		if first == nil {
+			first = &ql.Items[i]
+		}
+	}
+
+	return first
+}
+
+// HasPrerequisiteQueue returns true if other Queue contains components which the given Queue depends on,
+// reverify Queues and Queues which are not due yet are not counted as prerequisites
+func (ql *QueueList) HasPrerequisiteQueue(q *Queue, dependencies map[string][]string) bool {
+	if len(dependencies) == 0 {
+		return false
+	}
+
+	now := metav1.Now()
+	for _, qComp := range q.Spec.Components {
+		for _, depName := range dependencies[qComp.Name] {
+			for i := range ql.Items {
+				pq := &ql.Items[i]
+				if pq.Name == q.Name || pq.IsReverify() {
+					continue
+				}
+				if pq.Spec.NextProcessAt != nil && pq.Spec.NextProcessAt.After(now.Time) {
+					continue
+				}
+
+				for _, comp := range pq.Spec.Components {
+					if comp.Name == depName {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
 // Sort sorts queue items
 func (ql *QueueList) Sort() {
 	sort.Sort(QueueByNoOfOrder(ql.Items))
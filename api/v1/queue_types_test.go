@@ -107,3 +107,177 @@ var _ = Describe("Sort by no of order", func() {
 		g.Expect(queueList.Items).To(BeEquivalentTo(expectedQueueList.Items))
 	})
 })
+
+var _ = Describe("First queue in staging slot", func() {
+	g := NewWithT(GinkgoT())
+
+	namespace := "s2h-teamtest"
+	slotNamespace := "s2h-teamtest-slot-1"
+
+	newQueue := func(name string, order int, state s2hv1.QueueState, stagingNs string,
+		compNames ...string) s2hv1.Queue {
+		comps := make([]*s2hv1.QueueComponent, 0)
+		for _, compName := range compNames {
+			comps = append(comps, &s2hv1.QueueComponent{Name: compName})
+		}
+
+		return s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       s2hv1.QueueSpec{Name: name, NoOfOrder: order, Components: comps},
+			Status:     s2hv1.QueueStatus{State: state, StagingNamespace: stagingNs},
+		}
+	}
+
+	It("should return the processing queue of the slot", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				newQueue("comp1", 1, s2hv1.Waiting, "", "comp1"),
+				newQueue("comp2", 2, s2hv1.Testing, slotNamespace, "comp2"),
+			},
+		}
+
+		q := queueList.FirstInSlot(slotNamespace, nil)
+		g.Expect(q).NotTo(BeNil())
+		g.Expect(q.Name).To(Equal("comp2"))
+	})
+
+	It("should skip queues picked by other slots", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				newQueue("comp1", 1, s2hv1.Waiting, namespace, "comp1"),
+				newQueue("comp2", 2, s2hv1.Waiting, "", "comp2"),
+			},
+		}
+
+		q := queueList.FirstInSlot(slotNamespace, nil)
+		g.Expect(q).NotTo(BeNil())
+		g.Expect(q.Name).To(Equal("comp2"))
+	})
+
+	It("should skip queues conflicting with processing queues of other slots", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				newQueue("bundle", 1, s2hv1.Testing, namespace, "comp1", "comp2"),
+				newQueue("comp2", 2, s2hv1.Waiting, "", "comp2"),
+				newQueue("comp3", 3, s2hv1.Waiting, "", "comp3"),
+			},
+		}
+
+		q := queueList.FirstInSlot(slotNamespace, nil)
+		g.Expect(q).NotTo(BeNil())
+		g.Expect(q.Name).To(Equal("comp3"))
+	})
+
+	It("should skip queues conflicting with queues just picked by other slots", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				newQueue("bundle", 1, s2hv1.Waiting, namespace, "comp1", "comp2"),
+				newQueue("comp2", 2, s2hv1.Waiting, "", "comp2"),
+				newQueue("comp3", 3, s2hv1.Waiting, "", "comp3"),
+			},
+		}
+
+		q := queueList.FirstInSlot(slotNamespace, nil)
+		g.Expect(q).NotTo(BeNil())
+		g.Expect(q.Name).To(Equal("comp3"))
+	})
+
+	It("should return nil if all queues are conflicted", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				newQueue("comp1", 1, s2hv1.Testing, namespace, "comp1"),
+				newQueue("comp1-2", 2, s2hv1.Waiting, "", "comp1"),
+			},
+		}
+
+		g.Expect(queueList.FirstInSlot(slotNamespace, nil)).To(BeNil())
+	})
+})
+
+var _ = Describe("Sync batch items", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should remove components which no longer exist in batch queue", func() {
+		comp1 := &s2hv1.QueueComponent{Name: "comp1", Version: "1.0.0"}
+		comp2 := &s2hv1.QueueComponent{Name: "comp2", Version: "1.0.0"}
+		comp3 := &s2hv1.QueueComponent{Name: "comp3", Version: "1.0.0"}
+
+		q := s2hv1.Queue{
+			Spec: s2hv1.QueueSpec{
+				Components: s2hv1.QueueComponents{comp1, comp3},
+				Batch: []s2hv1.QueueBatchItem{
+					{Name: "comp1", Components: s2hv1.QueueComponents{comp1}},
+					{Name: "bundle", Bundle: "bundle", Components: s2hv1.QueueComponents{comp2, comp3}},
+					{Name: "comp2", Components: s2hv1.QueueComponents{comp2}},
+				},
+			},
+		}
+
+		q.SyncBatch()
+		g.Expect(q.Spec.Batch).To(Equal([]s2hv1.QueueBatchItem{
+			{Name: "comp1", Components: s2hv1.QueueComponents{comp1}},
+			{Name: "bundle", Bundle: "bundle", Components: s2hv1.QueueComponents{comp3}},
+		}))
+	})
+})
+
+var _ = Describe("First queue following dependencies", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should wait for queue of component which it depends on", func() {
+		queueList := s2hv1.QueueList{
+			Items: []s2hv1.Queue{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "api"},
+					Spec: s2hv1.QueueSpec{Name: "api", NoOfOrder: 1,
+						Components: s2hv1.QueueComponents{{Name: "api"}}},
+					Status: s2hv1.QueueStatus{State: s2hv1.Waiting},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "migration"},
+					Spec: s2hv1.QueueSpec{Name: "migration", NoOfOrder: 2,
+						Components: s2hv1.QueueComponents{{Name: "migration"}}},
+					Status: s2hv1.QueueStatus{State: s2hv1.Waiting},
+				},
+			},
+		}
+		dependencies := map[string][]string{"api": {"migration"}}
+
+		g.Expect(queueList.HasPrerequisiteQueue(&queueList.Items[0], dependencies)).To(BeTrue())
+		g.Expect(queueList.HasPrerequisiteQueue(&queueList.Items[1], dependencies)).To(BeFalse())
+
+		q := queueList.FirstInSlot("", dependencies)
+		g.Expect(q).NotTo(BeNil())
+		g.Expect(q.Name).To(Equal("migration"))
+	})
+
+	It("should not wait for reverify queue or queue which is not due yet", func() {
+		afterNow := metav1.NewTime(time.Now().Add(time.Hour))
+		newQueueList := func(spec s2hv1.QueueSpec) s2hv1.QueueList {
+			return s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "api"},
+						Spec: s2hv1.QueueSpec{Name: "api", NoOfOrder: 1,
+							Components: s2hv1.QueueComponents{{Name: "api"}}},
+						Status: s2hv1.QueueStatus{State: s2hv1.Waiting},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "migration"},
+						Spec:       spec,
+						Status:     s2hv1.QueueStatus{State: s2hv1.Waiting},
+					},
+				},
+			}
+		}
+		dependencies := map[string][]string{"api": {"migration"}}
+
+		queueList := newQueueList(s2hv1.QueueSpec{Name: "migration", NoOfOrder: 2, NextProcessAt: &afterNow,
+			Components: s2hv1.QueueComponents{{Name: "migration"}}})
+		g.Expect(queueList.HasPrerequisiteQueue(&queueList.Items[0], dependencies)).To(BeFalse())
+
+		queueList = newQueueList(s2hv1.QueueSpec{Name: "migration", NoOfOrder: 2, Type: s2hv1.QueueTypeReverify,
+			Components: s2hv1.QueueComponents{{Name: "migration"}}})
+		g.Expect(queueList.HasPrerequisiteQueue(&queueList.Items[0], dependencies)).To(BeFalse())
+	})
+})
@@ -31,9 +31,18 @@ type StableComponentSpec struct {
 	// Version represents Docker image tag version
 	Version string `json:"version"`
 
+	// ChartVersion represents Helm chart version, empty means using chart version in config
+	// +optional
+	ChartVersion string `json:"chartVersion,omitempty"`
+
 	// UpdatedBy represents a person who updated the StableComponent
 	// +optional
 	UpdatedBy string `json:"updatedBy,omitempty"`
+
+	// Pinned represents whether the version is pinned,
+	// the pinned component will not be upgraded by new versions from checkers until it is unpinned
+	// +optional
+	Pinned bool `json:"pinned,omitempty"`
 }
 
 // StableComponentStatus defines the observed state of StableComponent
@@ -62,6 +71,14 @@ type StableComponentList struct {
 	Items           []StableComponent `json:"items"`
 }
 
+// IsVersionChanged returns true if image repository, image version or chart version
+// differs from the given StableComponent
+func (s *StableComponent) IsVersionChanged(other *StableComponent) bool {
+	return s.Spec.Repository != other.Spec.Repository ||
+		s.Spec.Version != other.Spec.Version ||
+		s.Spec.ChartVersion != other.Spec.ChartVersion
+}
+
 func init() {
 	SchemeBuilder.Register(&StableComponent{}, &StableComponentList{})
 }
@@ -43,6 +43,17 @@ type TeamSpec struct {
 	// +optional
 	StagingCtrl *StagingCtrl `json:"stagingCtrl,omitempty"`
 
+	// StagingSlots represents number of staging namespaces for verifying queues in parallel,
+	// the staging namespace is the first slot and the others are created as `<staging-namespace>-slot-<no>`.
+	// Default is 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	StagingSlots int `json:"stagingSlots,omitempty"`
+
+	// Queue represents configuration about pausing the team queues
+	// +optional
+	Queue *TeamQueue `json:"queue,omitempty"`
+
 	// Credential
 	// +optional
 	Credential Credential `json:"credential,omitempty"`
@@ -59,6 +70,37 @@ type StagingCtrl struct {
 	IsDeploy bool `json:"isDeploy"`
 
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Replicas represents number of the staging controller replicas,
+	// the replicas elect a leader which processes queues if there is more than one replica
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// TeamQueue defines when the team queues are not processed,
+// new queues are not started and active promotions are not started during pausing
+type TeamQueue struct {
+	// Paused represents whether the team queues are paused or not
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// MaintenanceWindows represents recurring periods that the team queues are paused
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow defines a recurring period that the team queues are paused
+type MaintenanceWindow struct {
+	// Schedule represents a starting time of the window in cron format e.g. "0 22 * * 5"
+	Schedule string `json:"schedule"`
+
+	// Duration represents how long the window is e.g. "2h"
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone represents a time zone of the schedule e.g. "Asia/Bangkok".
+	// Default is UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
 }
 
 type Credential struct {
@@ -72,6 +114,11 @@ type Credential struct {
 	// Github
 	// +optional
 	Github *TokenCredential `json:"github,omitempty"`
+
+	// Approvers represents tokens of team owners for approving active promotions,
+	// an approver is identified by the token instead of the shared samsahai auth token
+	// +optional
+	Approvers []ApproverCredential `json:"approvers,omitempty"`
 }
 
 type UsernamePasswordCredential struct {
@@ -86,6 +133,14 @@ type TokenCredential struct {
 	Token    string                    `json:"-"`
 }
 
+// ApproverCredential defines a token of the team owner for approving active promotions
+type ApproverCredential struct {
+	// Owner represents the team owner who is identified by the token
+	Owner    string                    `json:"owner"`
+	TokenRef *corev1.SecretKeySelector `json:"token"`
+	Token    string                    `json:"-"`
+}
+
 // TeamStatus defines the observed state of Team
 type TeamStatus struct {
 	// +optional
@@ -112,6 +167,19 @@ type TeamStatus struct {
 	// +kubebuilder:pruning:PreserveUnknownFields
 	DesiredComponentImageCreatedTime map[string]map[string]DesiredImageTime `json:"desiredComponentImageCreatedTime,omitempty"`
 
+	// ComponentVersionPolling represents last and next scheduled version polling times of components
+	// map[componentName] = polling times
+	// +optional
+	ComponentVersionPolling map[string]ComponentVersionPolling `json:"componentVersionPolling,omitempty"`
+
+	// ActivePromotionSchedule represents last and next scheduled active promotion times
+	// +optional
+	ActivePromotionSchedule *ActivePromotionScheduleStatus `json:"activePromotionSchedule,omitempty"`
+
+	// OutdatedActivePromotion represents active promotions forced by outdated components
+	// +optional
+	OutdatedActivePromotion *OutdatedActivePromotionStatus `json:"outdatedActivePromotion,omitempty"`
+
 	// ActivePromotedBy represents a person who promoted the ActivePromotion
 	// +optional
 	ActivePromotedBy string `json:"activePromotedBy,omitempty"`
@@ -227,6 +295,100 @@ func (ts *TeamStatus) RemoveDesiredComponentImageCreatedTime(compName string) {
 	delete(ts.DesiredComponentImageCreatedTime, compName)
 }
 
+// SetComponentVersionPolling sets scheduled version polling times of the component
+func (ts *TeamStatus) SetComponentVersionPolling(compName string, polling ComponentVersionPolling) {
+	if ts.ComponentVersionPolling == nil {
+		ts.ComponentVersionPolling = make(map[string]ComponentVersionPolling)
+	}
+
+	ts.ComponentVersionPolling[compName] = polling
+}
+
+// RemoveComponentVersionPolling removes scheduled version polling times of the component from team
+func (ts *TeamStatus) RemoveComponentVersionPolling(compName string) {
+	if ts.ComponentVersionPolling == nil {
+		return
+	}
+
+	delete(ts.ComponentVersionPolling, compName)
+}
+
+// ComponentVersionPolling represents scheduled version polling times of a component
+type ComponentVersionPolling struct {
+	// LastPolledAt represents the last time that the component version has been polled
+	// +optional
+	LastPolledAt *metav1.Time `json:"lastPolledAt,omitempty"`
+
+	// NextPollAt represents the next time that the component version will be polled
+	// +optional
+	NextPollAt *metav1.Time `json:"nextPollAt,omitempty"`
+}
+
+// maxActivePromotionSkippedRuns is a maximum number of skipped scheduled runs stored in team status
+const maxActivePromotionSkippedRuns = 10
+
+// ActivePromotionScheduleStatus represents scheduled active promotion times and skipped runs
+type ActivePromotionScheduleStatus struct {
+	// LastScheduledAt represents the last time that the active promotion has been scheduled
+	// +optional
+	LastScheduledAt *metav1.Time `json:"lastScheduledAt,omitempty"`
+
+	// NextScheduleAt represents the next time that the active promotion will be scheduled
+	// +optional
+	NextScheduleAt *metav1.Time `json:"nextScheduleAt,omitempty"`
+
+	// SkippedRuns represents the latest scheduled runs which did not create the active promotion
+	// +optional
+	SkippedRuns []SkippedActivePromotion `json:"skippedRuns,omitempty"`
+}
+
+// AddSkippedRun records the skipped scheduled run, only the latest runs are kept
+func (s *ActivePromotionScheduleStatus) AddSkippedRun(scheduledAt metav1.Time, reason string) {
+	s.SkippedRuns = addSkippedRun(s.SkippedRuns, scheduledAt, reason)
+}
+
+// OutdatedActivePromotionStatus represents active promotions forced by outdated components and skipped runs
+type OutdatedActivePromotionStatus struct {
+	// LastForcedAt represents the last time that the active promotion has been forced
+	// +optional
+	LastForcedAt *metav1.Time `json:"lastForcedAt,omitempty"`
+
+	// SkippedRuns represents the latest forced runs which did not create the active promotion
+	// +optional
+	SkippedRuns []SkippedActivePromotion `json:"skippedRuns,omitempty"`
+}
+
+// AddSkippedRun records the skipped forced run if its reason differs from the latest skipped run
+// since the last forced time, only the latest runs are kept
+func (s *OutdatedActivePromotionStatus) AddSkippedRun(skippedAt metav1.Time, reason string) bool {
+	if n := len(s.SkippedRuns); n > 0 {
+		last := s.SkippedRuns[n-1]
+		if last.Reason == reason && (s.LastForcedAt == nil || !last.ScheduledAt.Before(s.LastForcedAt)) {
+			return false
+		}
+	}
+
+	s.SkippedRuns = addSkippedRun(s.SkippedRuns, skippedAt, reason)
+	return true
+}
+
+func addSkippedRun(runs []SkippedActivePromotion, scheduledAt metav1.Time, reason string) []SkippedActivePromotion {
+	runs = append(runs, SkippedActivePromotion{ScheduledAt: scheduledAt, Reason: reason})
+	if len(runs) > maxActivePromotionSkippedRuns {
+		runs = runs[len(runs)-maxActivePromotionSkippedRuns:]
+	}
+	return runs
+}
+
+// SkippedActivePromotion represents a scheduled run which did not create the active promotion
+type SkippedActivePromotion struct {
+	// ScheduledAt represents the time that the active promotion was scheduled
+	ScheduledAt metav1.Time `json:"scheduledAt"`
+
+	// Reason represents why the active promotion was not created
+	Reason string `json:"reason"`
+}
+
 type DesiredImageTime struct {
 	*Image         `json:"image"`
 	CreatedTime    metav1.Time `json:"createdTime"`
@@ -248,6 +410,15 @@ type TeamNamespace struct {
 
 	// +optional
 	PullRequests []string `json:"pullRequests,omitempty"`
+
+	// StagingSlots represents additional staging namespaces for verifying queues in parallel
+	// +optional
+	StagingSlots []string `json:"stagingSlots,omitempty"`
+
+	// RetainedActives represents previous active namespaces which are scaled down for instant rollback,
+	// the latest one comes first
+	// +optional
+	RetainedActives []string `json:"retainedActives,omitempty"`
 }
 
 type TeamCondition struct {
@@ -269,10 +440,13 @@ const (
 	TeamNamespacePreviousActiveCreated      TeamConditionType = "TeamNamespacePreviousActiveCreated"
 	TeamNamespaceActiveCreated              TeamConditionType = "TeamNamespaceActiveCreated"
 	TeamNamespacePullRequestCreated         TeamConditionType = "TeamNamespacePullRequestCreated"
+	TeamNamespaceStagingSlotCreated         TeamConditionType = "TeamNamespaceStagingSlotCreated"
+	TeamNamespaceRetainedActiveCreated      TeamConditionType = "TeamNamespaceRetainedActiveCreated"
 	TeamConfigExisted                       TeamConditionType = "TeamConfigExisted"
 	TeamPostStagingNamespaceCreationRun     TeamConditionType = "TeamPostStagingNamespaceCreationRun"
 	TeamPostPreActiveNamespaceCreationRun   TeamConditionType = "TeamPostPreActiveNamespaceCreationRun"
 	TeamPostPullRequestNamespaceCreationRun TeamConditionType = "TeamPostPullRequestNamespaceCreationRun"
+	TeamPostStagingSlotNamespaceCreationRun TeamConditionType = "TeamPostStagingSlotNamespaceCreationRun"
 	TeamFirstNotifyComponentChanged         TeamConditionType = "TeamFirstNotifyComponentChanged"
 	TeamFirstActivePromotionRun             TeamConditionType = "TeamFirstActivePromotionRun"
 	TeamUsedUpdated                         TeamConditionType = "TeamUsedUpdated"
@@ -26,6 +26,28 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActiveGatewayIngress) DeepCopyInto(out *ActiveGatewayIngress) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActiveGatewayIngress.
+func (in *ActiveGatewayIngress) DeepCopy() *ActiveGatewayIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveGatewayIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotion) DeepCopyInto(out *ActivePromotion) {
 	*out = *in
@@ -53,6 +75,34 @@ func (in *ActivePromotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionApproval) DeepCopyInto(out *ActivePromotionApproval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionApproval.
+func (in *ActivePromotionApproval) DeepCopy() *ActivePromotionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotionCondition) DeepCopyInto(out *ActivePromotionCondition) {
 	*out = *in
@@ -69,6 +119,37 @@ func (in *ActivePromotionCondition) DeepCopy() *ActivePromotionCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionDiff) DeepCopyInto(out *ActivePromotionDiff) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]ComponentDiff, len(*in))
+		copy(*out, *in)
+	}
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]ReleaseDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionDiff.
+func (in *ActivePromotionDiff) DeepCopy() *ActivePromotionDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotionHistory) DeepCopyInto(out *ActivePromotionHistory) {
 	*out = *in
@@ -188,6 +269,33 @@ func (in *ActivePromotionHistoryStatus) DeepCopy() *ActivePromotionHistoryStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionInPlace) DeepCopyInto(out *ActivePromotionInPlace) {
+	*out = *in
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]InPlaceRelease, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreviousComponents != nil {
+		in, out := &in.PreviousComponents, &out.PreviousComponents
+		*out = make(map[string]StableComponent, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionInPlace.
+func (in *ActivePromotionInPlace) DeepCopy() *ActivePromotionInPlace {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionInPlace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotionList) DeepCopyInto(out *ActivePromotionList) {
 	*out = *in
@@ -220,6 +328,51 @@ func (in *ActivePromotionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionSchedule) DeepCopyInto(out *ActivePromotionSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionSchedule.
+func (in *ActivePromotionSchedule) DeepCopy() *ActivePromotionSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePromotionScheduleStatus) DeepCopyInto(out *ActivePromotionScheduleStatus) {
+	*out = *in
+	if in.LastScheduledAt != nil {
+		in, out := &in.LastScheduledAt, &out.LastScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleAt != nil {
+		in, out := &in.NextScheduleAt, &out.NextScheduleAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SkippedRuns != nil {
+		in, out := &in.SkippedRuns, &out.SkippedRuns
+		*out = make([]SkippedActivePromotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionScheduleStatus.
+func (in *ActivePromotionScheduleStatus) DeepCopy() *ActivePromotionScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePromotionScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActivePromotionSpec) DeepCopyInto(out *ActivePromotionSpec) {
 	*out = *in
@@ -228,6 +381,11 @@ func (in *ActivePromotionSpec) DeepCopyInto(out *ActivePromotionSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePromotionSpec.
@@ -262,6 +420,16 @@ func (in *ActivePromotionStatus) DeepCopyInto(out *ActivePromotionStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PromotedComponents != nil {
+		in, out := &in.PromotedComponents, &out.PromotedComponents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InPlace != nil {
+		in, out := &in.InPlace, &out.InPlace
+		*out = new(ActivePromotionInPlace)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OutdatedComponents != nil {
 		in, out := &in.OutdatedComponents, &out.OutdatedComponents
 		*out = make(map[string]OutdatedComponent, len(*in))
@@ -270,6 +438,16 @@ func (in *ActivePromotionStatus) DeepCopyInto(out *ActivePromotionStatus) {
 		}
 	}
 	in.PreActiveQueue.DeepCopyInto(&out.PreActiveQueue)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ActivePromotionApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(ActivePromotionDiff)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]ActivePromotionCondition, len(*in))
@@ -289,6 +467,26 @@ func (in *ActivePromotionStatus) DeepCopy() *ActivePromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApproverCredential) DeepCopyInto(out *ApproverCredential) {
+	*out = *in
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApproverCredential.
+func (in *ApproverCredential) DeepCopy() *ApproverCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(ApproverCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ChartValuesURLs) DeepCopyInto(out *ChartValuesURLs) {
 	{
@@ -346,8 +544,8 @@ func (in *CommandAndArgs) DeepCopy() *CommandAndArgs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Component) DeepCopyInto(out *Component) {
 	*out = *in
-	out.Chart = in.Chart
-	out.Image = in.Image
+	in.Chart.DeepCopyInto(&out.Chart)
+	in.Image.DeepCopyInto(&out.Image)
 	in.Values.DeepCopyInto(&out.Values)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
@@ -370,6 +568,11 @@ func (in *Component) DeepCopyInto(out *Component) {
 			}
 		}
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Component.
@@ -410,6 +613,11 @@ func (in ComponentByName) DeepCopy() ComponentByName {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentChart) DeepCopyInto(out *ComponentChart) {
 	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(UpdatingSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentChart.
@@ -422,9 +630,49 @@ func (in *ComponentChart) DeepCopy() *ComponentChart {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentDiff) DeepCopyInto(out *ComponentDiff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentDiff.
+func (in *ComponentDiff) DeepCopy() *ComponentDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentGitRef) DeepCopyInto(out *ComponentGitRef) {
+	*out = *in
+	if in.ImageSource != nil {
+		in, out := &in.ImageSource, &out.ImageSource
+		*out = new(UpdatingSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentGitRef.
+func (in *ComponentGitRef) DeepCopy() *ComponentGitRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentGitRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentImage) DeepCopyInto(out *ComponentImage) {
 	*out = *in
+	if in.GitRef != nil {
+		in, out := &in.GitRef, &out.GitRef
+		*out = new(ComponentGitRef)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentImage.
@@ -437,6 +685,29 @@ func (in *ComponentImage) DeepCopy() *ComponentImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionPolling) DeepCopyInto(out *ComponentVersionPolling) {
+	*out = *in
+	if in.LastPolledAt != nil {
+		in, out := &in.LastPolledAt, &out.LastPolledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextPollAt != nil {
+		in, out := &in.NextPollAt, &out.NextPollAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionPolling.
+func (in *ComponentVersionPolling) DeepCopy() *ComponentVersionPolling {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionPolling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -464,6 +735,28 @@ func (in *Config) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigActiveGateway) DeepCopyInto(out *ConfigActiveGateway) {
+	*out = *in
+	if in.Ingresses != nil {
+		in, out := &in.Ingresses, &out.Ingresses
+		*out = make([]ActiveGatewayIngress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigActiveGateway.
+func (in *ConfigActiveGateway) DeepCopy() *ConfigActiveGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigActiveGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigActivePromotion) DeepCopyInto(out *ConfigActivePromotion) {
 	*out = *in
@@ -479,13 +772,38 @@ func (in *ConfigActivePromotion) DeepCopyInto(out *ConfigActivePromotion) {
 	if in.OutdatedNotification != nil {
 		in, out := &in.OutdatedNotification, &out.OutdatedNotification
 		*out = new(OutdatedNotification)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Deployment != nil {
 		in, out := &in.Deployment, &out.Deployment
 		*out = new(ConfigDeploy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ActivePromotionSchedule)
+		**out = **in
+	}
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ConfigActivePromotionApproval)
+		**out = **in
+	}
+	if in.PostActiveVerification != nil {
+		in, out := &in.PostActiveVerification, &out.PostActiveVerification
+		*out = new(ConfigPostActiveVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(ConfigActiveGateway)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigActivePromotion.
@@ -498,6 +816,37 @@ func (in *ConfigActivePromotion) DeepCopy() *ConfigActivePromotion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigActivePromotionApproval) DeepCopyInto(out *ConfigActivePromotionApproval) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigActivePromotionApproval.
+func (in *ConfigActivePromotionApproval) DeepCopy() *ConfigActivePromotionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigActivePromotionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigBatch) DeepCopyInto(out *ConfigBatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBatch.
+func (in *ConfigBatch) DeepCopy() *ConfigBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ConfigBundles) DeepCopyInto(out *ConfigBundles) {
 	{
@@ -632,6 +981,33 @@ func (in *ConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigPostActiveVerification) DeepCopyInto(out *ConfigPostActiveVerification) {
+	*out = *in
+	out.Timeout = in.Timeout
+	out.InitialDelay = in.InitialDelay
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]PostActiveProbe, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]PostActiveMetric, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigPostActiveVerification.
+func (in *ConfigPostActiveVerification) DeepCopy() *ConfigPostActiveVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigPostActiveVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigPullRequest) DeepCopyInto(out *ConfigPullRequest) {
 	*out = *in
@@ -690,6 +1066,23 @@ func (in *ConfigPullRequestTriggerReport) DeepCopy() *ConfigPullRequestTriggerRe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigQueueAgeing) DeepCopyInto(out *ConfigQueueAgeing) {
+	*out = *in
+	out.Interval = in.Interval
+	out.MaxWaitTime = in.MaxWaitTime
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigQueueAgeing.
+func (in *ConfigQueueAgeing) DeepCopy() *ConfigQueueAgeing {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigQueueAgeing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigReporter) DeepCopyInto(out *ConfigReporter) {
 	*out = *in
@@ -834,6 +1227,16 @@ func (in *ConfigStaging) DeepCopyInto(out *ConfigStaging) {
 		*out = new(ConfigDeploy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(ConfigBatch)
+		**out = **in
+	}
+	if in.QueueAgeing != nil {
+		in, out := &in.QueueAgeing, &out.QueueAgeing
+		*out = new(ConfigQueueAgeing)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigStaging.
@@ -919,6 +1322,13 @@ func (in *ConfigTestRunner) DeepCopyInto(out *ConfigTestRunner) {
 		*out = new(ConfigTestMock)
 		**out = **in
 	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]ConfigTestStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestRunner.
@@ -931,6 +1341,38 @@ func (in *ConfigTestRunner) DeepCopy() *ConfigTestRunner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigTestStage) DeepCopyInto(out *ConfigTestStage) {
+	*out = *in
+	out.Timeout = in.Timeout
+	out.PollingTime = in.PollingTime
+	if in.Gitlab != nil {
+		in, out := &in.Gitlab, &out.Gitlab
+		*out = new(ConfigGitlab)
+		**out = **in
+	}
+	if in.Teamcity != nil {
+		in, out := &in.Teamcity, &out.Teamcity
+		*out = new(ConfigTeamcity)
+		**out = **in
+	}
+	if in.TestMock != nil {
+		in, out := &in.TestMock, &out.TestMock
+		*out = new(ConfigTestMock)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigTestStage.
+func (in *ConfigTestStage) DeepCopy() *ConfigTestStage {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigTestStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Credential) DeepCopyInto(out *Credential) {
 	*out = *in
@@ -944,6 +1386,13 @@ func (in *Credential) DeepCopyInto(out *Credential) {
 		*out = new(TokenCredential)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]ApproverCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
@@ -959,8 +1408,8 @@ func (in *Credential) DeepCopy() *Credential {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dependency) DeepCopyInto(out *Dependency) {
 	*out = *in
-	out.Chart = in.Chart
-	out.Image = in.Image
+	in.Chart.DeepCopyInto(&out.Chart)
+	in.Image.DeepCopyInto(&out.Image)
 	in.Values.DeepCopyInto(&out.Values)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
@@ -1079,166 +1528,420 @@ func (in *DesiredComponentSpec) DeepCopy() *DesiredComponentSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DesiredComponentStatus) DeepCopyInto(out *DesiredComponentStatus) {
+func (in *DesiredComponentStatus) DeepCopyInto(out *DesiredComponentStatus) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentStatus.
+func (in *DesiredComponentStatus) DeepCopy() *DesiredComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesiredComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesiredImageTime) DeepCopyInto(out *DesiredImageTime) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(Image)
+		**out = **in
+	}
+	in.CreatedTime.DeepCopyInto(&out.CreatedTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredImageTime.
+func (in *DesiredImageTime) DeepCopy() *DesiredImageTime {
+	if in == nil {
+		return nil
+	}
+	out := new(DesiredImageTime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
+func (in *Endpoint) DeepCopy() *Endpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(Endpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureComponent) DeepCopyInto(out *FailureComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureComponent.
+func (in *FailureComponent) DeepCopy() *FailureComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gitlab) DeepCopyInto(out *Gitlab) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gitlab.
+func (in *Gitlab) DeepCopy() *Gitlab {
+	if in == nil {
+		return nil
+	}
+	out := new(Gitlab)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InPlaceRelease) DeepCopyInto(out *InPlaceRelease) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceRelease.
+func (in *InPlaceRelease) DeepCopy() *InPlaceRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(InPlaceRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSTeamsGroup) DeepCopyInto(out *MSTeamsGroup) {
+	*out = *in
+	if in.ChannelNameOrIDs != nil {
+		in, out := &in.ChannelNameOrIDs, &out.ChannelNameOrIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsGroup.
+func (in *MSTeamsGroup) DeepCopy() *MSTeamsGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(MSTeamsGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutdatedActivePromotionStatus) DeepCopyInto(out *OutdatedActivePromotionStatus) {
+	*out = *in
+	if in.LastForcedAt != nil {
+		in, out := &in.LastForcedAt, &out.LastForcedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SkippedRuns != nil {
+		in, out := &in.SkippedRuns, &out.SkippedRuns
+		*out = make([]SkippedActivePromotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedActivePromotionStatus.
+func (in *OutdatedActivePromotionStatus) DeepCopy() *OutdatedActivePromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OutdatedActivePromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutdatedComponent) DeepCopyInto(out *OutdatedComponent) {
+	*out = *in
+	if in.CurrentImage != nil {
+		in, out := &in.CurrentImage, &out.CurrentImage
+		*out = new(Image)
+		**out = **in
+	}
+	if in.DesiredImage != nil {
+		in, out := &in.DesiredImage, &out.DesiredImage
+		*out = new(Image)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedComponent.
+func (in *OutdatedComponent) DeepCopy() *OutdatedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(OutdatedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutdatedComponentPolicy) DeepCopyInto(out *OutdatedComponentPolicy) {
 	*out = *in
-	if in.CreatedAt != nil {
-		in, out := &in.CreatedAt, &out.CreatedAt
-		*out = (*in).DeepCopy()
+	if in.ExceedDuration != nil {
+		in, out := &in.ExceedDuration, &out.ExceedDuration
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.UpdatedAt != nil {
-		in, out := &in.UpdatedAt, &out.UpdatedAt
-		*out = (*in).DeepCopy()
+	if in.MaxOutdatedDuration != nil {
+		in, out := &in.MaxOutdatedDuration, &out.MaxOutdatedDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WarningDuration != nil {
+		in, out := &in.WarningDuration, &out.WarningDuration
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredComponentStatus.
-func (in *DesiredComponentStatus) DeepCopy() *DesiredComponentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedComponentPolicy.
+func (in *OutdatedComponentPolicy) DeepCopy() *OutdatedComponentPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(DesiredComponentStatus)
+	out := new(OutdatedComponentPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DesiredImageTime) DeepCopyInto(out *DesiredImageTime) {
+func (in *OutdatedNotification) DeepCopyInto(out *OutdatedNotification) {
 	*out = *in
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(Image)
-		**out = **in
+	out.ExceedDuration = in.ExceedDuration
+	if in.Holidays != nil {
+		in, out := &in.Holidays, &out.Holidays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.MaxOutdatedDuration = in.MaxOutdatedDuration
+	out.ForcedPromotionBackoff = in.ForcedPromotionBackoff
+	out.WarningDuration = in.WarningDuration
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(map[string]OutdatedComponentPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
-	in.CreatedTime.DeepCopyInto(&out.CreatedTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesiredImageTime.
-func (in *DesiredImageTime) DeepCopy() *DesiredImageTime {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedNotification.
+func (in *OutdatedNotification) DeepCopy() *OutdatedNotification {
 	if in == nil {
 		return nil
 	}
-	out := new(DesiredImageTime)
+	out := new(OutdatedNotification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Endpoint) DeepCopyInto(out *Endpoint) {
+func (in *Plugin) DeepCopyInto(out *Plugin) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Endpoint.
-func (in *Endpoint) DeepCopy() *Endpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Plugin.
+func (in *Plugin) DeepCopy() *Plugin {
 	if in == nil {
 		return nil
 	}
-	out := new(Endpoint)
+	out := new(Plugin)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Plugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FailureComponent) DeepCopyInto(out *FailureComponent) {
+func (in *PluginCondition) DeepCopyInto(out *PluginCondition) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureComponent.
-func (in *FailureComponent) DeepCopy() *FailureComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginCondition.
+func (in *PluginCondition) DeepCopy() *PluginCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(FailureComponent)
+	out := new(PluginCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Gitlab) DeepCopyInto(out *Gitlab) {
+func (in *PluginList) DeepCopyInto(out *PluginList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Plugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gitlab.
-func (in *Gitlab) DeepCopy() *Gitlab {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginList.
+func (in *PluginList) DeepCopy() *PluginList {
 	if in == nil {
 		return nil
 	}
-	out := new(Gitlab)
+	out := new(PluginList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *PluginSpec) DeepCopyInto(out *PluginSpec) {
 	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AuthTokenSecretRef != nil {
+		in, out := &in.AuthTokenSecretRef, &out.AuthTokenSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginSpec.
+func (in *PluginSpec) DeepCopy() *PluginSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Image)
+	out := new(PluginSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSTeamsGroup) DeepCopyInto(out *MSTeamsGroup) {
+func (in *PluginStatus) DeepCopyInto(out *PluginStatus) {
 	*out = *in
-	if in.ChannelNameOrIDs != nil {
-		in, out := &in.ChannelNameOrIDs, &out.ChannelNameOrIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PluginCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSTeamsGroup.
-func (in *MSTeamsGroup) DeepCopy() *MSTeamsGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginStatus.
+func (in *PluginStatus) DeepCopy() *PluginStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MSTeamsGroup)
+	out := new(PluginStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutdatedComponent) DeepCopyInto(out *OutdatedComponent) {
+func (in *PostActiveMetric) DeepCopyInto(out *PostActiveMetric) {
 	*out = *in
-	if in.CurrentImage != nil {
-		in, out := &in.CurrentImage, &out.CurrentImage
-		*out = new(Image)
-		**out = **in
-	}
-	if in.DesiredImage != nil {
-		in, out := &in.DesiredImage, &out.DesiredImage
-		*out = new(Image)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedComponent.
-func (in *OutdatedComponent) DeepCopy() *OutdatedComponent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostActiveMetric.
+func (in *PostActiveMetric) DeepCopy() *PostActiveMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(OutdatedComponent)
+	out := new(PostActiveMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OutdatedNotification) DeepCopyInto(out *OutdatedNotification) {
+func (in *PostActiveProbe) DeepCopyInto(out *PostActiveProbe) {
 	*out = *in
-	out.ExceedDuration = in.ExceedDuration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutdatedNotification.
-func (in *OutdatedNotification) DeepCopy() *OutdatedNotification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostActiveProbe.
+func (in *PostActiveProbe) DeepCopy() *PostActiveProbe {
 	if in == nil {
 		return nil
 	}
-	out := new(OutdatedNotification)
+	out := new(PostActiveProbe)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1283,7 +1986,7 @@ func (in *PullRequestBundle) DeepCopy() *PullRequestBundle {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestComponent) DeepCopyInto(out *PullRequestComponent) {
 	*out = *in
-	out.Image = in.Image
+	in.Image.DeepCopyInto(&out.Image)
 	if in.Source != nil {
 		in, out := &in.Source, &out.Source
 		*out = new(UpdatingSource)
@@ -1822,6 +2525,32 @@ func (in *Queue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueBatchItem) DeepCopyInto(out *QueueBatchItem) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make(QueueComponents, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(QueueComponent)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueBatchItem.
+func (in *QueueBatchItem) DeepCopy() *QueueBatchItem {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueBatchItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in QueueByNoOfOrder) DeepCopyInto(out *QueueByNoOfOrder) {
 	{
@@ -2076,6 +2805,13 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		in, out := &in.NextProcessAt, &out.NextProcessAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = make([]QueueBatchItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
@@ -2131,6 +2867,17 @@ func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 		*out = make([]Image, len(*in))
 		copy(*out, *in)
 	}
+	if in.TestStages != nil {
+		in, out := &in.TestStages, &out.TestStages
+		*out = make([]QueueTestStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WaitSLOBreachedAt != nil {
+		in, out := &in.WaitSLOBreachedAt, &out.WaitSLOBreachedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueStatus.
@@ -2143,6 +2890,54 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueTestStage) DeepCopyInto(out *QueueTestStage) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.TriggeredAt != nil {
+		in, out := &in.TriggeredAt, &out.TriggeredAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	out.TestRunner = in.TestRunner
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueTestStage.
+func (in *QueueTestStage) DeepCopy() *QueueTestStage {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueTestStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseDiff) DeepCopyInto(out *ReleaseDiff) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]ValuesDiff, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseDiff.
+func (in *ReleaseDiff) DeepCopy() *ReleaseDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportOption) DeepCopyInto(out *ReportOption) {
 	*out = *in
@@ -2238,6 +3033,21 @@ func (in *ReporterRest) DeepCopyInto(out *ReporterRest) {
 		*out = new(RestObject)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueueAction != nil {
+		in, out := &in.QueueAction, &out.QueueAction
+		*out = new(RestObject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueWaitSLOBreached != nil {
+		in, out := &in.QueueWaitSLOBreached, &out.QueueWaitSLOBreached
+		*out = new(RestObject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActivePromotionApproval != nil {
+		in, out := &in.ActivePromotionApproval, &out.ActivePromotionApproval
+		*out = new(RestObject)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReporterRest.
@@ -2283,6 +3093,21 @@ func (in *ReporterShell) DeepCopyInto(out *ReporterShell) {
 		*out = new(CommandAndArgs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QueueAction != nil {
+		in, out := &in.QueueAction, &out.QueueAction
+		*out = new(CommandAndArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueWaitSLOBreached != nil {
+		in, out := &in.QueueWaitSLOBreached, &out.QueueWaitSLOBreached
+		*out = new(CommandAndArgs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActivePromotionApproval != nil {
+		in, out := &in.ActivePromotionApproval, &out.ActivePromotionApproval
+		*out = new(CommandAndArgs)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReporterShell.
@@ -2356,6 +3181,22 @@ func (in *RestObject) DeepCopy() *RestObject {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedActivePromotion) DeepCopyInto(out *SkippedActivePromotion) {
+	*out = *in
+	in.ScheduledAt.DeepCopyInto(&out.ScheduledAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedActivePromotion.
+func (in *SkippedActivePromotion) DeepCopy() *SkippedActivePromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedActivePromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StableComponent) DeepCopyInto(out *StableComponent) {
 	*out = *in
@@ -2589,6 +3430,16 @@ func (in *TeamNamespace) DeepCopyInto(out *TeamNamespace) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StagingSlots != nil {
+		in, out := &in.StagingSlots, &out.StagingSlots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetainedActives != nil {
+		in, out := &in.RetainedActives, &out.RetainedActives
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamNamespace.
@@ -2601,6 +3452,26 @@ func (in *TeamNamespace) DeepCopy() *TeamNamespace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamQueue) DeepCopyInto(out *TeamQueue) {
+	*out = *in
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamQueue.
+func (in *TeamQueue) DeepCopy() *TeamQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
@@ -2621,6 +3492,11 @@ func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 		*out = new(StagingCtrl)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(TeamQueue)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Credential.DeepCopyInto(&out.Credential)
 }
 
@@ -2676,6 +3552,23 @@ func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.ComponentVersionPolling != nil {
+		in, out := &in.ComponentVersionPolling, &out.ComponentVersionPolling
+		*out = make(map[string]ComponentVersionPolling, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ActivePromotionSchedule != nil {
+		in, out := &in.ActivePromotionSchedule, &out.ActivePromotionSchedule
+		*out = new(ActivePromotionScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutdatedActivePromotion != nil {
+		in, out := &in.OutdatedActivePromotion, &out.OutdatedActivePromotion
+		*out = new(OutdatedActivePromotionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Used.DeepCopyInto(&out.Used)
 }
 
@@ -2765,3 +3658,18 @@ func (in *UsernamePasswordCredential) DeepCopy() *UsernamePasswordCredential {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesDiff) DeepCopyInto(out *ValuesDiff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesDiff.
+func (in *ValuesDiff) DeepCopy() *ValuesDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesDiff)
+	in.DeepCopyInto(out)
+	return out
+}
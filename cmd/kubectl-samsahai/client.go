@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2h "github.com/agoda-com/samsahai/internal"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+// client calls Samsahai REST APIs
+type client struct {
+	baseURL    string
+	authToken  string
+	httpClient *http.Client
+}
+
+type errResp struct {
+	Error string `json:"error"`
+}
+
+func newClient(baseURL, authToken string) *client {
+	return &client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		authToken:  authToken,
+		httpClient: &http.Client{Timeout: defaultRequestTimeout},
+	}
+}
+
+// PinComponent adds a pin queue of the component version,
+// nil queue will be returned if the stable component is pinned immediately
+func (c *client) PinComponent(teamName, compName, repository, version string, skipTestRunner bool,
+	pinnedBy string) (*s2hv1.Queue, error) {
+
+	reqBody := map[string]interface{}{
+		"repository":     repository,
+		"version":        version,
+		"skipTestRunner": skipTestRunner,
+		"by":             pinnedBy,
+	}
+	path := fmt.Sprintf("/teams/%s/components/%s/pin", url.PathEscape(teamName), url.PathEscape(compName))
+
+	q := &s2hv1.Queue{}
+	statusCode, err := c.post(path, nil, reqBody, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusCreated {
+		return nil, nil
+	}
+
+	return q, nil
+}
+
+// UnpinComponent unpins the stable component
+func (c *client) UnpinComponent(teamName, compName, unpinnedBy string) error {
+	path := fmt.Sprintf("/teams/%s/components/%s/unpin", url.PathEscape(teamName), url.PathEscape(compName))
+	_, err := c.post(path, url.Values{"by": {unpinnedBy}}, nil, nil)
+	return err
+}
+
+// CreateActivePromotion creates an active promotion of the team
+func (c *client) CreateActivePromotion(teamName string, skipTest bool, tearDownDuration, promotedBy string,
+	comps []string) (*s2hv1.ActivePromotion, error) {
+
+	reqBody := map[string]interface{}{
+		"skipTest":         skipTest,
+		"tearDownDuration": tearDownDuration,
+		"promotedBy":       promotedBy,
+		"components":       comps,
+	}
+	path := fmt.Sprintf("/teams/%s/activepromotions", url.PathEscape(teamName))
+
+	atp := &s2hv1.ActivePromotion{}
+	if _, err := c.post(path, nil, reqBody, atp); err != nil {
+		return nil, err
+	}
+
+	return atp, nil
+}
+
+// CancelActivePromotion cancels the current active promotion of the team
+func (c *client) CancelActivePromotion(teamName, canceledBy string) error {
+	path := fmt.Sprintf("/teams/%s/activepromotions/current", url.PathEscape(teamName))
+	_, err := c.do(http.MethodDelete, path, url.Values{"by": {canceledBy}}, nil, nil)
+	return err
+}
+
+// RetryActivePromotion creates an active promotion of the team from the latest failed active promotion
+func (c *client) RetryActivePromotion(teamName, retriedBy string) (*s2hv1.ActivePromotion, error) {
+	path := fmt.Sprintf("/teams/%s/activepromotions/retry", url.PathEscape(teamName))
+
+	atp := &s2hv1.ActivePromotion{}
+	if _, err := c.post(path, url.Values{"by": {retriedBy}}, nil, atp); err != nil {
+		return nil, err
+	}
+
+	return atp, nil
+}
+
+// RollbackActiveEnvironment switches the active namespace of the team back to the retained previous active namespace,
+// nil namespace will be returned if the retained namespace is still being promoted to active
+func (c *client) RollbackActiveEnvironment(teamName string, position int, rolledBackBy string) (
+	*s2hv1.TeamNamespace, error) {
+
+	path := fmt.Sprintf("/teams/%s/environment/active/rollback", url.PathEscape(teamName))
+	query := url.Values{"to": {strconv.Itoa(position)}, "by": {rolledBackBy}}
+
+	statusCode, body, err := c.doRaw(http.MethodPost, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == http.StatusAccepted {
+		return nil, nil
+	}
+
+	teamNs := &s2hv1.TeamNamespace{}
+	if err := json.Unmarshal(body, teamNs); err != nil {
+		return nil, err
+	}
+
+	return teamNs, nil
+}
+
+// post sends POST request with JSON body and decodes JSON response into out if it is not nil
+func (c *client) post(path string, query url.Values, in, out interface{}) (int, error) {
+	return c.do(http.MethodPost, path, query, in, out)
+}
+
+// do sends request with JSON body and decodes JSON response into out if it is not nil
+func (c *client) do(method, path string, query url.Values, in, out interface{}) (int, error) {
+	statusCode, respBody, err := c.doRaw(method, path, query, in)
+	if err != nil {
+		return statusCode, err
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return statusCode, err
+		}
+	}
+
+	return statusCode, nil
+}
+
+// doRaw sends request with JSON body and returns the response body, error responses are returned as error
+func (c *client) doRaw(method, path string, query url.Values, in interface{}) (int, []byte, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, reqURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(s2h.SamsahaiAuthHeader, c.authToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		errData := errResp{}
+		if err := json.Unmarshal(respBody, &errData); err == nil && errData.Error != "" {
+			return resp.StatusCode, nil, fmt.Errorf("%s (status code %d)", errData.Error, resp.StatusCode)
+		}
+		return resp.StatusCode, nil, fmt.Errorf("request failed with status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, respBody, nil
+}
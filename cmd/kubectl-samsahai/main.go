@@ -0,0 +1,340 @@
+/*
+Copyright 2019 Agoda DevOps Container.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/util"
+)
+
+// rollbackPollInterval is a duration between rollback requests while the retained namespace is being promoted
+const rollbackPollInterval = 10 * time.Second
+
+var cmd = &cobra.Command{
+	Use:          "kubectl-samsahai",
+	Short:        "Manage Samsahai teams from kubectl",
+	SilenceUsage: true,
+}
+
+func init() {
+	cobra.OnInitialize(util.InitViper)
+
+	cmd.PersistentFlags().String(s2h.VKS2HServerURL, "", "Samsahai server url.")
+	cmd.PersistentFlags().String(s2h.VKS2HAuthToken, "", "Samsahai auth token.")
+	cmd.PersistentFlags().StringP(s2h.VKS2HTeamName, "t", "", "Samsahai team name.")
+
+	if err := viper.BindPFlags(cmd.PersistentFlags()); err != nil {
+		log.Printf("viper cannot bind pflags: %+v\n", err)
+	}
+
+	cmd.AddCommand(versionCmd())
+	cmd.AddCommand(pinCmd())
+	cmd.AddCommand(unpinCmd())
+	cmd.AddCommand(promotionCmd())
+	cmd.AddCommand(rollbackCmd())
+}
+
+func main() {
+	// the plugin is also shipped as kubectl-s2h, usages follow the invoked binary name
+	cmd.Use = filepath.Base(os.Args[0])
+
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func pinCmd() *cobra.Command {
+	var skipTestRunner bool
+	var pinnedBy string
+	cmd := &cobra.Command{
+		Use:   "pin <component> <[repository:]tag>",
+		Short: "Pin a component of staging to a specific version",
+		Long: "Adds a pin queue of the component version to the top of team queues, " +
+			"the stable component will be pinned after the queue has been verified.\n" +
+			"New versions of the pinned component will not be verified until it is unpinned.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			repository, version := parseImage(args[1])
+			q, err := c.PinComponent(teamName, args[0], repository, version, skipTestRunner, pinnedBy)
+			if err != nil {
+				return err
+			}
+
+			if q == nil {
+				fmt.Printf("%s has been pinned to %s\n", args[0], args[1])
+				return nil
+			}
+
+			fmt.Printf("queue %s has been added, %s will be pinned after the queue has been verified\n",
+				q.Name, args[0])
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&skipTestRunner, "skip-test", false, "Skip running test of the pin queue.")
+	cmd.Flags().StringVar(&pinnedBy, "by", os.Getenv("USER"), "A person who pins the component.")
+
+	return cmd
+}
+
+func unpinCmd() *cobra.Command {
+	var unpinnedBy string
+	cmd := &cobra.Command{
+		Use:   "unpin <component>",
+		Short: "Unpin a component of staging",
+		Long:  "Unpins the stable component, the latest desired version will be added to team queues again.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			if err := c.UnpinComponent(teamName, args[0], unpinnedBy); err != nil {
+				return err
+			}
+
+			fmt.Printf("%s has been unpinned\n", args[0])
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&unpinnedBy, "by", os.Getenv("USER"), "A person who unpins the component.")
+
+	return cmd
+}
+
+func promotionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "promotion",
+		Aliases: []string{"atp"},
+		Short:   "Manage active promotion of the team",
+	}
+	cmd.AddCommand(promotionStartCmd())
+	cmd.AddCommand(promotionCancelCmd())
+	cmd.AddCommand(promotionRetryCmd())
+
+	return cmd
+}
+
+func promotionStartCmd() *cobra.Command {
+	var skipTest bool
+	var tearDownDuration string
+	var promotedBy string
+	var comps []string
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Start an active promotion",
+		Long: "Creates an active promotion of the team, " +
+			"it will be waiting in queue if there are other running active promotions.\n" +
+			"The active promotion cannot be created during code-freeze windows.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			atp, err := c.CreateActivePromotion(teamName, skipTest, tearDownDuration, promotedBy, comps)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("active promotion of %s has been created\n", atp.Name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&skipTest, "skip-test", false, "Skip running pre-active test.")
+	cmd.Flags().StringVar(&tearDownDuration, "tear-down-duration", "",
+		"Duration before tearing down the previous active namespace e.g. 30m.")
+	cmd.Flags().StringVar(&promotedBy, "by", os.Getenv("USER"), "A person who promotes the active environment.")
+	cmd.Flags().StringSliceVar(&comps, "components", nil,
+		"Components to be promoted, all components are promoted if not defined.")
+
+	return cmd
+}
+
+func promotionCancelCmd() *cobra.Command {
+	var canceledBy string
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel the current active promotion",
+		Long: "Cancels the current active promotion of the team, " +
+			"the active environment will be rolled back if it has been touched.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			if err := c.CancelActivePromotion(teamName, canceledBy); err != nil {
+				return err
+			}
+
+			fmt.Printf("active promotion of %s has been canceled\n", teamName)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&canceledBy, "by", os.Getenv("USER"), "A person who cancels the active promotion.")
+
+	return cmd
+}
+
+func promotionRetryCmd() *cobra.Command {
+	var retriedBy string
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retry the latest failed active promotion",
+		Long:  "Creates an active promotion with options of the latest active promotion if it was not successful.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			atp, err := c.RetryActivePromotion(teamName, retriedBy)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("active promotion of %s has been retried from %s\n", atp.Name, atp.Spec.RetriedFrom)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&retriedBy, "by", os.Getenv("USER"), "A person who retries the active promotion.")
+
+	return cmd
+}
+
+func rollbackCmd() *cobra.Command {
+	var rolledBackBy string
+	var timeout time.Duration
+	cmd := &cobra.Command{
+		Use:   "rollback [position]",
+		Short: "Roll back the active environment to a retained previous active environment",
+		Long: "Scales up the retained previous active namespace at the given position, promotes it to active again " +
+			"and switches it to active, 1 is the latest one (default 1).\n" +
+			"The current active namespace is scaled down and retained instead.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			position := 1
+			if len(args) > 0 {
+				var err error
+				if position, err = strconv.Atoi(args[0]); err != nil || position < 1 {
+					return fmt.Errorf("position must be a positive number, got %q", args[0])
+				}
+			}
+
+			c, teamName, err := newClientFromConfig()
+			if err != nil {
+				return err
+			}
+
+			deadline := time.Now().Add(timeout)
+			for {
+				teamNs, err := c.RollbackActiveEnvironment(teamName, position, rolledBackBy)
+				if err != nil {
+					return err
+				}
+
+				if teamNs != nil {
+					fmt.Printf("active environment of %s has been rolled back to %s\n", teamName, teamNs.Active)
+					return nil
+				}
+
+				if time.Now().After(deadline) {
+					return fmt.Errorf("retained namespace has not been promoted to active within %s, "+
+						"run the rollback again to continue", timeout)
+				}
+
+				fmt.Println("waiting for the retained namespace to be promoted to active...")
+				time.Sleep(rollbackPollInterval)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&rolledBackBy, "by", os.Getenv("USER"), "A person who rolls back the active environment.")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute,
+		"Maximum duration for waiting the retained namespace to be promoted to active.")
+
+	return cmd
+}
+
+func versionCmd() *cobra.Command {
+	isShortVersion := false
+	cmd := &cobra.Command{
+		Use:     "version",
+		Aliases: []string{"v"},
+		Short:   "show version",
+		Run: func(cmd *cobra.Command, args []string) {
+			if isShortVersion {
+				fmt.Println(s2h.Version)
+				return
+			}
+			fmt.Println(cmd.Root().Use+" version:", fmt.Sprintf("v%s (commit:%s)", s2h.Version, s2h.GitCommit))
+		},
+	}
+	cmd.Flags().BoolVarP(&isShortVersion, "short", "s", false, "print only version")
+
+	return cmd
+}
+
+func newClientFromConfig() (*client, string, error) {
+	requiredConfig := []string{s2h.VKS2HServerURL, s2h.VKS2HAuthToken, s2h.VKS2HTeamName}
+	for _, flag := range requiredConfig {
+		if _, err := checkRequiredConfig(flag); err != nil {
+			return nil, "", err
+		}
+	}
+
+	c := newClient(viper.GetString(s2h.VKS2HServerURL), viper.GetString(s2h.VKS2HAuthToken))
+	return c, viper.GetString(s2h.VKS2HTeamName), nil
+}
+
+func checkRequiredConfig(name string) (string, error) {
+	v := viper.GetString(name)
+	if v == "" {
+		return "", fmt.Errorf("config '%s' is required", strings.Replace(strings.ToUpper(name), "-", "_", -1))
+	}
+	return v, nil
+}
+
+// parseImage splits `repository:tag` into repository and tag,
+// empty repository will be returned if there is only tag
+func parseImage(image string) (repository, tag string) {
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i+1:], "/") {
+		return "", image
+	}
+
+	return image[:i], image[i+1:]
+}
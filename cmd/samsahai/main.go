@@ -47,6 +47,7 @@ import (
 	docs2 "github.com/agoda-com/samsahai/docs"
 	s2h "github.com/agoda-com/samsahai/internal"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/plugin"
 	"github.com/agoda-com/samsahai/internal/samsahai"
 	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
 	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
@@ -167,12 +168,13 @@ func startCtrlCmd() *cobra.Command {
 					MaxHistoryDays:             viper.GetInt(s2h.VKPullRequestQueueMaxHistoryDays),
 				},
 				SamsahaiCredential: s2h.SamsahaiCredential{
-					InternalAuthToken: authToken,
-					SlackToken:        viper.GetString(s2h.VKSlackToken),
-					GithubToken:       viper.GetString(s2h.VKGithubToken),
-					TeamcityUsername:  viper.GetString(s2h.VKTeamcityUsername),
-					TeamcityPassword:  viper.GetString(s2h.VKTeamcityPassword),
-					GitlabToken:       viper.GetString(s2h.VKGitlabToken),
+					InternalAuthToken:     authToken,
+					SlackToken:            viper.GetString(s2h.VKSlackToken),
+					GithubToken:           viper.GetString(s2h.VKGithubToken),
+					TeamcityUsername:      viper.GetString(s2h.VKTeamcityUsername),
+					TeamcityPassword:      viper.GetString(s2h.VKTeamcityPassword),
+					GitlabToken:           viper.GetString(s2h.VKGitlabToken),
+					RegistryWebhookSecret: viper.GetString(s2h.VKRegistryWebhookSecret),
 					MSTeams: s2h.MSTeamsCredential{
 						TenantID:     viper.GetString(s2h.VKMSTeamsTenantID),
 						ClientID:     viper.GetString(s2h.VKMSTeamsClientID),
@@ -181,9 +183,10 @@ func startCtrlCmd() *cobra.Command {
 						Password:     viper.GetString(s2h.VKMSTeamsPassword),
 					},
 				},
-				CheckerResources: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse(viper.GetString(s2h.VKCheckerCPU)),
-					corev1.ResourceMemory: resource.MustParse(viper.GetString(s2h.VKCheckerMemory)),
+				VersionPolling: s2h.VersionPollingConfig{
+					Interval:     metav1.Duration{Duration: viper.GetDuration(s2h.VKVersionPollingInterval)},
+					Concurrences: viper.GetInt(s2h.VKVersionPollingConcurrences),
+					MaxJitter:    metav1.Duration{Duration: viper.GetDuration(s2h.VKVersionPollingMaxJitter)},
 				},
 				InitialResourcesQuota: corev1.ResourceList{
 					corev1.ResourceCPU:    resource.MustParse(viper.GetString(s2h.VKInitialResourcesQuotaCPU)),
@@ -200,6 +203,7 @@ func startCtrlCmd() *cobra.Command {
 			s2hCtrl := samsahai.New(mgr, namespace, configs)
 			activepromotion.New(mgr, s2hCtrl, configs)
 			stablecomponent.New(mgr, s2hCtrl)
+			plugin.New(mgr, s2hCtrl, namespace)
 
 			// setup http server
 			logger.Info("setup http server")
@@ -257,6 +261,8 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().String(s2h.VKMetricHTTPPort, "8081", "The port for prometheus metric to binds to.")
 	cmd.Flags().String(s2h.VKS2HAuthToken, "<random>", "Samsahai server authentication token.")
 	cmd.Flags().String(s2h.VKSlackToken, "", "Slack token for sending notification if using slack.")
+	cmd.Flags().String(s2h.VKRegistryWebhookSecret, "",
+		"Shared secret for verifying push webhooks from container registries.")
 	cmd.Flags().String(s2h.VKS2HImage, defaultImage, "Docker image for running Staging.")
 	cmd.Flags().String(s2h.VKS2HServiceScheme, "http", "Scheme to use for connecting to Samsahai.")
 	cmd.Flags().String(s2h.VKS2HServiceName, "samsahai", "Service name for connecting to Samsahai.")
@@ -297,10 +303,12 @@ func startCtrlCmd() *cobra.Command {
 		"Waiting duration time to re-check pull request image in the registry.")
 	cmd.Flags().Int(s2h.VKPullRequestQueueMaxHistoryDays, 7,
 		"Max stored pull request queue histories in day.")
-	cmd.Flags().String(s2h.VKCheckerCPU, "100m",
-		"CPU of cronjobs for sending new component webhook.")
-	cmd.Flags().String(s2h.VKCheckerMemory, "125Mi",
-		"Memory of cronjobs for sending new component webhook.")
+	cmd.Flags().Duration(s2h.VKVersionPollingInterval, 30*time.Second,
+		"Interval for evaluating component schedules of every team.")
+	cmd.Flags().Int(s2h.VKVersionPollingConcurrences, 5,
+		"Max number of components to be polled for new version in each interval.")
+	cmd.Flags().Duration(s2h.VKVersionPollingMaxJitter, 30*time.Second,
+		"Max random delay before polling new version of a scheduled component.")
 	cmd.Flags().String(s2h.VKInitialResourcesQuotaCPU, "3",
 		"Required minimum cpu of resources quota which will be used for mock deployment engine.")
 	cmd.Flags().String(s2h.VKInitialResourcesQuotaMemory, "3Gi",
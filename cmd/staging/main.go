@@ -104,6 +104,13 @@ func startCtrlCmd() *cobra.Command {
 			httpServerPort := viper.GetString(s2h.VKServerHTTPPort)
 			httpMetricPort := viper.GetString(s2h.VKMetricHTTPPort)
 			teamName := viper.GetString(s2h.VKS2HTeamName)
+			stagingSlots := viper.GetInt(s2h.VKStagingSlots)
+			leaderElection := viper.GetBool(s2h.VKStagingLeaderElection)
+			podName := viper.GetString(s2h.VKPodName)
+			if leaderElection && podName == "" {
+				return fmt.Errorf("config '%s' is required for leader election",
+					strings.Replace(strings.ToUpper(s2h.VKPodName), "-", "_", -1))
+			}
 
 			logger.Debug(fmt.Sprintf("running on: %s", namespace))
 			// Get a config to talk to the apiserver
@@ -116,11 +123,28 @@ func startCtrlCmd() *cobra.Command {
 
 			// Create a new Cmd to provide shared dependencies and start components
 			logger.Info("setting up manager")
-			mgr, err := manager.New(cfg, manager.Options{
+			mgrOpts := manager.Options{
 				Scheme:             scheme,
 				MetricsBindAddress: ":" + httpMetricPort,
 				Namespace:          namespace,
-			})
+			}
+			if leaderElection {
+				// only the elected replica processes queues, the others are on standby
+				mgrOpts.LeaderElection = true
+				mgrOpts.LeaderElectionNamespace = namespace
+				mgrOpts.LeaderElectionID = s2h.StagingCtrlName + "-leader"
+			}
+			if stagingSlots > 1 {
+				// watch resources of all staging slot namespaces
+				namespaces := []string{namespace}
+				for slot := 1; slot < stagingSlots; slot++ {
+					namespaces = append(namespaces, s2h.GenStagingSlotNamespace(teamName, slot))
+				}
+				mgrOpts.Namespace = ""
+				mgrOpts.NewCache = stagingctrl.NewSlotCacheBuilder(namespaces)
+			}
+
+			mgr, err := manager.New(cfg, mgrOpts)
 			if err != nil {
 				logger.Error(err, "unable to set up overall controller manager")
 				os.Exit(1)
@@ -140,7 +164,7 @@ func startCtrlCmd() *cobra.Command {
 
 			samsahaiClient := rpc.NewRPCProtobufClient(viper.GetString(s2h.VKS2HServerURL), &http.Client{})
 			configCtrl := configctrl.New(mgr)
-			queueCtrl := queue.New(namespace, runtimeClient)
+			queueCtrl := queue.New(namespace, runtimeClient, queue.WithConfigCtrl(configCtrl))
 			authToken := viper.GetString(s2h.VKS2HAuthToken)
 			desiredctrl.New(teamName, mgr, queueCtrl, authToken, samsahaiClient)
 
@@ -152,15 +176,37 @@ func startCtrlCmd() *cobra.Command {
 			maxQueueHistDays := viper.GetInt(s2h.VKQueueMaxHistoryDays)
 			stagingCtrl := stagingctrl.NewController(teamName, namespace, authToken, samsahaiClient, mgr,
 				queueCtrl, configCtrl, tcBaseURL, tcUsername, tcPassword, glBaseURL, glToken,
-				s2h.StagingConfig{MaxHistoryDays: maxQueueHistDays})
+				s2h.StagingConfig{MaxHistoryDays: maxQueueHistDays, Slots: stagingSlots})
 
 			prQueueCtrl := prqueuectrl.New(teamName, namespace, mgr, authToken, samsahaiClient,
 				prqueuectrl.WithClient(runtimeClient))
 			_ = prtriggerctrl.New(teamName, mgr, prQueueCtrl, authToken, samsahaiClient)
 
-			logger.Info("starting controller")
-			chStop := make(chan struct{})
-			go stagingCtrl.Start(chStop)
+			if leaderElection {
+				// the replica does not serve requests of the current queue until it is elected
+				if err := stagingctrl.SetLeaderLabel(runtimeClient, namespace, podName, false); err != nil {
+					logger.Error(err, "cannot unmark leader pod", "pod", podName)
+					os.Exit(1)
+				}
+
+				err := mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+					logger.Info("elected as leader, starting controller", "pod", podName)
+					if err := stagingctrl.SetLeaderLabel(runtimeClient, namespace, podName, true); err != nil {
+						return err
+					}
+
+					stagingCtrl.Start(stop)
+					return nil
+				}))
+				if err != nil {
+					logger.Error(err, "cannot add staging controller to manager")
+					os.Exit(1)
+				}
+			} else {
+				logger.Info("starting controller")
+				chStop := make(chan struct{})
+				go stagingCtrl.Start(chStop)
+			}
 
 			logger.Info("initializing http routes")
 
@@ -203,6 +249,10 @@ func startCtrlCmd() *cobra.Command {
 	cmd.Flags().String(s2h.VKServerHTTPPort, "8090", "The port for http server to listens to.")
 	cmd.Flags().String(s2h.VKMetricHTTPPort, "8091", "The port for prometheus metric to binds to.")
 	cmd.Flags().Int(s2h.VKQueueMaxHistoryDays, 7, "Max stored queue histories in day.")
+	cmd.Flags().Int(s2h.VKStagingSlots, 1, "Number of staging namespaces for verifying queues in parallel.")
+	cmd.Flags().Bool(s2h.VKStagingLeaderElection, false,
+		"Enable leader election for running multiple replicas of the controller.")
+	cmd.Flags().String(s2h.VKPodName, "", "Name of the controller pod, required for leader election.")
 
 	return cmd
 }
@@ -1,6 +1,6 @@
 // GENERATED BY THE COMMAND ABOVE; DO NOT EDIT
 // This file was generated by swaggo/swag at
-// 2021-04-28 09:40:41.159975 +0700 +07 m=+0.155719399
+// 2026-10-18 17:13:12.406418418 +0000 UTC m=+0.129626387
 
 package docs
 
@@ -169,18 +169,16 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/teams/{team}/activepromotions/histories": {
-            "get": {
-                "description": "get active promotion histories by team name",
-                "produces": [
+            },
+            "post": {
+                "description": "Creates an active promotion of the team, it will be waiting in queue\nif there are other running active promotions.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "get active promotion histories by team name",
+                "summary": "Create Active Promotion",
                 "parameters": [
                     {
                         "type": "string",
@@ -188,40 +186,65 @@ var doc = `{
                         "name": "team",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Active promotion options",
+                        "name": "createActivePromotionJSON",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.createActivePromotionJSON"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/webhook.activePromotionHistories"
+                            "$ref": "#/definitions/v1.ActivePromotion"
                         }
                     },
                     "400": {
-                        "description": "team should not be empty",
+                        "description": "Invalid JSON, invalid tear down duration, active promotion already exists or in code-freeze window",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "500": {
-                        "description": "cannot get activepromotion histories of team {team}",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/activepromotions/histories/{history}": {
-            "get": {
-                "description": "get active promotion history by team and history name",
-                "produces": [
-                    "application/json"
-                ],
+        "/teams/{team}/activepromotions/approve": {
+            "post": {
+                "description": "Approves the active promotion which is waiting for approvals by the team owner.\nThe pre-active environment will be switched to be active once required approvals are given.\nThe approver is identified by the approver token of the team owner\nwhich is configured in the team credential, the shared samsahai auth token cannot approve.",
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "get active promotion history by team and history name",
+                "summary": "Approve Active Promotion",
                 "parameters": [
                     {
                         "type": "string",
@@ -232,9 +255,9 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "Active promotion history name",
-                        "name": "history",
-                        "in": "path",
+                        "description": "Approver token of the team owner",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -242,40 +265,43 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.ActivePromotion"
                         }
                     },
                     "400": {
-                        "description": "team/history should not be empty",
+                        "description": "Approver is not a team owner or active promotion is not waiting for approvals",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "404": {
-                        "description": "activepromotion history {history} of team {team} not found",
+                        "description": "Team or active promotion not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "500": {
-                        "description": "cannot get activepromotion history {history} of team {team}",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/activepromotions/histories/{history}/log": {
-            "get": {
-                "description": "Returns zip log file of the active promotion history",
-                "produces": [
-                    "application/json"
-                ],
+        "/teams/{team}/activepromotions/current": {
+            "delete": {
+                "description": "Cancels the current active promotion of the team.\nThe active environment will be rolled back if it has been touched.\nActive promotion which is destroying environments or rolling back cannot be canceled.",
                 "tags": [
-                    "GET"
+                    "DELETE"
                 ],
-                "summary": "Get zip log of active promotion history",
+                "summary": "Cancel Active Promotion",
                 "parameters": [
                     {
                         "type": "string",
@@ -286,47 +312,62 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "Active promotion history name",
-                        "name": "history",
-                        "in": "path",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Canceled by",
+                        "name": "by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/v1.ActivePromotion"
                         }
                     },
                     "400": {
-                        "description": "team/history should not be empty",
+                        "description": "Active promotion cannot be canceled in current state",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "404": {
-                        "description": "activepromotion history {history} of team {team} not found",
+                        "description": "Active promotion not found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "500": {
-                        "description": "cannot get activepromotion history {history} of team {team}",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/components": {
+        "/teams/{team}/activepromotions/diff": {
             "get": {
-                "description": "Returns list of components of team",
+                "description": "Returns differences of component versions, chart versions and rendered values\nbetween the active and pre-active environments of the current active promotion.\nThe diff is created before promoting, it is calculated on demand if it has not been created yet.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "GET"
                 ],
-                "summary": "Get Team Component",
+                "summary": "Get active promotion diff",
                 "parameters": [
                     {
                         "type": "string",
@@ -340,11 +381,23 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/webhook.teamComponentsJSON"
+                            "$ref": "#/definitions/v1.ActivePromotionDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Pre-active environment has not been created",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "404": {
-                        "description": "Team not found",
+                        "description": "Active promotion not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -352,13 +405,16 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/components/{component}/values": {
+        "/teams/{team}/activepromotions/histories": {
             "get": {
-                "description": "get team stable component values",
+                "description": "get active promotion histories by team name",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "GET"
                 ],
-                "summary": "get team stable component values",
+                "summary": "get active promotion histories by team name",
                 "parameters": [
                     {
                         "type": "string",
@@ -366,55 +422,40 @@ var doc = `{
                         "name": "team",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Component name",
-                        "name": "component",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "application/json",
-                            "application/x-yaml"
-                        ],
-                        "type": "string",
-                        "description": "Accept",
-                        "name": "accept",
-                        "in": "header",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/webhook.activePromotionHistories"
                         }
                     },
-                    "404": {
-                        "description": "Component not found",
+                    "400": {
+                        "description": "team should not be empty",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "cannot get activepromotion histories of team {team}",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/config": {
+        "/teams/{team}/activepromotions/histories/{history}": {
             "get": {
-                "description": "get team configuration",
+                "description": "get active promotion history by team and history name",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "GET"
                 ],
-                "summary": "get team configuration",
+                "summary": "get active promotion history by team and history name",
                 "parameters": [
                     {
                         "type": "string",
@@ -424,14 +465,10 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "enum": [
-                            "application/json",
-                            "application/x-yaml"
-                        ],
                         "type": "string",
-                        "description": "Accept",
-                        "name": "accept",
-                        "in": "header",
+                        "description": "Active promotion history name",
+                        "name": "history",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -442,28 +479,37 @@ var doc = `{
                             "type": "string"
                         }
                     },
+                    "400": {
+                        "description": "team/history should not be empty",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
-                        "description": "Team not found",
+                        "description": "activepromotion history {history} of team {team} not found",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "cannot get activepromotion history {history} of team {team}",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/environment/active/delete": {
-            "delete": {
-                "description": "Delete the current active namespace.",
+        "/teams/{team}/activepromotions/histories/{history}/diff": {
+            "get": {
+                "description": "Returns differences between the active and pre-active environments before promoting\nof the active promotion history.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "GET"
                 ],
-                "summary": "Delete the current active namespace",
+                "summary": "Get active promotion history diff",
                 "parameters": [
                     {
                         "type": "string",
@@ -474,26 +520,21 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "Delete by",
-                        "name": "deleted_by",
-                        "in": "query"
+                        "description": "Active promotion history name",
+                        "name": "history",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "400": {
-                        "description": "There is no active namespace to destroy",
-                        "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "$ref": "#/definitions/v1.ActivePromotionDiff"
                         }
                     },
                     "404": {
-                        "description": "Team not found",
+                        "description": "Active promotion history or its diff not found",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -507,13 +548,16 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/pullrequest/queue": {
+        "/teams/{team}/activepromotions/histories/{history}/log": {
             "get": {
-                "description": "Returns queue information of pull request deployment flow.",
+                "description": "Returns zip log file of the active promotion history",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "GET"
                 ],
-                "summary": "Get Team's Pull Request Queues",
+                "summary": "Get zip log of active promotion history",
                 "parameters": [
                     {
                         "type": "string",
@@ -521,37 +565,665 @@ var doc = `{
                         "name": "team",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Active promotion history name",
+                        "name": "history",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/webhook.teamPRQueueJSON"
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "team/history should not be empty",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Team not found",
+                        "description": "activepromotion history {history} of team {team} not found",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "cannot get activepromotion history {history} of team {team}",
                         "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/teams/{team}/pullrequest/queue/histories/{queue}": {
-            "get": {
-                "description": "Return pull request queue history of team by id",
+        "/teams/{team}/activepromotions/retry": {
+            "post": {
+                "description": "Creates an active promotion of the team with options of the latest active promotion history\nif it was not successful.",
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "Get Team Pull Request Queue History",
+                "summary": "Retry Active Promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retried by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ActivePromotion"
+                        }
+                    },
+                    "400": {
+                        "description": "No failed active promotion history, active promotion already exists or in code-freeze window",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/activepromotions/{name}/diff": {
+            "get": {
+                "description": "Returns differences between the active and pre-active environments of the active promotion.\nThe name can be ` + "`" + `current` + "`" + `, name of the current active promotion or name of an active promotion history.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get active promotion diff by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Active promotion or active promotion history name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ActivePromotionDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Pre-active environment has not been created",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Active promotion, active promotion history or its diff not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/components": {
+            "get": {
+                "description": "Returns list of components of team",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team Component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.teamComponentsJSON"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/components/{component}/pin": {
+            "post": {
+                "description": "Adds a pin queue of the component version to the top of team queues, test runner can be skipped.\nThe stable component will be pinned after the queue has been verified,\nnew versions of the pinned component will not be verified until it is unpinned.\nThe stable component is pinned immediately if the version is the same as the stable version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Pin Component Version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component name",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Component version",
+                        "name": "pinComponentJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.pinComponentJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stable component is pinned",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "201": {
+                        "description": "Pin queue is added",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Queue"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON, component not found or already pinned",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/components/{component}/unpin": {
+            "post": {
+                "description": "Unpins the stable component, the latest desired version will be added to team queues again.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Unpin Component",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component name",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unpinned by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Component is not pinned",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or stable component not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/components/{component}/values": {
+            "get": {
+                "description": "get team stable component values",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "get team stable component values",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Component name",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "application/json",
+                            "application/x-yaml"
+                        ],
+                        "type": "string",
+                        "description": "Accept",
+                        "name": "accept",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Component not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/config": {
+            "get": {
+                "description": "get team configuration",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "get team configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "application/json",
+                            "application/x-yaml"
+                        ],
+                        "type": "string",
+                        "description": "Accept",
+                        "name": "accept",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/environment/active/delete": {
+            "delete": {
+                "description": "Delete the current active namespace.",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Delete the current active namespace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delete by",
+                        "name": "deleted_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "There is no active namespace to destroy",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/environment/active/rollback": {
+            "post": {
+                "description": "Switches the active namespace back to the retained previous active namespace.\nThe retained namespace is scaled up and promoted to active again before switching,\n202 is returned while it is being promoted and the request should be sent again until 200 is returned.\nThe current active namespace is scaled down and retained instead.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Rollback Active Environment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Position of retained previous active namespace, 1 is the latest one (default 1)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rolled back by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TeamNamespace"
+                        }
+                    },
+                    "202": {
+                        "description": "Retained namespace is being promoted to active",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "400": {
+                        "description": "Retained namespace not found or active promotion is running",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/pullrequest/queue": {
+            "get": {
+                "description": "Returns queue information of pull request deployment flow.",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team's Pull Request Queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.teamPRQueueJSON"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/pullrequest/queue/histories/{queue}": {
+            "get": {
+                "description": "Return pull request queue history of team by id",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team Pull Request Queue History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "pull request queue history name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PullRequestQueueHistory"
+                        }
+                    },
+                    "404": {
+                        "description": "pull request queue history not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/pullrequest/queue/histories/{queue}/log": {
+            "get": {
+                "description": "Returns zip log file of the pull request queue history",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team Pull Request Queue History Log",
                 "parameters": [
                     {
                         "type": "string",
@@ -572,11 +1244,358 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PullRequestQueueHistory"
+                            "$ref": "#/definitions/v1.PullRequestQueueHistory"
+                        }
+                    },
+                    "404": {
+                        "description": "pull request queue history not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/pullrequest/trigger": {
+            "post": {
+                "description": "Endpoint for manually triggering pull request deployment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook For Pull Request Deployment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pull Request",
+                        "name": "pullRequestWebhookEventJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.pullRequestWebhookEventJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Errors",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue": {
+            "get": {
+                "description": "Returns queue information of new component upgrading flow.",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team's Queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.teamQueueJSON"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/bottom": {
+            "post": {
+                "description": "Moves the waiting queue to the bottom of team queues.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Move Queue To Bottom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Queue",
+                        "name": "queueActionJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.queueActionJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or queue is not waiting",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/cancel": {
+            "post": {
+                "description": "Cancels the waiting or running queue, the running queue will be stopped by the staging controller.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Cancel Queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Queue",
+                        "name": "queueActionJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.queueActionJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or queue cannot be cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/enqueue": {
+            "post": {
+                "description": "Adds a specific component version to the top of team queues, test runner can be skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Enqueue Component Version",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Component version",
+                        "name": "enqueueComponentJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.enqueueComponentJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Queue"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or component not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/histories/{queue}": {
+            "get": {
+                "description": "Return queue history of team by id",
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Get Team Queue History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Queue history name",
+                        "name": "queue",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.QueueHistory"
                         }
                     },
                     "404": {
-                        "description": "pull request queue history not found",
+                        "description": "Queue history not found",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -590,13 +1609,13 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/pullrequest/queue/histories/{queue}/log": {
+        "/teams/{team}/queue/histories/{queue}/log": {
             "get": {
-                "description": "Returns zip log file of the pull request queue history",
+                "description": "Returns zip log file of the queue history",
                 "tags": [
                     "GET"
                 ],
-                "summary": "Get Team Pull Request Queue History Log",
+                "summary": "Get Team Queue History Log",
                 "parameters": [
                     {
                         "type": "string",
@@ -607,7 +1626,7 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "pull request queue history name",
+                        "description": "Queue history name",
                         "name": "queue",
                         "in": "path",
                         "required": true
@@ -617,11 +1636,11 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PullRequestQueueHistory"
+                            "$ref": "#/definitions/v1.QueueHistory"
                         }
                     },
                     "404": {
-                        "description": "pull request queue history not found",
+                        "description": "Queue history not found",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -635,19 +1654,193 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/pullrequest/trigger": {
+        "/teams/{team}/queue/pause": {
             "post": {
-                "description": "Endpoint for manually triggering pull request deployment",
-                "consumes": [
-                    "application/json"
+                "description": "Pauses team queues, new queues are not started and active promotions are not started.",
+                "tags": [
+                    "POST"
                 ],
-                "produces": [
+                "summary": "Pause Team's Queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Paused by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/resume": {
+            "post": {
+                "description": "Resumes paused team queues, maintenance windows are still applied.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Resume Team's Queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resumed by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/retry": {
+            "post": {
+                "description": "Adds the latest failed component upgrade queue back to team queues.",
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Retry Failed Queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retried by",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.Queue"
+                        }
+                    },
+                    "400": {
+                        "description": "There is no failed queue or the queue already exists",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/teams/{team}/queue/top": {
+            "post": {
+                "description": "Moves the waiting queue to the top of team queues.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
                     "POST"
                 ],
-                "summary": "Webhook For Pull Request Deployment",
+                "summary": "Move Queue To Top",
                 "parameters": [
                     {
                         "type": "string",
@@ -657,13 +1850,99 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Pull Request",
-                        "name": "pullRequestWebhookEventJSON",
+                        "type": "string",
+                        "description": "Samsahai auth token",
+                        "name": "x-samsahai-auth",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Queue",
+                        "name": "queueActionJSON",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/webhook.pullRequestWebhookEventJSON"
+                            "$ref": "#/definitions/webhook.queueActionJSON"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or queue is not waiting",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or queue not found",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Get service version information.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GET"
+                ],
+                "summary": "Service Version",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.versionJSON"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/component": {
+            "post": {
+                "description": "Endpoint for manually triggering new component update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "POST"
+                ],
+                "summary": "Webhook New Component",
+                "parameters": [
+                    {
+                        "description": "New Component",
+                        "name": "newComponentEventJSON",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/webhook.newComponentEventJSON"
                         }
                     }
                 ],
@@ -679,47 +1958,50 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Errors",
-                        "schema": {
-                            "$ref": "#/definitions/webhook.errResp"
-                        }
                     }
                 }
             }
         },
-        "/teams/{team}/queue": {
-            "get": {
-                "description": "Returns queue information of new component upgrading flow.",
+        "/webhook/registry/dockerhub": {
+            "post": {
+                "description": "Endpoint for receiving push event from Docker Hub.\nDocker Hub does not support custom headers, secret should be set in ` + "`" + `token` + "`" + ` query parameter.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "Get Team's Queues",
+                "summary": "Webhook Docker Hub Push",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Team name",
-                        "name": "team",
-                        "in": "path",
+                        "description": "Registry webhook secret",
+                        "name": "token",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/webhook.teamQueueJSON"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Team not found",
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal Server Errors",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -727,44 +2009,46 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/queue/histories/{queue}": {
-            "get": {
-                "description": "Return queue history of team by id",
+        "/webhook/registry/ghcr": {
+            "post": {
+                "description": "Endpoint for receiving ` + "`" + `package` + "`" + ` or ` + "`" + `registry_package` + "`" + ` event from GitHub.\nRequest body is verified by ` + "`" + `X-Hub-Signature-256` + "`" + ` header which is signed by the webhook secret.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "Get Team Queue History",
+                "summary": "Webhook GitHub Container Registry Publish",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Team name",
-                        "name": "team",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Queue history name",
-                        "name": "queue",
-                        "in": "path",
+                        "description": "HMAC-SHA256 signature of request body",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/v1.QueueHistory"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Queue history not found",
+                    "400": {
+                        "description": "Invalid JSON",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal Server Errors",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
@@ -772,94 +2056,70 @@ var doc = `{
                 }
             }
         },
-        "/teams/{team}/queue/histories/{queue}/log": {
-            "get": {
-                "description": "Returns zip log file of the queue history",
+        "/webhook/registry/gitlab": {
+            "post": {
+                "description": "Endpoint for receiving push notification from GitLab container registry (docker distribution).\nSecret should be set in ` + "`" + `Authorization` + "`" + ` header of registry notification endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "GET"
+                    "POST"
                 ],
-                "summary": "Get Team Queue History Log",
+                "summary": "Webhook GitLab Container Registry Push",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Team name",
-                        "name": "team",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Queue history name",
-                        "name": "queue",
-                        "in": "path",
+                        "description": "Registry webhook secret",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/v1.QueueHistory"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Queue history not found",
+                    "400": {
+                        "description": "Invalid JSON",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
-                    }
-                }
-            }
-        },
-        "/version": {
-            "get": {
-                "description": "Get service version information.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "GET"
-                ],
-                "summary": "Service Version",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "500": {
+                        "description": "Internal Server Errors",
                         "schema": {
-                            "$ref": "#/definitions/webhook.versionJSON"
+                            "$ref": "#/definitions/webhook.errResp"
                         }
                     }
                 }
             }
         },
-        "/webhook/component": {
+        "/webhook/registry/harbor": {
             "post": {
-                "description": "Endpoint for manually triggering new component update",
+                "description": "Endpoint for receiving ` + "`" + `PUSH_ARTIFACT` + "`" + ` event from Harbor.\nSecret should be set in ` + "`" + `Authorization` + "`" + ` header of Harbor webhook policy.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "POST"
                 ],
-                "summary": "Webhook New Component",
+                "summary": "Webhook Harbor Push Artifact",
                 "parameters": [
                     {
-                        "description": "New Component",
-                        "name": "newComponentEventJSON",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/webhook.newComponentEventJSON"
-                        }
+                        "type": "string",
+                        "description": "Registry webhook secret",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -874,12 +2134,53 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/webhook.errResp"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Errors",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.errResp"
+                        }
                     }
                 }
             }
         }
     },
     "definitions": {
+        "v1.ActiveGatewayIngress": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "description": "Annotations represents annotations of the ingress e.g. ingress class\n+optional",
+                    "type": "object"
+                },
+                "host": {
+                    "description": "Host represents a host of the ingress rule",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name represents a name of the ingress",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path represents a path of the ingress rule\n+optional",
+                    "type": "string"
+                },
+                "serviceName": {
+                    "description": "ServiceName represents a service alias name which is a service name in the active namespace\nwithout the release name prefix",
+                    "type": "string"
+                },
+                "servicePort": {
+                    "description": "ServicePort represents a port of the service",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.ActivePromotion": {
             "type": "object",
             "properties": {
@@ -893,6 +2194,30 @@ var doc = `{
                 }
             }
         },
+        "v1.ActivePromotionApproval": {
+            "type": "object",
+            "properties": {
+                "approvedAt": {
+                    "description": "ApprovedAt represents time at which the active promotion has been approved\n+optional",
+                    "type": "string"
+                },
+                "approvers": {
+                    "description": "Approvers represents a list of team owners who have approved the active promotion\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "requiredApprovals": {
+                    "description": "RequiredApprovals represents a number of approvals required before switching to active",
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "description": "StartedAt represents time at which the active promotion started waiting for approvals\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ActivePromotionCondition": {
             "type": "object",
             "properties": {
@@ -916,6 +2241,37 @@ var doc = `{
                 }
             }
         },
+        "v1.ActivePromotionDiff": {
+            "type": "object",
+            "properties": {
+                "activeNamespace": {
+                    "description": "ActiveNamespace represents the active namespace which is compared\n+optional",
+                    "type": "string"
+                },
+                "components": {
+                    "description": "Components represents components which versions are different\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ComponentDiff"
+                    }
+                },
+                "createdAt": {
+                    "description": "CreatedAt represents time at which the diff has been created\n+optional",
+                    "type": "string"
+                },
+                "preActiveNamespace": {
+                    "description": "PreActiveNamespace represents the pre-active namespace which is compared\n+optional",
+                    "type": "string"
+                },
+                "releases": {
+                    "description": "Releases represents releases which chart versions or values are different\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReleaseDiff"
+                    }
+                }
+            }
+        },
         "v1.ActivePromotionHistory": {
             "type": "object",
             "properties": {
@@ -951,9 +2307,65 @@ var doc = `{
         "v1.ActivePromotionHistoryStatus": {
             "type": "object"
         },
+        "v1.ActivePromotionInPlace": {
+            "type": "object",
+            "properties": {
+                "previousComponents": {
+                    "description": "PreviousComponents represents stable components of the active namespace before upgrading\n+optional",
+                    "type": "object"
+                },
+                "releases": {
+                    "description": "Releases represents releases to be upgraded in dependency order\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.InPlaceRelease"
+                    }
+                }
+            }
+        },
+        "v1.ActivePromotionSchedule": {
+            "type": "object",
+            "properties": {
+                "cron": {
+                    "description": "Cron represents when the active promotion is created in cron format e.g. \"0 2 * * *\"",
+                    "type": "string"
+                },
+                "timeZone": {
+                    "description": "TimeZone represents a time zone of the schedule e.g. \"Asia/Bangkok\".\nDefault is UTC\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ActivePromotionScheduleStatus": {
+            "type": "object",
+            "properties": {
+                "lastScheduledAt": {
+                    "description": "LastScheduledAt represents the last time that the active promotion has been scheduled\n+optional",
+                    "type": "string"
+                },
+                "nextScheduleAt": {
+                    "description": "NextScheduleAt represents the next time that the active promotion will be scheduled\n+optional",
+                    "type": "string"
+                },
+                "skippedRuns": {
+                    "description": "SkippedRuns represents the latest scheduled runs which did not create the active promotion\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkippedActivePromotion"
+                    }
+                }
+            }
+        },
         "v1.ActivePromotionSpec": {
             "type": "object",
             "properties": {
+                "components": {
+                    "description": "Components represents names of components to be promoted,\nthe other components are carried over at their current active versions\nor promoted from staging if they do not exist in active.\nAll components are promoted if not defined\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "noOfRetry": {
                     "description": "NoOfRetry represents how many times this active promotion process has been run\n+optional",
                     "type": "integer"
@@ -962,6 +2374,10 @@ var doc = `{
                     "description": "PromotedBy represents a person who promoted the ActivePromotion\n+optional",
                     "type": "string"
                 },
+                "retriedFrom": {
+                    "description": "RetriedFrom represents a name of the active promotion history which is retried manually\n+optional",
+                    "type": "string"
+                },
                 "skipTestRunner": {
                     "description": "SkipTestRunner represents a flag for skipping running pre-active test\n+optional",
                     "type": "boolean"
@@ -983,6 +2399,15 @@ var doc = `{
                     "description": "ActivePromotionHistoryName represents created ActivePromotionHistoryName name\n+optional",
                     "type": "string"
                 },
+                "approval": {
+                    "description": "Approval represents a status of approvals before switching the pre-active to be active\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionApproval"
+                },
+                "canceledBy": {
+                    "description": "CanceledBy represents a person who canceled the active promotion\n+optional",
+                    "type": "string"
+                },
                 "conditions": {
                     "description": "Conditions contains observations of the resource's state e.g.,\nQueue deployed, being tested\n+optional\n+patchMergeKey=type\n+patchStrategy=merge",
                     "type": "array",
@@ -998,10 +2423,20 @@ var doc = `{
                     "description": "DestroyedTime represents time at which the previous active namespace will be destroyed\n+optional",
                     "type": "string"
                 },
+                "diff": {
+                    "description": "Diff represents differences between the active and pre-active environments before promoting\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionDiff"
+                },
                 "hasOutdatedComponent": {
                     "description": "HasOutdatedComponent defines whether current active promotion has outdated component or not\n+optional",
                     "type": "boolean"
                 },
+                "inPlace": {
+                    "description": "InPlace represents releases upgraded in the active namespace in case of in-place promotion\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionInPlace"
+                },
                 "isTimeout": {
                     "description": "IsTimeout defines whether the active promotion has been timeout or not\n+optional",
                     "type": "boolean"
@@ -1023,6 +2458,13 @@ var doc = `{
                     "description": "PreviousActiveNamespace represents an active namespace before promoting\n+optional",
                     "type": "string"
                 },
+                "promotedComponents": {
+                    "description": "PromotedComponents represents names of components which have been promoted from staging\nin case of promoting a subset of components\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "result": {
                     "description": "Result represents a result of the active promotion\n+optional",
                     "type": "string"
@@ -1049,6 +2491,18 @@ var doc = `{
                 }
             }
         },
+        "v1.ApproverCredential": {
+            "type": "object",
+            "properties": {
+                "owner": {
+                    "description": "Owner represents the team owner who is identified by the token",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.CommandAndArgs": {
             "type": "object",
             "properties": {
@@ -1081,6 +2535,13 @@ var doc = `{
                         "$ref": "#/definitions/v1.Dependency"
                     }
                 },
+                "dependsOn": {
+                    "description": "DependsOn represents a list of component names which have to be verified before this component\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "image": {
                     "type": "object",
                     "$ref": "#/definitions/v1.ComponentImage"
@@ -1116,18 +2577,84 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "pattern": {
+                    "description": "+optional",
+                    "type": "string"
+                },
                 "repository": {
                     "type": "string"
                 },
+                "source": {
+                    "description": "Source represents source for checking desired chart version,\nif defined, the chart version will be tracked the same as image version\n+optional",
+                    "type": "string"
+                },
                 "version": {
                     "description": "+optional",
                     "type": "string"
                 }
             }
         },
+        "v1.ComponentDiff": {
+            "type": "object",
+            "properties": {
+                "activeRepository": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "activeVersion": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "preActiveRepository": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "preActiveVersion": {
+                    "description": "+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ComponentGitRef": {
+            "type": "object",
+            "properties": {
+                "branch": {
+                    "description": "Branch represents a git branch which the latest commit will be used",
+                    "type": "string"
+                },
+                "imageSource": {
+                    "description": "ImageSource represents a source for ensuring the generated image tag exists\n+optional",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider represents a provider of git repository, ` + "`" + `github` + "`" + `, ` + "`" + `gitlab` + "`" + ` or ` + "`" + `local` + "`" + `\n+kubebuilder:validation:Enum=github;gitlab;local",
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Repository represents a git repository, e.g. ` + "`" + `agoda-com/samsahai` + "`" + ` for github or gitlab\nand path of bare repository for local",
+                    "type": "string"
+                },
+                "tagTemplate": {
+                    "description": "TagTemplate represents a template for generating image tag from the commit,\n` + "`" + `{{ .Commit }}` + "`" + `, ` + "`" + `{{ .ShortCommit }}` + "`" + ` and ` + "`" + `{{ .Branch }}` + "`" + ` are available, default is ` + "`" + `{{ .Commit }}` + "`" + `\n+optional",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL represents a base url of git server, default is public github or gitlab\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ComponentImage": {
             "type": "object",
             "properties": {
+                "gitRef": {
+                    "description": "GitRef represents a git branch which the latest commit will be used for generating image tag,\nrequired when source is ` + "`" + `git` + "`" + `\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ComponentGitRef"
+                },
                 "pattern": {
                     "description": "+optional",
                     "type": "string"
@@ -1147,9 +2674,30 @@ var doc = `{
                 "type": "object"
             }
         },
+        "v1.ConfigActiveGateway": {
+            "type": "object",
+            "properties": {
+                "ingresses": {
+                    "description": "Ingresses represents ingresses to be created in the gateway namespace backed by the service aliases\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ActiveGatewayIngress"
+                    }
+                },
+                "namespace": {
+                    "description": "Namespace represents a name of the gateway namespace,\nthe namespace is created by samsahai and an existing namespace of other owners is rejected",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ConfigActivePromotion": {
             "type": "object",
             "properties": {
+                "approval": {
+                    "description": "Approval defines approvals required from team owners before switching the pre-active to be active\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigActivePromotionApproval"
+                },
                 "demotionTimeout": {
                     "description": "DemotionTimeout defines maximum duration for doing active demotion\n+optional",
                     "type": "string"
@@ -1159,6 +2707,18 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.ConfigDeploy"
                 },
+                "freezeWindows": {
+                    "description": "FreezeWindows represents recurring code-freeze periods that active promotions are not started\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.MaintenanceWindow"
+                    }
+                },
+                "gateway": {
+                    "description": "Gateway defines a fixed namespace containing aliases of the active namespace services,\nthe aliases are re-pointed to the new active namespace after promoting\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigActiveGateway"
+                },
                 "maxHistories": {
                     "description": "MaxHistories defines maximum length of ActivePromotionHistory stored per team\n+optional",
                     "type": "integer"
@@ -1172,10 +2732,28 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.OutdatedNotification"
                 },
+                "postActiveVerification": {
+                    "description": "PostActiveVerification defines how the new active environment is verified after promoting\nbefore the previous active environment is destroyed, the active promotion is rolled back on failure\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigPostActiveVerification"
+                },
+                "retainedPreviousActives": {
+                    "description": "RetainedPreviousActives defines a number of previous active namespaces to be kept for rollback,\nthe previous active namespace is scaled down to zero replicas instead of being destroyed after TearDownDuration.\nDefault is 0\n+kubebuilder:validation:Minimum=0\n+optional",
+                    "type": "integer"
+                },
                 "rollbackTimeout": {
                     "description": "RollbackTimeout defines maximum duration for rolling back active promotion\n+optional",
                     "type": "string"
                 },
+                "schedule": {
+                    "description": "Schedule defines when the active promotion is created automatically\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionSchedule"
+                },
+                "strategy": {
+                    "description": "Strategy defines how the active environment is promoted.\nDefault is BlueGreen\n+optional",
+                    "type": "string"
+                },
                 "tearDownDuration": {
                     "description": "TearDownDuration defines duration before teardown the previous active namespace\n+optional",
                     "type": "string"
@@ -1186,6 +2764,32 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigActivePromotionApproval": {
+            "type": "object",
+            "properties": {
+                "cancelOnTimeout": {
+                    "description": "CancelOnTimeout defines whether the active promotion is canceled instead of failed\nwhen the approval has been timeout\n+optional",
+                    "type": "boolean"
+                },
+                "minApprovals": {
+                    "description": "MinApprovals defines a number of team owners required to approve the active promotion.\nDefault is 1\n+optional",
+                    "type": "integer"
+                },
+                "timeout": {
+                    "description": "Timeout defines maximum duration for waiting approvals\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConfigBatch": {
+            "type": "object",
+            "properties": {
+                "maxSize": {
+                    "description": "MaxSize defines maximum number of queues which are verified together\n+kubebuilder:validation:Minimum=2",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.ConfigBundles": {
             "type": "object",
             "additionalProperties": {
@@ -1242,6 +2846,37 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigPostActiveVerification": {
+            "type": "object",
+            "properties": {
+                "initialDelay": {
+                    "description": "InitialDelay defines duration to wait after promoting before starting the checks\n+optional",
+                    "type": "string"
+                },
+                "metrics": {
+                    "description": "Metrics defines prometheus queries whose results have to satisfy the thresholds\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PostActiveMetric"
+                    }
+                },
+                "probes": {
+                    "description": "Probes defines http endpoints of the new active environment which have to respond successfully\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PostActiveProbe"
+                    }
+                },
+                "readiness": {
+                    "description": "Readiness defines whether all pods in the new active namespace have to be ready\n+optional",
+                    "type": "boolean"
+                },
+                "timeout": {
+                    "description": "Timeout defines maximum duration for verifying the new active environment.\nDefault is 10m\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.ConfigPullRequest": {
             "type": "object",
             "properties": {
@@ -1297,6 +2932,23 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigQueueAgeing": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "description": "Interval defines how long a queue has to wait to be moved ahead by one order\n+optional",
+                    "type": "string"
+                },
+                "maxWaitTime": {
+                    "description": "MaxWaitTime defines the waiting time SLO of queues,\nthe queues exceeding the maximum waiting time are moved to the top of queues\n+optional",
+                    "type": "string"
+                },
+                "report": {
+                    "description": "Report enables sending a report when a queue exceeds the maximum waiting time\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
         "v1.ConfigReporter": {
             "type": "object",
             "properties": {
@@ -1393,6 +3045,11 @@ var doc = `{
         "v1.ConfigStaging": {
             "type": "object",
             "properties": {
+                "batch": {
+                    "description": "Batch enables verifying multiple waiting component upgrades together,\nthe failure batch will be bisected for finding the failure components\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigBatch"
+                },
                 "deployment": {
                     "description": "Deployment represents configuration about deploy\n+optional",
                     "type": "object",
@@ -1405,6 +3062,11 @@ var doc = `{
                 "maxRetry": {
                     "description": "MaxRetry defines max retry counts of component upgrade\n+optional",
                     "type": "integer"
+                },
+                "queueAgeing": {
+                    "description": "QueueAgeing enables promoting waiting queues by their waiting time,\nso low priority queues are not starved behind priority queues\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigQueueAgeing"
                 }
             }
         },
@@ -1439,6 +3101,13 @@ var doc = `{
                     "description": "+optional",
                     "type": "string"
                 },
+                "stages": {
+                    "description": "Stages defines test stages which are run in order e.g. smoke test then full regression test,\ntest runners above are used by the stages which do not define their own test runners.\nIf stages are not defined, all test runners above are run together\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ConfigTestStage"
+                    }
+                },
                 "teamcity": {
                     "description": "+optional",
                     "type": "object",
@@ -1455,9 +3124,52 @@ var doc = `{
                 }
             }
         },
+        "v1.ConfigTestStage": {
+            "type": "object",
+            "properties": {
+                "continueOnFailure": {
+                    "description": "ContinueOnFailure defines whether the next stages are run when this stage fails,\nthe next stages are skipped by default (fail-fast). The testing result is failed in both cases\n+optional",
+                    "type": "boolean"
+                },
+                "gitlab": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigGitlab"
+                },
+                "name": {
+                    "description": "Name represents a name of test stage",
+                    "type": "string"
+                },
+                "pollingTime": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "teamcity": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTeamcity"
+                },
+                "testMock": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ConfigTestMock"
+                },
+                "timeout": {
+                    "description": "Timeout defines maximum duration of the test stage, timeout of test runner is used if not defined\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.Credential": {
             "type": "object",
             "properties": {
+                "approvers": {
+                    "description": "Approvers represents tokens of team owners for approving active promotions,\nan approver is identified by the token instead of the shared samsahai auth token\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ApproverCredential"
+                    }
+                },
                 "github": {
                     "description": "Github\n+optional",
                     "type": "object",
@@ -1584,6 +3296,31 @@ var doc = `{
                 }
             }
         },
+        "v1.InPlaceRelease": {
+            "type": "object",
+            "properties": {
+                "component": {
+                    "description": "Component represents a parent component name",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Message represents details of the release upgrading\n+optional",
+                    "type": "string"
+                },
+                "previousRevision": {
+                    "description": "PreviousRevision represents a revision of the release before upgrading,\n0 means the release has not been installed\n+optional",
+                    "type": "integer"
+                },
+                "releaseName": {
+                    "description": "ReleaseName represents a release name of the component",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State represents a state of the release upgrading\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1.MSTeamsGroup": {
             "type": "object",
             "properties": {
@@ -1593,21 +3330,115 @@ var doc = `{
                         "type": "string"
                     }
                 },
-                "groupNameOrID": {
+                "groupNameOrID": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.MaintenanceWindow": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Duration represents how long the window is e.g. \"2h\"",
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "Schedule represents a starting time of the window in cron format e.g. \"0 22 * * 5\"",
+                    "type": "string"
+                },
+                "timeZone": {
+                    "description": "TimeZone represents a time zone of the schedule e.g. \"Asia/Bangkok\".\nDefault is UTC\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.OutdatedActivePromotionStatus": {
+            "type": "object",
+            "properties": {
+                "lastForcedAt": {
+                    "description": "LastForcedAt represents the last time that the active promotion has been forced\n+optional",
+                    "type": "string"
+                },
+                "skippedRuns": {
+                    "description": "SkippedRuns represents the latest forced runs which did not create the active promotion\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SkippedActivePromotion"
+                    }
+                }
+            }
+        },
+        "v1.OutdatedNotification": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "description": "Components defines outdated policies per component which override the team policies\n+optional",
+                    "type": "object"
+                },
+                "exceedDuration": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "excludeWeekendCalculation": {
+                    "description": "+optional",
+                    "type": "boolean"
+                },
+                "forcedPromotionBackoff": {
+                    "description": "ForcedPromotionBackoff defines how long the active promotion is not forced again\nafter the forced active promotion failed. Default is MaxOutdatedDuration\n+optional",
+                    "type": "string"
+                },
+                "holidays": {
+                    "description": "Holidays defines dates in YYYY-MM-DD format which are excluded from outdated duration calculation\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxOutdatedDuration": {
+                    "description": "MaxOutdatedDuration defines a maximum outdated duration of active components,\nan active promotion is created automatically when any component exceeds this duration\n+optional",
+                    "type": "string"
+                },
+                "warningDuration": {
+                    "description": "WarningDuration defines an outdated duration that a warning is raised\nwhen the active promotion would leave any component outdated longer than this duration\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PostActiveMetric": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name represents a name of the metric check",
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "Operator represents how the query result is compared with the threshold\n+kubebuilder:validation:Enum=\"\u003c\";\"\u003c=\";\"\u003e\";\"\u003e=\";\"==\";\"!=\"",
+                    "type": "string"
+                },
+                "query": {
+                    "description": "Query represents a prometheus query, supports template e.g. \"sum(rate(errors{namespace='{{ .Namespace }}'}[1m]))\".\nEvery returned sample has to satisfy the threshold, an empty result is considered as failure",
+                    "type": "string"
+                },
+                "threshold": {
+                    "description": "Threshold represents a number which the query result is compared with e.g. \"0.05\"",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "URL represents a base url of the prometheus server e.g. \"http://prometheus.monitoring:9090\"",
                     "type": "string"
                 }
             }
         },
-        "v1.OutdatedNotification": {
+        "v1.PostActiveProbe": {
             "type": "object",
             "properties": {
-                "exceedDuration": {
-                    "description": "+optional",
-                    "type": "string"
+                "expectedStatusCode": {
+                    "description": "ExpectedStatusCode represents an expected http status code, any 2xx status code is accepted if not defined\n+optional",
+                    "type": "integer"
                 },
-                "excludeWeekendCalculation": {
-                    "description": "+optional",
-                    "type": "boolean"
+                "url": {
+                    "description": "URL represents a http endpoint, supports template e.g. \"http://app.{{ .Namespace }}/healthz\"",
+                    "type": "string"
                 }
             }
         },
@@ -1871,9 +3702,31 @@ var doc = `{
                 }
             }
         },
+        "v1.QueueBatchItem": {
+            "type": "object",
+            "properties": {
+                "bundle": {
+                    "description": "Bundle represents a bundle name of original queue\n+optional",
+                    "type": "string"
+                },
+                "components": {
+                    "description": "Components represents a list of components of original queue",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.QueueComponents"
+                },
+                "name": {
+                    "description": "Name represents a Component name or bundle name of original queue",
+                    "type": "string"
+                }
+            }
+        },
         "v1.QueueComponent": {
             "type": "object",
             "properties": {
+                "chartVersion": {
+                    "description": "ChartVersion represents Helm chart version, empty means using chart version in config\n+optional",
+                    "type": "string"
+                },
                 "name": {
                     "description": "Name represents Component name",
                     "type": "string"
@@ -1893,6 +3746,10 @@ var doc = `{
             "items": {
                 "type": "object",
                 "properties": {
+                    "chartVersion": {
+                        "description": "ChartVersion represents Helm chart version, empty means using chart version in config\n+optional",
+                        "type": "string"
+                    },
                     "name": {
                         "description": "Name represents Component name",
                         "type": "string"
@@ -1981,6 +3838,13 @@ var doc = `{
         "v1.QueueSpec": {
             "type": "object",
             "properties": {
+                "batch": {
+                    "description": "Batch represents original queues which are verified together in this queue,\na queue containing only one item is a result of bisecting the failure batch\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.QueueBatchItem"
+                    }
+                },
                 "bundle": {
                     "description": "Bundle represents a bundle name of component\n+optional",
                     "type": "string"
@@ -2006,6 +3870,10 @@ var doc = `{
                     "description": "NoOfRetry defines how many times this component has been tested\n+optional",
                     "type": "integer"
                 },
+                "pin": {
+                    "description": "Pin represents a flag for pinning the component versions as stable after the queue has been verified,\nthe pinned components will not be upgraded by new versions from checkers until they are unpinned\n+optional",
+                    "type": "boolean"
+                },
                 "prNumber": {
                     "description": "PRNumber represents a pull request number\n+optional",
                     "type": "string"
@@ -2072,6 +3940,10 @@ var doc = `{
                     "description": "QueueHistoryName defines name of history of this queue",
                     "type": "string"
                 },
+                "stagingNamespace": {
+                    "description": "StagingNamespace represents the staging slot namespace which this queue has been picked by\n+optional",
+                    "type": "string"
+                },
                 "startDeployTime": {
                     "description": "StartDeployTime represents the time when this queue start deploying",
                     "type": "string"
@@ -2089,9 +3961,82 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.TestRunner"
                 },
+                "testStages": {
+                    "description": "TestStages represents results of test stages following the order in test runner configuration\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.QueueTestStage"
+                    }
+                },
                 "updatedAt": {
                     "description": "UpdatedAt represents time when the component was processed",
                     "type": "string"
+                },
+                "waitSLOBreachedAt": {
+                    "description": "WaitSLOBreachedAt represents time when the queue has exceeded the maximum waiting time\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.QueueTestStage": {
+            "type": "object",
+            "properties": {
+                "finishedAt": {
+                    "description": "FinishedAt represents the time when the stage has been finished\n+optional",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Message represents a detail of the result\n+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name represents a name of test stage",
+                    "type": "string"
+                },
+                "result": {
+                    "description": "Result represents a result of test stage, empty means the stage is being tested\n+optional",
+                    "type": "string"
+                },
+                "startedAt": {
+                    "description": "StartedAt represents the time when the stage has been started\n+optional",
+                    "type": "string"
+                },
+                "testRunners": {
+                    "description": "TestRunner defines the test runner of the stage\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TestRunner"
+                },
+                "triggeredAt": {
+                    "description": "TriggeredAt represents the time when test runners of the stage have been triggered\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReleaseDiff": {
+            "type": "object",
+            "properties": {
+                "activeChartVersion": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "component": {
+                    "description": "Component represents a parent component name of the release",
+                    "type": "string"
+                },
+                "isValuesTruncated": {
+                    "description": "IsValuesTruncated defines whether the values diff has been truncated due to its size\n+optional",
+                    "type": "boolean"
+                },
+                "preActiveChartVersion": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "values": {
+                    "description": "Values represents rendered values which are different, values are in json format\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ValuesDiff"
+                    }
                 }
             }
         },
@@ -2153,6 +4098,11 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.RestObject"
                 },
+                "activePromotionApproval": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.RestObject"
+                },
                 "componentUpgrade": {
                     "description": "+optional",
                     "type": "object",
@@ -2172,6 +4122,16 @@ var doc = `{
                     "description": "+optional",
                     "type": "object",
                     "$ref": "#/definitions/v1.RestObject"
+                },
+                "queueAction": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.RestObject"
+                },
+                "queueWaitSLOBreached": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.RestObject"
                 }
             }
         },
@@ -2188,6 +4148,11 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.CommandAndArgs"
                 },
+                "activePromotionApproval": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.CommandAndArgs"
+                },
                 "componentUpgrade": {
                     "description": "+optional",
                     "type": "object",
@@ -2207,6 +4172,16 @@ var doc = `{
                     "description": "+optional",
                     "type": "object",
                     "$ref": "#/definitions/v1.CommandAndArgs"
+                },
+                "queueAction": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.CommandAndArgs"
+                },
+                "queueWaitSLOBreached": {
+                    "description": "+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.CommandAndArgs"
                 }
             }
         },
@@ -2247,6 +4222,19 @@ var doc = `{
                 }
             }
         },
+        "v1.SkippedActivePromotion": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "description": "Reason represents why the active promotion was not created",
+                    "type": "string"
+                },
+                "scheduledAt": {
+                    "description": "ScheduledAt represents the time that the active promotion was scheduled",
+                    "type": "string"
+                }
+            }
+        },
         "v1.StableComponent": {
             "type": "object",
             "properties": {
@@ -2263,10 +4251,18 @@ var doc = `{
         "v1.StableComponentSpec": {
             "type": "object",
             "properties": {
+                "chartVersion": {
+                    "description": "ChartVersion represents Helm chart version, empty means using chart version in config\n+optional",
+                    "type": "string"
+                },
                 "name": {
                     "description": "Name represents Component name",
                     "type": "string"
                 },
+                "pinned": {
+                    "description": "Pinned represents whether the version is pinned,\nthe pinned component will not be upgraded by new versions from checkers until it is unpinned\n+optional",
+                    "type": "boolean"
+                },
                 "repository": {
                     "description": "Repository represents Docker image repository",
                     "type": "string"
@@ -2307,6 +4303,10 @@ var doc = `{
                     "description": "IsDeploy represents flag to deploy staging controller or not.",
                     "type": "boolean"
                 },
+                "replicas": {
+                    "description": "Replicas represents number of the staging controller replicas,\nthe replicas elect a leader which processes queues if there is more than one replica\n+optional",
+                    "type": "integer"
+                },
                 "resources": {
                     "type": "string"
                 }
@@ -2357,9 +4357,39 @@ var doc = `{
                         "type": "string"
                     }
                 },
+                "retainedActives": {
+                    "description": "RetainedActives represents previous active namespaces which are scaled down for instant rollback,\nthe latest one comes first\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "staging": {
                     "description": "+optional",
                     "type": "string"
+                },
+                "stagingSlots": {
+                    "description": "StagingSlots represents additional staging namespaces for verifying queues in parallel\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.TeamQueue": {
+            "type": "object",
+            "properties": {
+                "maintenanceWindows": {
+                    "description": "MaintenanceWindows represents recurring periods that the team queues are paused\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.MaintenanceWindow"
+                    }
+                },
+                "paused": {
+                    "description": "Paused represents whether the team queues are paused or not\n+optional",
+                    "type": "boolean"
                 }
             }
         },
@@ -2382,6 +4412,11 @@ var doc = `{
                         "type": "string"
                     }
                 },
+                "queue": {
+                    "description": "Queue represents configuration about pausing the team queues\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.TeamQueue"
+                },
                 "resources": {
                     "description": "Resources represents how many resources per namespace for the team\n+optional",
                     "type": "string"
@@ -2390,6 +4425,10 @@ var doc = `{
                     "description": "StagingCtrl represents configuration about the staging controller.\nFor easier for developing, debugging and testing purposes\n+optional",
                     "type": "object",
                     "$ref": "#/definitions/v1.StagingCtrl"
+                },
+                "stagingSlots": {
+                    "description": "StagingSlots represents number of staging namespaces for verifying queues in parallel,\nthe staging namespace is the first slot and the others are created as ` + "`" + `\u003cstaging-namespace\u003e-slot-\u003cno\u003e` + "`" + `.\nDefault is 1\n+kubebuilder:validation:Minimum=1\n+optional",
+                    "type": "integer"
                 }
             }
         },
@@ -2408,6 +4447,15 @@ var doc = `{
                     "description": "ActivePromotedBy represents a person who promoted the ActivePromotion\n+optional",
                     "type": "string"
                 },
+                "activePromotionSchedule": {
+                    "description": "ActivePromotionSchedule represents last and next scheduled active promotion times\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.ActivePromotionScheduleStatus"
+                },
+                "componentVersionPolling": {
+                    "description": "ComponentVersionPolling represents last and next scheduled version polling times of components\nmap[componentName] = polling times\n+optional",
+                    "type": "object"
+                },
                 "conditions": {
                     "description": "Conditions contains observations of the resource's state e.g.,\nTeam namespace is created, destroyed\n+optional\n+patchMergeKey=type\n+patchStrategy=merge",
                     "type": "array",
@@ -2424,6 +4472,11 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/v1.TeamNamespace"
                 },
+                "outdatedActivePromotion": {
+                    "description": "OutdatedActivePromotion represents active promotions forced by outdated components\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/v1.OutdatedActivePromotionStatus"
+                },
                 "stableComponents": {
                     "description": "StableComponentList represents a list of stable components\n+optional",
                     "type": "object"
@@ -2501,6 +4554,23 @@ var doc = `{
                 "type": "object"
             }
         },
+        "v1.ValuesDiff": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "+optional",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path represents a path of the value e.g. \"image.tag\"",
+                    "type": "string"
+                },
+                "preActive": {
+                    "description": "+optional",
+                    "type": "string"
+                }
+            }
+        },
         "webhook.Components": {
             "type": "object",
             "properties": {
@@ -2551,6 +4621,50 @@ var doc = `{
                 }
             }
         },
+        "webhook.createActivePromotionJSON": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "description": "Components represents names of components to be promoted, all components are promoted if not defined",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "promotedBy": {
+                    "description": "PromotedBy represents a person who promotes the active environment",
+                    "type": "string"
+                },
+                "skipTest": {
+                    "description": "SkipTest represents a flag for skipping running pre-active test",
+                    "type": "boolean"
+                },
+                "tearDownDuration": {
+                    "description": "TearDownDuration represents duration before tear down the previous active namespace",
+                    "type": "string",
+                    "example": "30m"
+                }
+            }
+        },
+        "webhook.enqueueComponentJSON": {
+            "type": "object",
+            "properties": {
+                "by": {
+                    "description": "By represents a person who applies the action\n+optional",
+                    "type": "string"
+                },
+                "component": {
+                    "type": "string"
+                },
+                "skipTestRunner": {
+                    "description": "SkipTestRunner represents a flag for skipping running test\n+optional",
+                    "type": "boolean"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
         "webhook.errResp": {
             "type": "object",
             "properties": {
@@ -2582,6 +4696,26 @@ var doc = `{
                 }
             }
         },
+        "webhook.pinComponentJSON": {
+            "type": "object",
+            "properties": {
+                "by": {
+                    "description": "By represents a person who applies the action\n+optional",
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Repository represents Docker image repository, empty means using repository in config\n+optional",
+                    "type": "string"
+                },
+                "skipTestRunner": {
+                    "description": "SkipTestRunner represents a flag for skipping running test\n+optional",
+                    "type": "boolean"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
         "webhook.pullRequestWebhookEventJSON": {
             "type": "object",
             "properties": {
@@ -2602,6 +4736,19 @@ var doc = `{
                 }
             }
         },
+        "webhook.queueActionJSON": {
+            "type": "object",
+            "properties": {
+                "by": {
+                    "description": "By represents a person who applies the action\n+optional",
+                    "type": "string"
+                },
+                "queue": {
+                    "description": "Queue represents a name of queue",
+                    "type": "string"
+                }
+            }
+        },
         "webhook.teamActivePromotion": {
             "type": "object",
             "properties": {
@@ -2639,6 +4786,13 @@ var doc = `{
                             "$ref": "#/definitions/v1.Dependency"
                         }
                     },
+                    "dependsOn": {
+                        "description": "DependsOn represents a list of component names which have to be verified before this component\n+optional",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    },
                     "image": {
                         "type": "object",
                         "$ref": "#/definitions/v1.ComponentImage"
@@ -2716,6 +4870,13 @@ var doc = `{
                         "type": "string"
                     }
                 },
+                "retainedActives": {
+                    "description": "RetainedActives represents previous active namespaces which are scaled down for instant rollback,\nthe latest one comes first\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "spec": {
                     "type": "object",
                     "$ref": "#/definitions/v1.TeamSpec"
@@ -2724,6 +4885,13 @@ var doc = `{
                     "description": "+optional",
                     "type": "string"
                 },
+                "stagingSlots": {
+                    "description": "StagingSlots represents additional staging namespaces for verifying queues in parallel\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "status": {
                     "type": "object",
                     "$ref": "#/definitions/v1.TeamStatus"
@@ -2778,6 +4946,14 @@ var doc = `{
                     "description": "+optional",
                     "type": "integer"
                 },
+                "paused": {
+                    "description": "Paused represents whether new queues are not started due to pausing or maintenance windows",
+                    "type": "boolean"
+                },
+                "pausedReason": {
+                    "description": "PausedReason represents why the queues are paused e.g. paused, maintenance\n+optional",
+                    "type": "string"
+                },
                 "queues": {
                     "description": "+Optional",
                     "type": "array",
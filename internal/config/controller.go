@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	cr "sigs.k8s.io/controller-runtime"
@@ -36,8 +37,8 @@ const (
 	ctrlName                = "config-ctrl"
 	maxConcurrentReconciles = 1
 
-	webhookAPI                 = "webhook/component"
-	successfulJobsHistoryLimit = int32(0)
+	// cronJobNameLabel is a label of CronJobs which were created for component schedules
+	cronJobNameLabel = "cronjob-name"
 )
 
 type controller struct {
@@ -381,13 +382,6 @@ func teamNameRendering(teamName, values string) []byte {
 	))
 }
 
-func (c *controller) createCronJob(cronJob batchv1beta1.CronJob) error {
-	if err := c.client.Create(context.TODO(), &cronJob); err != nil {
-		return err
-	}
-	return nil
-}
-
 func (c *controller) deleteCronJobAndMatchingJobs(cronJob batchv1beta1.CronJob) error {
 	ctx := context.TODO()
 	jobList := &batchv1.JobList{}
@@ -410,142 +404,6 @@ func (c *controller) deleteCronJobAndMatchingJobs(cronJob batchv1beta1.CronJob)
 	return nil
 }
 
-func (c *controller) getCreatingCronJobs(namespace, teamName string, comp s2hv1.Component,
-	cronJobList *batchv1beta1.CronJobList) []batchv1beta1.CronJob {
-
-	creatingCronJobs := make([]batchv1beta1.CronJob, 0)
-	uniqueCreatingCronJobs := map[string]batchv1beta1.CronJob{}
-	cronJobCmd := c.getCronJobCmd(comp.Name, teamName, comp.Image.Repository)
-
-	for _, schedule := range comp.Schedules {
-		isCronJobChanged := true
-
-		for _, cj := range cronJobList.Items {
-			if schedule == cj.Spec.Schedule {
-				argList := cj.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args
-				for _, arg := range argList {
-					if !strings.Contains(arg, cronJobCmd) {
-						isCronJobChanged = true
-					} else {
-						isCronJobChanged = false
-					}
-				}
-			}
-		}
-		if isCronJobChanged {
-			cronJobName := comp.Name + "-checker-" + c.getCronJobSuffix(schedule)
-			cronJob := c.generateCronJob(cronJobName, cronJobCmd, schedule, comp.Name, namespace, teamName)
-
-			if _, ok := uniqueCreatingCronJobs[schedule]; !ok {
-				uniqueCreatingCronJobs[schedule] = cronJob
-			}
-		}
-	}
-
-	for _, v := range uniqueCreatingCronJobs {
-		creatingCronJobs = append(creatingCronJobs, v)
-	}
-
-	return creatingCronJobs
-}
-
-func (c *controller) generateCronJob(cronJobName, cronJobCmd, schedule, compName, namespace, teamName string) batchv1beta1.CronJob {
-	successfulJobsHistoryLimit := successfulJobsHistoryLimit
-	cronJobLabels := c.getCronJobLabels(cronJobName, teamName, compName)
-	cronJobDefaultArgs := []string{"/bin/sh", "-c", cronJobCmd}
-	cronJob := batchv1beta1.CronJob{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      cronJobName,
-			Namespace: namespace,
-			Labels:    cronJobLabels,
-		},
-		Spec: batchv1beta1.CronJobSpec{
-			SuccessfulJobsHistoryLimit: &successfulJobsHistoryLimit,
-			Schedule:                   schedule,
-			JobTemplate: batchv1beta1.JobTemplateSpec{
-				Spec: batchv1.JobSpec{
-					Template: corev1.PodTemplateSpec{
-						ObjectMeta: metav1.ObjectMeta{
-							Labels: cronJobLabels,
-						},
-						Spec: corev1.PodSpec{
-							Containers: []corev1.Container{
-								{
-									Name:      "component-checker",
-									Image:     "quay.io/samsahai/curl:latest",
-									Args:      cronJobDefaultArgs,
-									Resources: c.getCronJobResources(),
-								},
-							},
-							RestartPolicy: "OnFailure",
-						},
-					},
-				},
-			},
-		},
-	}
-
-	return cronJob
-}
-
-func (c *controller) getDeletingCronJobs(teamName string, comp s2hv1.Component,
-	cronJobList *batchv1beta1.CronJobList) []batchv1beta1.CronJob {
-
-	deletingCronJobObjs := make([]batchv1beta1.CronJob, 0)
-	cronJobCmd := c.getCronJobCmd(comp.Name, teamName, comp.Image.Repository)
-
-	for _, cj := range cronJobList.Items {
-		isCronJobChanged := true
-		for _, schedule := range comp.Schedules {
-			if schedule == cj.Spec.Schedule {
-				argList := cj.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Args
-				for _, arg := range argList {
-					if !strings.Contains(arg, cronJobCmd) {
-						isCronJobChanged = true
-					} else {
-						isCronJobChanged = false
-					}
-				}
-			}
-		}
-		if isCronJobChanged {
-			deletingCronJobObjs = append(deletingCronJobObjs, cj)
-		}
-	}
-	return deletingCronJobObjs
-}
-
-func (c *controller) getUpdatedCronJobs(namespace, teamName string, comp *s2hv1.Component,
-	cronJobList *batchv1beta1.CronJobList) ([]batchv1beta1.CronJob, []batchv1beta1.CronJob) {
-
-	creatingCronJobObjs := c.getCreatingCronJobs(namespace, teamName, *comp, cronJobList)
-	deletingCronJobObjs := c.getDeletingCronJobs(teamName, *comp, cronJobList)
-
-	return creatingCronJobObjs, deletingCronJobObjs
-}
-
-func (c *controller) getCronJobCmd(compName, teamName, imageRepo string) string {
-	return fmt.Sprintf(`set -eux
-curl -X POST -k %s/%s -d '{"component": "%s", "team": "%s", "repository": "%s"}'
-`, c.s2hConfig.SamsahaiExternalURL, webhookAPI, compName, teamName, imageRepo)
-}
-
-func (c *controller) getCronJobResources() corev1.ResourceRequirements {
-	resources := c.s2hConfig.CheckerResources
-	return corev1.ResourceRequirements{
-		Limits:   resources,
-		Requests: resources,
-	}
-}
-
-func (c *controller) getCronJobLabels(cronJobName, teamName, compName string) map[string]string {
-	cronJobLabels := internal.GetDefaultLabels(teamName)
-	cronJobLabels["cronjob-name"] = cronJobName
-	cronJobLabels["component"] = compName
-
-	return cronJobLabels
-}
-
 // assignParent assigns Parent to SubComponent
 // only support 1 level of dependencies
 func (c *controller) assignParent(config *s2hv1.ConfigSpec) {
@@ -591,7 +449,7 @@ func (c *controller) ensureConfigChanged(teamName, namespace string) error {
 		return err
 	}
 
-	if err := c.detectSchedulerChanged(comps, teamName, namespace); err != nil {
+	if err := c.deleteComponentCronJobs(teamName, namespace); err != nil {
 		return err
 	}
 
@@ -638,39 +496,36 @@ func (c *controller) EnsureConfigTemplateChanged(config *s2hv1.Config) error {
 	return nil
 }
 
-func (c *controller) detectSchedulerChanged(comps map[string]*s2hv1.Component, teamName, namespace string) error {
+// deleteComponentCronJobs deletes CronJobs of component schedules which were created by the previous versions,
+// scheduled components are now polled by samsahai controller
+func (c *controller) deleteComponentCronJobs(teamName, namespace string) error {
 	ctx := context.TODO()
-	for _, comp := range comps {
-		cronJobList := &batchv1beta1.CronJobList{}
-		componentLabel := labels.SelectorFromSet(map[string]string{"component": comp.Name})
-		listOption := &client.ListOptions{Namespace: namespace, LabelSelector: componentLabel}
-		err := c.client.List(ctx, cronJobList, listOption)
-		if err != nil {
-			logger.Error(err, "cannot list cronJob ", "component", comp.Name)
-			return err
+	cronJobList := &batchv1beta1.CronJobList{}
+	teamLabel := labels.SelectorFromSet(internal.GetDefaultLabels(teamName))
+	listOption := &client.ListOptions{Namespace: namespace, LabelSelector: teamLabel}
+	if err := c.client.List(ctx, cronJobList, listOption); err != nil {
+		if meta.IsNoMatchError(err) {
+			// CronJob API has been removed from the cluster
+			return nil
 		}
+		logger.Error(err, "cannot list cronJobs", "namespace", namespace)
+		return err
+	}
 
-		creatingCronJobObjs, deletingCronJobObjs := c.getUpdatedCronJobs(namespace, teamName, comp, cronJobList)
-		if len(deletingCronJobObjs) > 0 {
-			for _, obj := range deletingCronJobObjs {
-				err := c.deleteCronJobAndMatchingJobs(obj)
-				if err != nil && !k8serrors.IsNotFound(err) {
-					logger.Error(err, "cannot delete cronJob", "component", obj.Name)
-					return err
-				}
-			}
+	for _, cj := range cronJobList.Items {
+		if _, ok := cj.Labels[cronJobNameLabel]; !ok {
+			continue
 		}
 
-		if len(creatingCronJobObjs) > 0 {
-			for _, obj := range creatingCronJobObjs {
-				err := c.createCronJob(obj)
-				if err != nil && !k8serrors.IsAlreadyExists(err) {
-					logger.Error(err, "cannot create cronJob", "component", obj.Name)
-					return err
-				}
-			}
+		err := c.deleteCronJobAndMatchingJobs(cj)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot delete cronJob", "name", cj.Name)
+			return err
 		}
+
+		logger.Debug("component cronJob has been removed", "namespace", namespace, "name", cj.Name)
 	}
+
 	return nil
 }
 
@@ -793,13 +648,6 @@ func (c *controller) detectRemovedStableComponents(comps map[string]*s2hv1.Compo
 	return nil
 }
 
-func (c *controller) getCronJobSuffix(schedule string) string {
-	suffix := strings.Replace(schedule, " ", "x", -1)
-	suffix = strings.Replace(suffix, "*", "", -1)
-
-	return suffix
-}
-
 func (c *controller) updateChildrenConfig(config s2hv1.Config) error {
 	if err := c.Update(&config); err != nil {
 		return err
@@ -843,6 +691,109 @@ func ValidateConfigRequiredField(config *s2hv1.Config) error {
 	return nil
 }
 
+// ValidateComponentDependencies validates that `dependsOn` of components refers to existing components
+// and does not contain any cycle, components of the same bundle are deployed in the same queue
+// so that they are validated as a single node
+func ValidateComponentDependencies(config *s2hv1.Config) error {
+	compNames := make(map[string]bool)
+	for _, comp := range config.Status.Used.Components {
+		if comp != nil {
+			compNames[comp.Name] = true
+		}
+	}
+
+	dependencies := config.Status.Used.GetComponentDependencies()
+	for compName, depNames := range dependencies {
+		for _, depName := range depNames {
+			if !compNames[depName] {
+				return errors.Wrapf(errors.ErrComponentDependencyUnknown,
+					"component %s depends on %s", compName, depName)
+			}
+		}
+	}
+
+	// expand bundle membership, a component in a bundle is represented by its bundle
+	nodeOf := func(compName string) string { return compName }
+	if len(config.Status.Used.Bundles) > 0 {
+		bundleOf := make(map[string]string)
+		for bundleName, bundleComps := range config.Status.Used.Bundles {
+			for _, compName := range bundleComps {
+				bundleOf[compName] = bundleName
+			}
+		}
+		nodeOf = func(compName string) string {
+			if bundleName, ok := bundleOf[compName]; ok {
+				return bundleName
+			}
+			return compName
+		}
+	}
+
+	nodeDependencies := make(map[string][]string)
+	for compName, depNames := range dependencies {
+		node := nodeOf(compName)
+		for _, depName := range depNames {
+			// dependencies within the same bundle are deployed together
+			if depNode := nodeOf(depName); depNode != node {
+				nodeDependencies[node] = append(nodeDependencies[node], depNode)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	states := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch states[name] {
+		case visiting:
+			return errors.Wrapf(errors.ErrComponentDependencyCycle,
+				"%s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		states[name] = visiting
+		for _, depName := range nodeDependencies[name] {
+			if err := visit(depName, append(path, name)); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+
+		return nil
+	}
+
+	nodeList := make([]string, 0)
+	for node := range nodeDependencies {
+		nodeList = append(nodeList, node)
+	}
+	sort.Strings(nodeList)
+
+	for _, node := range nodeList {
+		if err := visit(node, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isConditionFalseWithMessage returns true if the condition has already been set to false with the message
+func isConditionFalseWithMessage(config *s2hv1.Config, cond s2hv1.ConfigConditionType, message string) bool {
+	for _, c := range config.Status.Conditions {
+		if c.Type == cond {
+			return c.Status == corev1.ConditionFalse && c.Message == message
+		}
+	}
+
+	return false
+}
+
 func applyConfigTemplate(config, configTemplate *s2hv1.Config) error {
 	config.Status.Used = config.Spec
 	if err := mergo.Merge(&config.Status.Used, configTemplate.Spec); err != nil {
@@ -917,6 +868,37 @@ func (c *controller) Reconcile(req cr.Request) (cr.Result, error) {
 		return cr.Result{}, nil
 	}
 
+	if err := ValidateComponentDependencies(configComp); err != nil {
+		logger.Error(err, "cannot validate component dependencies of config", "team", req.Name)
+		if isConditionFalseWithMessage(configComp, s2hv1.ConfigComponentDependenciesValidated, err.Error()) {
+			return cr.Result{}, nil
+		}
+
+		configComp.Status.SetCondition(
+			s2hv1.ConfigComponentDependenciesValidated,
+			corev1.ConditionFalse,
+			err.Error())
+
+		if err := c.Update(configComp); err != nil {
+			return reconcile.Result{}, errors.Wrap(err,
+				"cannot update config conditions when component dependencies are invalid")
+		}
+		return cr.Result{}, nil
+	}
+
+	if !configComp.Status.IsConditionTrue(s2hv1.ConfigComponentDependenciesValidated) {
+		configComp.Status.SetCondition(
+			s2hv1.ConfigComponentDependenciesValidated,
+			corev1.ConditionTrue,
+			"validate component dependencies successfully")
+
+		if err := c.Update(configComp); err != nil {
+			return reconcile.Result{}, errors.Wrap(err,
+				"cannot update config conditions when component dependencies are valid")
+		}
+		return cr.Result{}, nil
+	}
+
 	teamComp := s2hv1.Team{}
 	if err := c.s2hCtrl.GetTeam(req.Name, &teamComp); err != nil {
 		logger.Error(err, "cannot get team", "team", req.Name)
@@ -20,6 +20,8 @@ const (
 
 	StagingCtrlName    = "s2h-staging-ctrl"
 	StagingDefaultPort = 8090
+	// StagingCtrlLeaderLabel represents the pod label of the elected staging controller replica
+	StagingCtrlLeaderLabel = "samsahai.io/staging-leader"
 
 	ResourcesQuotaSuffix = "-resources"
 
@@ -43,6 +45,7 @@ const (
 	VKGitlabURL                       = "gitlab-url"
 	VKGitlabToken                     = "gitlab-token"
 	VKSlackToken                      = "slack-token"
+	VKRegistryWebhookSecret           = "registry-webhook-secret"
 	VKGithubURL                       = "github-url"
 	VKGithubToken                     = "github-token"
 	VKMSTeamsTenantID                 = "ms-teams-tenant-id"
@@ -59,13 +62,17 @@ const (
 	VKActivePromotionMaxHistories     = "active-promotion-max-histories"
 	VKActivePromotionOnTeamCreation   = "active-promotion-on-team-creation"
 	VKQueueMaxHistoryDays             = "queue-max-history-days"
+	VKStagingSlots                    = "staging-slots"
+	VKStagingLeaderElection           = "leader-election"
+	VKPodName                         = "pod-name"
 	VKPRQueueConcurrences             = "pr-queue-concurrences"
 	VKPRVerificationMaxRetry          = "pr-verification-max-retry"
 	VKPRTriggerMaxRetry               = "pr-trigger-max-retry"
 	VKPRTriggerPollingTime            = "pr-trigger-polling-time"
 	VKPullRequestQueueMaxHistoryDays  = "pr-queue-max-history-days"
-	VKCheckerCPU                      = "checker-cpu"
-	VKCheckerMemory                   = "checker-memory"
+	VKVersionPollingInterval          = "version-polling-interval"
+	VKVersionPollingConcurrences      = "version-polling-concurrences"
+	VKVersionPollingMaxJitter         = "version-polling-max-jitter"
 	VKInitialResourcesQuotaCPU        = "initial-resources-quota-cpu"
 	VKInitialResourcesQuotaMemory     = "initial-resources-quota-memory"
 )
@@ -1,5 +1,9 @@
 package internal
 
+import (
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
 // DesiredComponentChecker represents standard interface for checking component version
 type DesiredComponentChecker interface {
 	// GetName returns name of checker
@@ -12,5 +16,13 @@ type DesiredComponentChecker interface {
 	EnsureVersion(repository string, name string, version string) error
 }
 
+// DesiredComponentGitChecker represents interface for checking component version from git branch
+type DesiredComponentGitChecker interface {
+	DesiredComponentChecker
+
+	// GetGitRefVersion returns image tag generated from the latest commit of git branch
+	GetGitRefVersion(repository string, name string, gitRef s2hv1.ComponentGitRef) (string, error)
+}
+
 type DesiredComponentController interface {
 }
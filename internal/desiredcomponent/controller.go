@@ -91,6 +91,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 // +kubebuilder:rbac:groups=env.samsahai.io,resources=desiredcomponents/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=env.samsahai.io,resources=queues,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=env.samsahai.io,resources=queues/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=env.samsahai.io,resources=stablecomponents,verbs=get;list;watch
 func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error) {
 	ctx := context.TODO()
 
@@ -114,6 +115,17 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 		comp.Status.UpdatedAt = &now
 	}
 
+	stableComp := &s2hv1.StableComponent{}
+	err = c.client.Get(ctx, req.NamespacedName, stableComp)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	} else if err == nil && stableComp.Spec.Pinned {
+		// the pinned component will be queued again when it is unpinned
+		logger.Debug(fmt.Sprintf("%s is pinned to %s:%s, ignore %s:%s", comp.Spec.Name,
+			stableComp.Spec.Repository, stableComp.Spec.Version, comp.Spec.Repository, comp.Spec.Version))
+		return reconcile.Result{}, nil
+	}
+
 	logger.Debug(fmt.Sprintf("add %s (%s:%s) to queue", comp.Spec.Name, comp.Spec.Repository, comp.Spec.Version))
 
 	headers := make(http.Header)
@@ -138,9 +150,10 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 
 	comps := []*s2hv1.QueueComponent{
 		{
-			Name:       comp.Spec.Name,
-			Repository: comp.Spec.Repository,
-			Version:    comp.Spec.Version,
+			Name:         comp.Spec.Name,
+			Repository:   comp.Spec.Repository,
+			Version:      comp.Spec.Version,
+			ChartVersion: comp.Spec.ChartVersion,
 		},
 	}
 	q := queue.NewQueue(c.teamName, req.Namespace, comp.Spec.Name, bundle.Name, comps, s2hv1.QueueTypeUpgrade)
@@ -39,6 +39,8 @@ const (
 	ErrForceDeletingComponents           = Error("force deleting components")
 	ErrRollingBackActivePromotion        = Error("rolling back active promotion process")
 	ErrEnsureStableComponentsDestroyed   = Error("all stable components has not been destroyed")
+	ErrEnsureActivePromotionApproved     = Error("active promotion is waiting for approvals")
+	ErrEnsureActiveEnvironmentVerified   = Error("new active environment has not been verified")
 
 	ErrPullRequestBundleNotFound = Error("pull request bundle name not found in configuration")
 
@@ -50,6 +52,23 @@ const (
 
 	ErrTestConfigurationNotFound  = Error("test configuration not found")
 	ErrConfigurationRequiredField = Error("required filed cannot be empty")
+	ErrComponentDependencyCycle   = Error("component dependencies cannot be cyclic")
+	ErrComponentDependencyUnknown = Error("component dependency not found in configuration")
+
+	ErrQueueActionNotAllowed = Error("queue action is not allowed in current queue state")
+	ErrNoFailedQueueHistory  = Error("failed queue history not found")
+	ErrComponentNotFound     = Error("component not found in configuration")
+
+	ErrActivePromotionNotWaitingForApproval = Error("active promotion is not waiting for approvals")
+	ErrActivePromotionApproverNotOwner      = Error("approver is not an owner of the team")
+	ErrActivePromotionApproverUnauthorized  = Error("approver token does not belong to any team owner")
+	ErrActivePromotionCannotBeCanceled      = Error("active promotion cannot be canceled in current state")
+	ErrActivePromotionInFreezeWindow        = Error("active promotion cannot be created in code-freeze window")
+	ErrNoFailedActivePromotionHistory       = Error("failed active promotion history not found")
+	ErrActiveEnvironmentRollbackNotAllowed  = Error("active environment cannot be rolled back while active promotion is running")
+	ErrRetainedActiveNamespaceNotFound      = Error("retained previous active namespace not found")
+	ErrActiveEnvironmentRollbackInProgress  = Error("retained previous active namespace is being promoted to active")
+	ErrActiveGatewayNotOwnedByTeam          = Error("active gateway resource is not managed by samsahai for the team")
 
 	ErrEnsureConfigDestroyed = Error("config been being destroyed")
 
@@ -182,6 +201,16 @@ func IsEnsuringStableComponentsDestroyed(err error) bool {
 	return ErrEnsureStableComponentsDestroyed.Error() == err.Error()
 }
 
+// IsEnsuringActivePromotionApproved checks ensuring active promotion approved
+func IsEnsuringActivePromotionApproved(err error) bool {
+	return ErrEnsureActivePromotionApproved.Error() == err.Error()
+}
+
+// IsEnsuringActiveEnvironmentVerified checks ensuring new active environment verified
+func IsEnsuringActiveEnvironmentVerified(err error) bool {
+	return ErrEnsureActiveEnvironmentVerified.Error() == err.Error()
+}
+
 // IsErrPullRequestBundleNotFound checks pull request bundle not found error
 func IsErrPullRequestBundleNotFound(err error) bool {
 	return ErrPullRequestBundleNotFound.Error() == err.Error()
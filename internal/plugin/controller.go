@@ -0,0 +1,180 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	cr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8scontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	s2hplugin "github.com/agoda-com/samsahai/internal/samsahai/plugin"
+)
+
+var logger = s2hlog.Log.WithName(ctrlName)
+
+const (
+	ctrlName                = "plugin-ctrl"
+	maxConcurrentReconciles = 1
+
+	// retryRegisterDuration is a duration for retrying to register unreachable plugin
+	retryRegisterDuration = 1 * time.Minute
+)
+
+type controller struct {
+	client    client.Client
+	s2hCtrl   internal.SamsahaiController
+	namespace string
+
+	// registered contains registered plugin specs, for skipping unchanged plugins
+	registered map[string]registeredPlugin
+}
+
+type registeredPlugin struct {
+	spec      s2hv1.PluginSpec
+	authToken string
+}
+
+// New creates a controller which registers out-of-process plugins from Plugin CRD into Samsahai at runtime
+func New(
+	mgr cr.Manager,
+	s2hCtrl internal.SamsahaiController,
+	namespace string,
+) internal.PluginController {
+	c := &controller{
+		s2hCtrl:    s2hCtrl,
+		namespace:  namespace,
+		registered: map[string]registeredPlugin{},
+	}
+
+	if mgr != nil {
+		c.client = mgr.GetClient()
+		if err := c.setupWithManager(mgr); err != nil {
+			logger.Error(err, "cannot add new controller to manager")
+			return nil
+		}
+	}
+
+	return c
+}
+
+func (c *controller) setupWithManager(mgr cr.Manager) error {
+	return cr.NewControllerManagedBy(mgr).
+		WithOptions(k8scontroller.Options{MaxConcurrentReconciles: maxConcurrentReconciles}).
+		For(&s2hv1.Plugin{}).
+		Complete(c)
+}
+
+func (c *controller) Reconcile(req cr.Request) (cr.Result, error) {
+	ctx := context.TODO()
+
+	plugin := &s2hv1.Plugin{}
+	if err := c.client.Get(ctx, req.NamespacedName, plugin); err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.unregister(req.Name)
+			return cr.Result{}, nil
+		}
+
+		logger.Error(err, "cannot get Plugin", "name", req.Name)
+		return cr.Result{}, err
+	}
+
+	if !plugin.ObjectMeta.DeletionTimestamp.IsZero() {
+		c.unregister(plugin.Name)
+		return cr.Result{}, nil
+	}
+
+	authToken, err := c.getAuthToken(ctx, plugin.Spec.AuthTokenSecretRef)
+	if err != nil {
+		c.unregister(plugin.Name)
+		return cr.Result{RequeueAfter: retryRegisterDuration}, c.updateCondition(ctx, plugin, err)
+	}
+
+	current := registeredPlugin{spec: plugin.Spec, authToken: authToken}
+	if reg, ok := c.registered[plugin.Name]; ok && reflect.DeepEqual(reg, current) {
+		return cr.Result{}, nil
+	}
+
+	if err := c.register(plugin, authToken); err != nil {
+		logger.Error(err, "cannot register plugin", "name", plugin.Name, "endpoint", plugin.Spec.Endpoint)
+		c.unregister(plugin.Name)
+		return cr.Result{RequeueAfter: retryRegisterDuration}, c.updateCondition(ctx, plugin, err)
+	}
+
+	c.registered[plugin.Name] = current
+
+	return cr.Result{}, c.updateCondition(ctx, plugin, nil)
+}
+
+func (c *controller) register(plugin *s2hv1.Plugin, authToken string) error {
+	opts := []s2hplugin.RemoteOption{s2hplugin.WithRemoteAuthToken(authToken)}
+	if plugin.Spec.Timeout != nil {
+		opts = append(opts, s2hplugin.WithRemoteTimeout(plugin.Spec.Timeout.Duration))
+	}
+
+	p, err := s2hplugin.NewRemote(plugin.Name, plugin.Spec.Endpoint, opts...)
+	if err != nil {
+		return err
+	}
+
+	return c.s2hCtrl.RegisterPlugin(p)
+}
+
+func (c *controller) unregister(name string) {
+	if _, ok := c.registered[name]; !ok {
+		return
+	}
+
+	c.s2hCtrl.UnregisterPlugin(name)
+	delete(c.registered, name)
+}
+
+func (c *controller) getAuthToken(ctx context.Context, secretRef *corev1.SecretKeySelector) (string, error) {
+	if secretRef == nil {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := c.client.Get(ctx, types.NamespacedName{Name: secretRef.Name, Namespace: c.namespace}, secret)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot get %s secret in %s namespace", secretRef.Name, c.namespace)
+	}
+
+	token, ok := secret.Data[secretRef.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in %s secret", secretRef.Key, secretRef.Name)
+	}
+
+	return string(token), nil
+}
+
+// updateCondition updates registered condition of plugin if it has been changed
+func (c *controller) updateCondition(ctx context.Context, plugin *s2hv1.Plugin, registerErr error) error {
+	status, message := corev1.ConditionTrue, "plugin has been registered"
+	if registerErr != nil {
+		status, message = corev1.ConditionFalse, registerErr.Error()
+	}
+
+	for _, cond := range plugin.Status.Conditions {
+		if cond.Type == s2hv1.PluginRegistered && cond.Status == status && cond.Message == message {
+			return nil
+		}
+	}
+
+	plugin.Status.SetCondition(s2hv1.PluginRegistered, status, message)
+	if err := c.client.Update(ctx, plugin); err != nil {
+		logger.Error(err, "cannot update plugin", "name", plugin.Name)
+		return errors.Wrap(err, "cannot update plugin")
+	}
+
+	return nil
+}
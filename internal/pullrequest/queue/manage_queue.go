@@ -44,6 +44,11 @@ func (c *controller) First(namespace string) (runtime.Object, error) {
 	return &s2hv1.PullRequestQueue{}, nil
 }
 
+// does not support staging slot
+func (c *controller) FirstInSlot(namespace, slotNamespace string) (runtime.Object, error) {
+	return c.First(namespace)
+}
+
 func (c *controller) Remove(obj runtime.Object) error {
 	return c.client.Delete(context.TODO(), obj)
 }
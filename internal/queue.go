@@ -18,6 +18,9 @@ type QueueController interface {
 	// First returns first component in Queue or current running Queue
 	First(namespace string) (runtime.Object, error)
 
+	// FirstInSlot returns first non-conflicting component in Queue or current running Queue of the staging slot
+	FirstInSlot(namespace, slotNamespace string) (runtime.Object, error)
+
 	// Remove removes Queue
 	Remove(q runtime.Object) error
 
@@ -0,0 +1,90 @@
+package queue
+
+import (
+	"sort"
+	"time"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+// GetQueueWaitTime returns how long the queue has been waiting to be processed,
+// waiting time of the retrying queue starts from its next process time
+func GetQueueWaitTime(q *s2hv1.Queue, now time.Time) time.Duration {
+	if q.Status.State != s2hv1.Waiting || q.Status.CreatedAt == nil {
+		return 0
+	}
+
+	start := q.Status.CreatedAt.Time
+	if q.Spec.NextProcessAt != nil && q.Spec.NextProcessAt.After(start) {
+		start = q.Spec.NextProcessAt.Time
+	}
+
+	if now.Before(start) {
+		return 0
+	}
+
+	return now.Sub(start)
+}
+
+// IsQueueWaitSLOBreached returns true if the queue has been waiting longer than the maximum waiting time
+func IsQueueWaitSLOBreached(q *s2hv1.Queue, ageing *s2hv1.ConfigQueueAgeing, now time.Time) bool {
+	if ageing == nil || ageing.MaxWaitTime.Duration <= 0 {
+		return false
+	}
+
+	return GetQueueWaitTime(q, now) >= ageing.MaxWaitTime.Duration
+}
+
+// AgeQueueList returns a copy of queue list which orders of waiting queues are promoted by their waiting time,
+// the queue is moved ahead by one order every ageing interval and
+// the queues exceeding the maximum waiting time are moved to the top ordering by their waiting time
+func AgeQueueList(list *s2hv1.QueueList, ageing *s2hv1.ConfigQueueAgeing, now time.Time) *s2hv1.QueueList {
+	aged := list.DeepCopy()
+	if ageing == nil || len(aged.Items) == 0 {
+		return aged
+	}
+
+	aged.Sort()
+
+	type agedQueue struct {
+		queue    s2hv1.Queue
+		order    float64
+		waitTime time.Duration
+		breached bool
+	}
+
+	agedQueues := make([]agedQueue, len(aged.Items))
+	for i, q := range aged.Items {
+		agedQueues[i] = agedQueue{queue: q, order: float64(i)}
+		if q.Status.State != s2hv1.Waiting {
+			continue
+		}
+
+		agedQueues[i].waitTime = GetQueueWaitTime(&aged.Items[i], now)
+		agedQueues[i].breached = IsQueueWaitSLOBreached(&aged.Items[i], ageing, now)
+
+		if ageing.Interval.Duration > 0 {
+			// promoted queue comes before the queue which is at the same order
+			if promoted := int(agedQueues[i].waitTime / ageing.Interval.Duration); promoted > 0 {
+				agedQueues[i].order -= float64(promoted) + 0.5
+			}
+		}
+	}
+
+	sort.SliceStable(agedQueues, func(i, j int) bool {
+		if agedQueues[i].breached != agedQueues[j].breached {
+			return agedQueues[i].breached
+		}
+		if agedQueues[i].breached {
+			return agedQueues[i].waitTime > agedQueues[j].waitTime
+		}
+		return agedQueues[i].order < agedQueues[j].order
+	})
+
+	for i := range agedQueues {
+		aged.Items[i] = agedQueues[i].queue
+		aged.Items[i].Spec.NoOfOrder = i + 1
+	}
+
+	return aged
+}
@@ -0,0 +1,225 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/util/random"
+)
+
+// NewBatchQueue returns a queue which verifies all given batch items together,
+// a queue of single item is named after the item,
+// a batch queue is named with a random suffix as the same items can be batched again after bisecting
+func NewBatchQueue(teamName, namespace string, items []s2hv1.QueueBatchItem) *s2hv1.Queue {
+	if len(items) == 1 {
+		q := NewQueue(teamName, namespace, items[0].Name, items[0].Bundle, items[0].Components, s2hv1.QueueTypeUpgrade)
+		q.Spec.Batch = items
+		return q
+	}
+
+	comps := make(s2hv1.QueueComponents, 0)
+	for _, item := range items {
+		comps = append(comps, item.Components...)
+	}
+	comps.Sort()
+
+	name := fmt.Sprintf("batch-%s-%d-%s", items[0].Name, len(items), random.GenerateRandomString(5))
+	q := NewQueue(teamName, namespace, name, "", comps, s2hv1.QueueTypeUpgrade)
+	q.Spec.Batch = items
+	return q
+}
+
+// NewBatchItemQueue returns a queue of batch item containing result of the batch queue
+func NewBatchItemQueue(q *s2hv1.Queue, item s2hv1.QueueBatchItem) *s2hv1.Queue {
+	itemQueue := NewQueue(q.Spec.TeamName, q.Namespace, item.Name, item.Bundle, item.Components, q.Spec.Type)
+	itemQueue.Spec.NoOfOrder = q.Spec.NoOfOrder
+	itemQueue.Spec.NoOfRetry = q.Spec.NoOfRetry
+	itemQueue.Status = *q.Status.DeepCopy()
+
+	return itemQueue
+}
+
+// CreateBatchQueue merges the given queue with the following waiting upgrade queues into a batch queue,
+// the given queue will be returned if there is no other queue to be verified together
+func CreateBatchQueue(c client.Client, q *s2hv1.Queue, maxSize int) (*s2hv1.Queue, error) {
+	if !q.IsBatchable() || maxSize < 2 {
+		return q, nil
+	}
+
+	ctx := context.TODO()
+
+	list := &s2hv1.QueueList{}
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: q.Namespace}); err != nil {
+		return nil, errors.Wrapf(err, "cannot list queue in %s", q.Namespace)
+	}
+
+	members := getBatchMembers(list, q, maxSize)
+	if len(members) < 2 {
+		return q, nil
+	}
+
+	items := make([]s2hv1.QueueBatchItem, 0)
+	for _, member := range members {
+		items = append(items, s2hv1.QueueBatchItem{
+			Name:       member.Spec.Name,
+			Bundle:     member.Spec.Bundle,
+			Components: member.Spec.Components,
+		})
+	}
+
+	now := metav1.Now()
+	batch := NewBatchQueue(q.Spec.TeamName, q.Namespace, items)
+	batch.Spec.NoOfOrder = q.Spec.NoOfOrder
+	batch.Status = s2hv1.QueueStatus{
+		CreatedAt:        &now,
+		UpdatedAt:        &now,
+		State:            s2hv1.Waiting,
+		StagingNamespace: q.Status.StagingNamespace,
+	}
+
+	if err := c.Create(ctx, batch); err != nil {
+		return nil, errors.Wrapf(err, "cannot create batch queue %s", batch.Name)
+	}
+
+	for i := range members {
+		if err := c.Delete(ctx, members[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "cannot delete queue %s", members[i].Name)
+		}
+	}
+
+	logger.Info("queues have been merged into batch", "batch", batch.Name, "size", len(items))
+
+	return batch, nil
+}
+
+// getBatchMembers returns the given queue and the following waiting queues which are ready to be batched,
+// queues containing the same components as processing queues are excluded
+func getBatchMembers(list *s2hv1.QueueList, q *s2hv1.Queue, maxSize int) []*s2hv1.Queue {
+	list.Sort()
+
+	processing := make([]*s2hv1.Queue, 0)
+	for i := range list.Items {
+		if list.Items[i].Name != q.Name && list.Items[i].Status.State != s2hv1.Waiting {
+			processing = append(processing, &list.Items[i])
+		}
+	}
+
+	now := metav1.Now()
+	members := []*s2hv1.Queue{q}
+	for i := range list.Items {
+		if len(members) >= maxSize {
+			break
+		}
+
+		item := &list.Items[i]
+		if item.Name == q.Name || !item.IsBatchable() || item.Status.State != s2hv1.Waiting ||
+			item.Status.StagingNamespace != "" {
+			continue
+		}
+
+		if item.Spec.NextProcessAt != nil && !item.Spec.NextProcessAt.Before(&now) {
+			continue
+		}
+
+		isConflicted := false
+		for _, pq := range processing {
+			if pq.IsConflicted(item) {
+				isConflicted = true
+				break
+			}
+		}
+		if isConflicted {
+			continue
+		}
+
+		members = append(members, item)
+	}
+
+	return members
+}
+
+// BisectBatchQueue splits the failure batch queue into two halves and puts them on top of queues,
+// the batch queue will be deleted
+func BisectBatchQueue(c client.Client, q *s2hv1.Queue) error {
+	if !q.IsBatchQueue() {
+		return nil
+	}
+
+	ctx := context.TODO()
+
+	list := &s2hv1.QueueList{}
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: q.Namespace}); err != nil {
+		return errors.Wrapf(err, "cannot list queue in %s", q.Namespace)
+	}
+
+	topOrder := list.TopQueueOrder()
+	half := (len(q.Spec.Batch) + 1) / 2
+	groups := [][]s2hv1.QueueBatchItem{q.Spec.Batch[:half], q.Spec.Batch[half:]}
+
+	now := metav1.Now()
+	for i, items := range groups {
+		newQueue := NewBatchQueue(q.Spec.TeamName, q.Namespace, items)
+		newQueue.Spec.NoOfOrder = topOrder - len(groups) + i + 1
+		newQueue.Status = s2hv1.QueueStatus{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+			State:     s2hv1.Waiting,
+		}
+
+		if err := createOrMergeQueue(ctx, c, newQueue); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Delete(ctx, q); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot delete batch queue %s", q.Name)
+	}
+
+	logger.Info("batch queue has been bisected", "batch", q.Name, "size", len(q.Spec.Batch))
+
+	return nil
+}
+
+// createOrMergeQueue creates the queue or adds missing components into the existing queue
+// which has been added during verifying the batch
+func createOrMergeQueue(ctx context.Context, c client.Client, q *s2hv1.Queue) error {
+	err := c.Create(ctx, q)
+	if err == nil {
+		return nil
+	} else if !k8serrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "cannot create queue %s", q.Name)
+	}
+
+	fetched := &s2hv1.Queue{}
+	if err := c.Get(ctx, types.NamespacedName{Name: q.Name, Namespace: q.Namespace}, fetched); err != nil {
+		return errors.Wrapf(err, "cannot get queue %s", q.Name)
+	}
+
+	for _, comp := range q.Spec.Components {
+		found := false
+		for _, fComp := range fetched.Spec.Components {
+			if fComp.Name == comp.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			fetched.Spec.Components = append(fetched.Spec.Components, comp)
+		}
+	}
+
+	fetched.Spec.Components.Sort()
+	if err := c.Update(ctx, fetched); err != nil {
+		return errors.Wrapf(err, "cannot update queue %s", q.Name)
+	}
+
+	return nil
+}
@@ -24,8 +24,9 @@ var logger = s2hlog.Log.WithName(CtrlName)
 const CtrlName = "queue-ctrl"
 
 type controller struct {
-	client    client.Client
-	namespace string
+	client     client.Client
+	namespace  string
+	configCtrl internal.ConfigController
 }
 
 var _ internal.QueueController = &controller{}
@@ -54,12 +55,27 @@ func NewQueue(teamName, namespace, name, bundle string, comps []*s2hv1.QueueComp
 	}
 }
 
+// Option allows specifying various configuration of queue controller
+type Option func(*controller)
+
+// WithConfigCtrl specifies config controller for ordering queues following dependencies between components
+func WithConfigCtrl(configCtrl internal.ConfigController) Option {
+	return func(c *controller) {
+		c.configCtrl = configCtrl
+	}
+}
+
 // New returns QueueController
-func New(ns string, runtimeClient client.Client) internal.QueueController {
+func New(ns string, runtimeClient client.Client, opts ...Option) internal.QueueController {
 	c := &controller{
 		namespace: ns,
 		client:    runtimeClient,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
 	return c
 }
 
@@ -92,6 +108,10 @@ func (c *controller) Size(namespace string) int {
 }
 
 func (c *controller) First(namespace string) (runtime.Object, error) {
+	return c.FirstInSlot(namespace, namespace)
+}
+
+func (c *controller) FirstInSlot(namespace, slotNamespace string) (runtime.Object, error) {
 	listOpts := &client.ListOptions{Namespace: namespace}
 	list, err := c.list(listOpts)
 	if err != nil {
@@ -99,21 +119,49 @@ func (c *controller) First(namespace string) (runtime.Object, error) {
 		return nil, err
 	}
 
-	q := list.First()
-	c.resetQueueOrderWithCurrentQueue(list, q)
+	var teamName string
+	var dependencies map[string][]string
+	if len(list.Items) > 0 {
+		teamName = list.Items[0].Spec.TeamName
+		dependencies = c.getComponentDependencies(teamName)
+	}
+
+	// waiting queues are promoted by their waiting time only for picking the current queue
+	var q, current *s2hv1.Queue
+	agedList := AgeQueueList(list, c.getQueueAgeing(teamName), time.Now())
+	if first := agedList.FirstInSlot(slotNamespace, dependencies); first != nil {
+		current = first.DeepCopy()
+	}
+
+	// new queues are not started during pausing except active promotion queues
+	if current != nil && current.Status.State == s2hv1.Waiting && !current.IsActivePromotionQueue() {
+		if paused, reason := c.isTeamQueuePaused(teamName); paused {
+			logger.Debug("team queue is paused", "team", teamName, "reason", reason)
+			current = nil
+		}
+	}
+
+	c.resetQueueOrderWithCurrentQueue(list, current, slotNamespace)
+	for i := range list.Items {
+		if current != nil && list.Items[i].Name == current.Name {
+			q = &list.Items[i]
+		}
+	}
+
+	isReady := q != nil && (q.Spec.NextProcessAt == nil || time.Now().After(q.Spec.NextProcessAt.Time))
+	if isReady {
+		pickQueueInSlot(list, q, slotNamespace)
+	}
+
 	if err := c.updateQueueList(list); err != nil {
 		return nil, err
 	}
 
-	if q == nil {
+	if !isReady {
 		return nil, nil
 	}
 
-	if q.Spec.NextProcessAt == nil || time.Now().After(q.Spec.NextProcessAt.Time) {
-		return q, nil
-	}
-
-	return nil, nil
+	return q, nil
 }
 
 func (c *controller) Remove(obj runtime.Object) error {
@@ -144,6 +192,17 @@ func (c *controller) add(ctx context.Context, queue *s2hv1.Queue, atTop bool, pr
 		return nil
 	}
 
+	if queue.Spec.Pin {
+		return c.addPinQueue(ctx, queue, queueList)
+	}
+
+	// pinning version has higher priority than new versions of the same component
+	if isComponentPinning(queueList, queue.Spec.Components[0].Name) {
+		logger.Debug("component is being pinned, ignore new version",
+			"team", queue.Spec.TeamName, "component", queue.Spec.Components[0].Name)
+		return nil
+	}
+
 	pQueue := &s2hv1.Queue{}
 	isAlreadyInQueue := false
 	isAlreadyInBundle := false
@@ -234,6 +293,148 @@ func (c *controller) add(ctx context.Context, queue *s2hv1.Queue, atTop bool, pr
 	return nil
 }
 
+// addPinQueue adds the pin queue to the top of queues,
+// the pinned component will be removed from other waiting queues as it is replaced by the pinned version
+func (c *controller) addPinQueue(ctx context.Context, queue *s2hv1.Queue, list *s2hv1.QueueList) error {
+	compName := queue.Spec.Components[0].Name
+
+	if processing := getProcessingQueueOfComponent(list, compName); processing != nil {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed,
+			"%s is being verified in queue %s, cannot be pinned", compName, processing.Name)
+	}
+
+	var existing *s2hv1.Queue
+	for i := range list.Items {
+		if list.Items[i].Name == queue.Name {
+			existing = &list.Items[i]
+			break
+		}
+	}
+
+	if existing != nil && existing.Status.State != s2hv1.Waiting {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed,
+			"queue %s is being processed, cannot be replaced by pin queue", existing.Name)
+	}
+
+	removingList, updatingList := getWaitingQueuesWithoutComponent(list, compName, queue.Name)
+	if err := removeAndUpdateQueues(ctx, c.client, removingList, updatingList); err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	if existing != nil {
+		existing.Spec = queue.Spec
+		existing.Spec.NoOfOrder = list.TopQueueOrder()
+		existing.Spec.Components.Sort()
+		existing.Status.UpdatedAt = &now
+		return c.client.Update(ctx, existing)
+	}
+
+	queue.Spec.NoOfOrder = list.TopQueueOrder()
+	queue.Status.State = s2hv1.Waiting
+	queue.Status.CreatedAt = &now
+	queue.Status.UpdatedAt = &now
+	queue.Spec.Components.Sort()
+
+	return c.client.Create(ctx, queue)
+}
+
+// RemoveComponentFromWaitingQueues removes the component from waiting queues of the namespace,
+// queues which have no component left will be deleted
+func RemoveComponentFromWaitingQueues(c client.Client, namespace, compName string) error {
+	ctx := context.TODO()
+
+	list := &s2hv1.QueueList{}
+	if err := c.List(ctx, list, &client.ListOptions{Namespace: namespace}); err != nil {
+		return errors.Wrapf(err, "cannot list queue in %s", namespace)
+	}
+
+	removingList, updatingList := getWaitingQueuesWithoutComponent(list, compName, "")
+	return removeAndUpdateQueues(ctx, c, removingList, updatingList)
+}
+
+func removeAndUpdateQueues(ctx context.Context, c client.Client, removingList, updatingList []s2hv1.Queue) error {
+	for i := range removingList {
+		if err := c.Delete(ctx, &removingList[i]); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete queue %s", removingList[i].Name)
+		}
+	}
+
+	for i := range updatingList {
+		if err := c.Update(ctx, &updatingList[i]); err != nil {
+			return errors.Wrapf(err, "cannot update queue %s", updatingList[i].Name)
+		}
+	}
+
+	return nil
+}
+
+// getProcessingQueueOfComponent returns the queue containing the component which is not waiting
+func getProcessingQueueOfComponent(list *s2hv1.QueueList, compName string) *s2hv1.Queue {
+	for i := range list.Items {
+		if list.Items[i].Status.State == s2hv1.Waiting {
+			continue
+		}
+
+		for _, qComp := range list.Items[i].Spec.Components {
+			if qComp.Name == compName {
+				return &list.Items[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// getWaitingQueuesWithoutComponent returns waiting queues to be removed as they contain only the component
+// and waiting queues to be updated without the component, the queue of excluded name is skipped
+func getWaitingQueuesWithoutComponent(list *s2hv1.QueueList, compName, excludedName string) (
+	removing []s2hv1.Queue, updating []s2hv1.Queue) {
+
+	for _, q := range list.Items {
+		if q.Name == excludedName || q.Status.State != s2hv1.Waiting {
+			continue
+		}
+
+		newComps := make([]*s2hv1.QueueComponent, 0)
+		for _, qComp := range q.Spec.Components {
+			if qComp.Name != compName {
+				newComps = append(newComps, qComp)
+			}
+		}
+
+		if len(newComps) == 0 {
+			removing = append(removing, q)
+			continue
+		}
+
+		if len(newComps) != len(q.Spec.Components) {
+			q.Spec.Components = newComps
+			q.SyncBatch()
+			updating = append(updating, q)
+		}
+	}
+
+	return
+}
+
+// isComponentPinning returns true if there is a pin queue of the component
+func isComponentPinning(list *s2hv1.QueueList, compName string) bool {
+	for _, q := range list.Items {
+		if !q.Spec.Pin {
+			continue
+		}
+
+		for _, qComp := range q.Spec.Components {
+			if qComp.Name == compName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // queue always contains 1 component
 func (c *controller) isMatchWithStableComponent(ctx context.Context, q *s2hv1.Queue) (isMatch bool, err error) {
 	if len(q.Spec.Components) == 0 {
@@ -251,13 +452,16 @@ func (c *controller) isMatchWithStableComponent(ctx context.Context, q *s2hv1.Qu
 	}
 
 	isMatch = stableComp.Spec.Repository == qComp.Repository &&
-		stableComp.Spec.Version == qComp.Version
+		stableComp.Spec.Version == qComp.Version &&
+		stableComp.Spec.ChartVersion == qComp.ChartVersion
 
 	return
 }
 
 // expect sorted queue list
 func (c *controller) setQueueOrderFollowingPriorityQueues(queue *s2hv1.Queue, list *s2hv1.QueueList, priorityQueues []string) []s2hv1.Queue {
+	priorityQueues = sortPriorityQueuesByDependencies(priorityQueues, c.getComponentDependencies(queue.Spec.TeamName))
+
 	targetNo := c.getPriorityNo(queue, priorityQueues)
 	if targetNo == -1 || len(list.Items) == 0 {
 		queue.Spec.NoOfOrder = list.LastQueueOrder()
@@ -306,6 +510,95 @@ func (c *controller) setQueueOrderFollowingPriorityQueues(queue *s2hv1.Queue, li
 	return updating
 }
 
+// getComponentDependencies returns dependencies between components of the team
+func (c *controller) getComponentDependencies(teamName string) map[string][]string {
+	if c.configCtrl == nil || teamName == "" {
+		return nil
+	}
+
+	config, err := c.configCtrl.Get(teamName)
+	if err != nil {
+		logger.Error(err, "cannot get configuration", "team", teamName)
+		return nil
+	}
+
+	return config.Status.Used.GetComponentDependencies()
+}
+
+// getQueueAgeing returns configuration about promoting waiting queues of the team
+func (c *controller) getQueueAgeing(teamName string) *s2hv1.ConfigQueueAgeing {
+	if c.configCtrl == nil || teamName == "" {
+		return nil
+	}
+
+	config, err := c.configCtrl.Get(teamName)
+	if err != nil {
+		logger.Error(err, "cannot get configuration", "team", teamName)
+		return nil
+	}
+
+	if config.Status.Used.Staging == nil {
+		return nil
+	}
+
+	return config.Status.Used.Staging.QueueAgeing
+}
+
+func (c *controller) isTeamQueuePaused(teamName string) (bool, string) {
+	if teamName == "" {
+		return false, ""
+	}
+
+	team := &s2hv1.Team{}
+	if err := c.client.Get(context.TODO(), types.NamespacedName{Name: teamName}, team); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			logger.Error(err, "cannot get team", "team", teamName)
+		}
+		return false, ""
+	}
+
+	return IsTeamQueuePaused(team.Status.Used.Queue, time.Now())
+}
+
+// sortPriorityQueuesByDependencies returns priority queues which components always come after
+// the priority components they depend on, components without priority are not added
+// as the dependent queues are held until their prerequisites have been verified
+func sortPriorityQueuesByDependencies(priorityQueues []string, dependencies map[string][]string) []string {
+	if len(dependencies) == 0 {
+		return priorityQueues
+	}
+
+	isPriority := make(map[string]bool)
+	for _, name := range priorityQueues {
+		isPriority[name] = true
+	}
+
+	sorted := make([]string, 0)
+	visited := make(map[string]bool)
+
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+
+		for _, depName := range dependencies[name] {
+			if isPriority[depName] {
+				visit(depName)
+			}
+		}
+
+		sorted = append(sorted, name)
+	}
+
+	for _, name := range priorityQueues {
+		visit(name)
+	}
+
+	return sorted
+}
+
 func (c *controller) getPriorityNo(queue *s2hv1.Queue, priorityQueues []string) int {
 	for i, priorComp := range priorityQueues {
 		if queue.Spec.Name == priorComp {
@@ -355,6 +648,7 @@ func (c *controller) addExistingBundleQueue(queue *s2hv1.Queue, list *s2hv1.Queu
 				if qComp.Name == queue.Spec.Components[0].Name {
 					q.Spec.Components[j].Repository = queue.Spec.Components[0].Repository
 					q.Spec.Components[j].Version = queue.Spec.Components[0].Version
+					q.Spec.Components[j].ChartVersion = queue.Spec.Components[0].ChartVersion
 					found = true
 					break
 				}
@@ -365,6 +659,9 @@ func (c *controller) addExistingBundleQueue(queue *s2hv1.Queue, list *s2hv1.Queu
 				q.Spec.Components = append(q.Spec.Components, queue.Spec.Components[0])
 			}
 
+			// bundle has been changed, it can be verified in a batch again
+			q.Spec.Batch = nil
+
 			updating[i] = q
 		}
 
@@ -403,6 +700,7 @@ func (c *controller) removeAndUpdateSimilarQueue(queue *s2hv1.Queue, list *s2hv1
 
 			if len(newComps) != len(q.Spec.Components) {
 				q.Spec.Components = newComps
+				q.SyncBatch()
 				updating[i] = q
 			}
 		}
@@ -439,6 +737,7 @@ func (c *controller) SetLastOrder(obj runtime.Object) error {
 
 	q.Spec.NoOfOrder = queueList.LastQueueOrder()
 	q.Status.Conditions = nil
+	q.Status.StagingNamespace = ""
 
 	return c.client.Update(context.TODO(), q)
 }
@@ -505,22 +804,66 @@ func (c *controller) updateQueueList(ql *s2hv1.QueueList) error {
 	return nil
 }
 
-// resetQueueOrderWithCurrentQueue resets order of all queues to start with 1 respectively
-func (c *controller) resetQueueOrderWithCurrentQueue(ql *s2hv1.QueueList, currentQueue *s2hv1.Queue) {
+// resetQueueOrderWithCurrentQueue moves the current queue of the slot to the top of the waiting queues,
+// queues picked by staging slots keep their orders on top so that waiting priorities are not shuffled
+// when there are multiple slots
+func (c *controller) resetQueueOrderWithCurrentQueue(ql *s2hv1.QueueList, currentQueue *s2hv1.Queue,
+	slotNamespace string) {
+
 	ql.Sort()
-	count := 2
-	for i := range ql.Items {
-		if ql.Items[i].Name == currentQueue.Name {
-			ql.Items[i].Spec.NoOfOrder = 1
-			continue
+
+	isCurrent := func(q *s2hv1.Queue) bool {
+		return currentQueue != nil && q.Name == currentQueue.Name
+	}
+	isPicked := func(q *s2hv1.Queue) bool {
+		if q.Status.StagingNamespace == "" {
+			return false
 		}
-		ql.Items[i].Spec.NoOfOrder = count
+		// other queues picked by the slot will be released by the current queue
+		return q.Status.StagingNamespace != slotNamespace || isCurrent(q)
+	}
+
+	count := 1
+	ordered := make(map[string]bool)
+	setOrder := func(q *s2hv1.Queue) {
+		q.Spec.NoOfOrder = count
+		ordered[q.Name] = true
 		count++
 	}
+
+	for i := range ql.Items {
+		if isPicked(&ql.Items[i]) {
+			setOrder(&ql.Items[i])
+		}
+	}
+	for i := range ql.Items {
+		if isCurrent(&ql.Items[i]) && !ordered[ql.Items[i].Name] {
+			setOrder(&ql.Items[i])
+		}
+	}
+	for i := range ql.Items {
+		if !ordered[ql.Items[i].Name] {
+			setOrder(&ql.Items[i])
+		}
+	}
+}
+
+// pickQueueInSlot marks queue as picked by staging slot and releases other waiting queues picked by the slot
+func pickQueueInSlot(ql *s2hv1.QueueList, q *s2hv1.Queue, slotNamespace string) {
+	for i := range ql.Items {
+		if ql.Items[i].Status.State == s2hv1.Waiting && ql.Items[i].Status.StagingNamespace == slotNamespace {
+			ql.Items[i].Status.StagingNamespace = ""
+		}
+	}
+
+	q.Status.StagingNamespace = slotNamespace
 }
 
 // EnsurePreActiveComponents ensures that components were deployed with `pre-active` config and tested
-func EnsurePreActiveComponents(c client.Client, teamName, namespace string, skipTest bool) (q *s2hv1.Queue, err error) {
+// comps represents components to be verified in case of promoting a subset of components
+func EnsurePreActiveComponents(c client.Client, teamName, namespace string, skipTest bool,
+	comps s2hv1.QueueComponents) (q *s2hv1.Queue, err error) {
+
 	q = &s2hv1.Queue{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      string(s2hv1.EnvPreActive),
@@ -530,6 +873,7 @@ func EnsurePreActiveComponents(c client.Client, teamName, namespace string, skip
 			Type:           s2hv1.QueueTypePreActive,
 			TeamName:       teamName,
 			SkipTestRunner: skipTest,
+			Components:     comps,
 		},
 	}
 
@@ -2,6 +2,7 @@ package queue
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -318,6 +319,25 @@ var _ = Describe("Queue Controller", func() {
 
 	})
 
+	Describe("Sort priority queues by dependencies", func() {
+		It("should put prerequisite components before dependent components within priority queues", func() {
+			g := NewWithT(GinkgoT())
+
+			dependencies := map[string][]string{
+				"api":    {"migration"},
+				"worker": {"migration"},
+			}
+
+			g.Expect(sortPriorityQueuesByDependencies([]string{"api", "redis", "migration"}, dependencies)).
+				To(Equal([]string{"migration", "api", "redis"}))
+
+			By("components without priority should not be added")
+			g.Expect(sortPriorityQueuesByDependencies([]string{"api", "redis"}, dependencies)).
+				To(Equal([]string{"api", "redis"}))
+			g.Expect(sortPriorityQueuesByDependencies([]string{"redis"}, nil)).To(Equal([]string{"redis"}))
+		})
+	})
+
 	Describe("Reset Queue order", func() {
 		It("should reset order of all Queues correctly", func() {
 			g := NewWithT(GinkgoT())
@@ -345,7 +365,7 @@ var _ = Describe("Queue Controller", func() {
 				},
 			}
 
-			c.resetQueueOrderWithCurrentQueue(queueList, queue)
+			c.resetQueueOrderWithCurrentQueue(queueList, queue, "")
 
 			g.Expect(len(queueList.Items)).To(Equal(3))
 			g.Expect(queueList.Items).To(ContainElement(
@@ -367,6 +387,364 @@ var _ = Describe("Queue Controller", func() {
 				},
 			))
 		})
+
+		It("should keep orders of Queues picked by other staging slots", func() {
+			g := NewWithT(GinkgoT())
+
+			c := controller{}
+
+			newQueue := func(name string, order int, stagingNs string) s2hv1.Queue {
+				return s2hv1.Queue{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       s2hv1.QueueSpec{NoOfOrder: order},
+					Status:     s2hv1.QueueStatus{StagingNamespace: stagingNs},
+				}
+			}
+			queueList := &s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					newQueue("comp1", 1, "s2h-teamtest"),
+					newQueue("comp2", 2, "s2h-teamtest-slot-1"),
+					newQueue("comp3", 3, ""),
+					newQueue("comp4", 4, ""),
+				},
+			}
+
+			current := queueList.Items[3].DeepCopy()
+			c.resetQueueOrderWithCurrentQueue(queueList, current, "s2h-teamtest-slot-2")
+
+			orders := map[string]int{}
+			for _, q := range queueList.Items {
+				orders[q.Name] = q.Spec.NoOfOrder
+			}
+			g.Expect(orders).To(Equal(map[string]int{"comp1": 1, "comp2": 2, "comp4": 3, "comp3": 4}))
+		})
+	})
+
+	Describe("Batch Queue", func() {
+		comp := func(name string) *s2hv1.QueueComponent {
+			return &s2hv1.QueueComponent{Name: name, Version: "1.0.0"}
+		}
+		item := func(name string, comps ...*s2hv1.QueueComponent) s2hv1.QueueBatchItem {
+			return s2hv1.QueueBatchItem{Name: name, Components: comps}
+		}
+
+		It("should create batch queue containing components of all items", func() {
+			g := NewWithT(GinkgoT())
+
+			q := NewBatchQueue("teamtest", "s2h-teamtest",
+				[]s2hv1.QueueBatchItem{item("comp2", comp("comp2")), item("comp1", comp("comp1"))})
+			g.Expect(q.Name).To(HavePrefix("batch-comp2-2-"))
+			g.Expect(q.IsBatchQueue()).To(BeTrue())
+			g.Expect(q.IsBatchable()).To(BeFalse())
+			g.Expect(q.Spec.Components).To(Equal(s2hv1.QueueComponents{comp("comp1"), comp("comp2")}))
+
+			By("batching the same items again should not collide with the existing batch")
+			again := NewBatchQueue("teamtest", "s2h-teamtest",
+				[]s2hv1.QueueBatchItem{item("comp2", comp("comp2")), item("comp1", comp("comp1"))})
+			g.Expect(again.Name).NotTo(Equal(q.Name))
+		})
+
+		It("should create queue of single item after bisecting", func() {
+			g := NewWithT(GinkgoT())
+
+			q := NewBatchQueue("teamtest", "s2h-teamtest", []s2hv1.QueueBatchItem{item("comp1", comp("comp1"))})
+			g.Expect(q.Name).To(Equal("comp1"))
+			g.Expect(q.IsBatchQueue()).To(BeFalse())
+			g.Expect(q.IsBatchable()).To(BeFalse())
+		})
+
+		It("should get batch members following order and skip conflicting queues", func() {
+			g := NewWithT(GinkgoT())
+
+			afterNow := metav1.NewTime(time.Now().Add(time.Hour))
+			newQueue := func(name string, order int, state s2hv1.QueueState) s2hv1.Queue {
+				q := NewQueue("teamtest", "s2h-teamtest", name, "", s2hv1.QueueComponents{comp(name)},
+					s2hv1.QueueTypeUpgrade)
+				q.Spec.NoOfOrder = order
+				q.Status.State = state
+				return *q
+			}
+
+			queueList := &s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					newQueue("comp1", 1, s2hv1.Waiting),
+					newQueue("comp2", 2, s2hv1.Testing),
+					newQueue("comp3", 3, s2hv1.Waiting),
+					newQueue("comp4", 4, s2hv1.Waiting),
+					newQueue("comp5", 5, s2hv1.Waiting),
+					newQueue("comp6", 6, s2hv1.Waiting),
+				},
+			}
+			// retried queue cannot be batched
+			queueList.Items[2].Spec.NoOfRetry = 1
+			// not ready to be processed
+			queueList.Items[3].Spec.NextProcessAt = &afterNow
+
+			members := getBatchMembers(queueList, &queueList.Items[0], 3)
+			g.Expect(members).To(HaveLen(3))
+			g.Expect(members[0].Name).To(Equal("comp1"))
+			g.Expect(members[1].Name).To(Equal("comp5"))
+			g.Expect(members[2].Name).To(Equal("comp6"))
+		})
+	})
+
+	Describe("Pick Queue in staging slot", func() {
+		It("should pick queue and release other waiting queues of the slot", func() {
+			g := NewWithT(GinkgoT())
+
+			slotNamespace := "s2h-teamtest-slot-1"
+			queueList := &s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "comp1"},
+						Status:     s2hv1.QueueStatus{State: s2hv1.Waiting, StagingNamespace: slotNamespace},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "comp2"},
+						Status:     s2hv1.QueueStatus{State: s2hv1.Waiting},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "comp3"},
+						Status:     s2hv1.QueueStatus{State: s2hv1.Waiting, StagingNamespace: "s2h-teamtest"},
+					},
+				},
+			}
+
+			pickQueueInSlot(queueList, &queueList.Items[1], slotNamespace)
+
+			g.Expect(queueList.Items[0].Status.StagingNamespace).To(BeEmpty())
+			g.Expect(queueList.Items[1].Status.StagingNamespace).To(Equal(slotNamespace))
+			g.Expect(queueList.Items[2].Status.StagingNamespace).To(Equal("s2h-teamtest"))
+		})
+	})
+
+	Describe("Pin component", func() {
+		It("should detect component being pinned", func() {
+			g := NewWithT(GinkgoT())
+
+			queueList := &s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "comp1"},
+						Spec: s2hv1.QueueSpec{
+							Name: "comp1",
+							Pin:  true,
+							Components: []*s2hv1.QueueComponent{
+								{Name: "comp1", Repository: "repo/comp1", Version: "1.0.0"},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "bundle"},
+						Spec: s2hv1.QueueSpec{
+							Name: "bundle",
+							Components: []*s2hv1.QueueComponent{
+								{Name: "comp2", Repository: "repo/comp2", Version: "2.0.0"},
+							},
+						},
+					},
+				},
+			}
+
+			g.Expect(isComponentPinning(queueList, "comp1")).To(BeTrue())
+			g.Expect(isComponentPinning(queueList, "comp2")).To(BeFalse())
+			g.Expect(isComponentPinning(queueList, "comp3")).To(BeFalse())
+		})
+
+		It("should remove pinned component only from waiting queues", func() {
+			g := NewWithT(GinkgoT())
+
+			newQueue := func(name string, state s2hv1.QueueState, compNames ...string) s2hv1.Queue {
+				comps := make(s2hv1.QueueComponents, 0)
+				for _, compName := range compNames {
+					comps = append(comps, &s2hv1.QueueComponent{Name: compName, Version: "1.0.0"})
+				}
+				return s2hv1.Queue{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       s2hv1.QueueSpec{Name: name, Components: comps},
+					Status:     s2hv1.QueueStatus{State: state},
+				}
+			}
+
+			queueList := &s2hv1.QueueList{
+				Items: []s2hv1.Queue{
+					newQueue("comp1", s2hv1.Waiting, "comp1"),
+					newQueue("bundle", s2hv1.Waiting, "comp1", "comp2"),
+					newQueue("comp3", s2hv1.Waiting, "comp3"),
+					newQueue("bundle2", s2hv1.Testing, "comp4", "comp5"),
+				},
+			}
+
+			removing, updating := getWaitingQueuesWithoutComponent(queueList, "comp1", "")
+			g.Expect(removing).To(HaveLen(1))
+			g.Expect(removing[0].Name).To(Equal("comp1"))
+			g.Expect(updating).To(HaveLen(1))
+			g.Expect(updating[0].Name).To(Equal("bundle"))
+			g.Expect(updating[0].Spec.Components).To(HaveLen(1))
+			g.Expect(updating[0].Spec.Components[0].Name).To(Equal("comp2"))
+
+			removing, _ = getWaitingQueuesWithoutComponent(queueList, "comp1", "comp1")
+			g.Expect(removing).To(BeEmpty())
+
+			By("components of running queues should not be removed")
+			removing, updating = getWaitingQueuesWithoutComponent(queueList, "comp4", "")
+			g.Expect(removing).To(BeEmpty())
+			g.Expect(updating).To(BeEmpty())
+			g.Expect(getProcessingQueueOfComponent(queueList, "comp4").Name).To(Equal("bundle2"))
+			g.Expect(getProcessingQueueOfComponent(queueList, "comp1")).To(BeNil())
+		})
+	})
+
+	Describe("Pause team queue", func() {
+		// Friday 22:00-02:00 UTC
+		window := s2hv1.MaintenanceWindow{
+			Schedule: "0 22 * * 5",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+		}
+
+		It("should be paused manually", func() {
+			g := NewWithT(GinkgoT())
+
+			now := time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC)
+			paused, reason := IsTeamQueuePaused(&s2hv1.TeamQueue{Paused: true}, now)
+			g.Expect(paused).To(BeTrue())
+			g.Expect(reason).To(Equal(PausedReasonManual))
+
+			paused, _ = IsTeamQueuePaused(nil, now)
+			g.Expect(paused).To(BeFalse())
+		})
+
+		It("should be paused during maintenance window", func() {
+			g := NewWithT(GinkgoT())
+
+			teamQueue := &s2hv1.TeamQueue{MaintenanceWindows: []s2hv1.MaintenanceWindow{window}}
+
+			paused, reason := IsTeamQueuePaused(teamQueue, time.Date(2020, 10, 23, 22, 0, 0, 0, time.UTC))
+			g.Expect(paused).To(BeTrue())
+			g.Expect(reason).To(Equal(PausedReasonMaintenance))
+
+			paused, _ = IsTeamQueuePaused(teamQueue, time.Date(2020, 10, 24, 1, 59, 0, 0, time.UTC))
+			g.Expect(paused).To(BeTrue())
+
+			paused, _ = IsTeamQueuePaused(teamQueue, time.Date(2020, 10, 24, 2, 0, 0, 0, time.UTC))
+			g.Expect(paused).To(BeFalse())
+
+			paused, _ = IsTeamQueuePaused(teamQueue, time.Date(2020, 10, 23, 21, 59, 0, 0, time.UTC))
+			g.Expect(paused).To(BeFalse())
+		})
+
+		It("should evaluate maintenance window in time zone", func() {
+			g := NewWithT(GinkgoT())
+
+			tzWindow := window
+			tzWindow.TimeZone = "Asia/Bangkok"
+
+			// Friday 22:00 in Bangkok is 15:00 UTC
+			active, err := IsInMaintenanceWindow(tzWindow, time.Date(2020, 10, 23, 15, 30, 0, 0, time.UTC))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(active).To(BeTrue())
+
+			active, err = IsInMaintenanceWindow(tzWindow, time.Date(2020, 10, 23, 22, 30, 0, 0, time.UTC))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(active).To(BeFalse())
+		})
+
+		It("should ignore invalid maintenance window", func() {
+			g := NewWithT(GinkgoT())
+
+			invalid := s2hv1.MaintenanceWindow{Schedule: "invalid", Duration: window.Duration}
+			_, err := IsInMaintenanceWindow(invalid, time.Now())
+			g.Expect(err).To(HaveOccurred())
+
+			paused, _ := IsTeamQueuePaused(&s2hv1.TeamQueue{MaintenanceWindows: []s2hv1.MaintenanceWindow{invalid}},
+				time.Now())
+			g.Expect(paused).To(BeFalse())
+		})
+	})
+
+	Describe("Queue ageing", func() {
+		now := time.Date(2020, 10, 19, 10, 0, 0, 0, time.UTC)
+		newWaitingQueue := func(name string, order int, waitTime time.Duration) s2hv1.Queue {
+			return s2hv1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       s2hv1.QueueSpec{Name: name, NoOfOrder: order},
+				Status: s2hv1.QueueStatus{
+					State:     s2hv1.Waiting,
+					CreatedAt: &metav1.Time{Time: now.Add(-waitTime)},
+				},
+			}
+		}
+		getNames := func(list *s2hv1.QueueList) []string {
+			names := make([]string, 0)
+			for _, q := range list.Items {
+				names = append(names, q.Name)
+			}
+			return names
+		}
+
+		It("should get waiting time of queue", func() {
+			g := NewWithT(GinkgoT())
+
+			q := newWaitingQueue("comp1", 1, 10*time.Minute)
+			g.Expect(GetQueueWaitTime(&q, now)).To(Equal(10 * time.Minute))
+
+			q.Spec.NextProcessAt = &metav1.Time{Time: now.Add(-2 * time.Minute)}
+			g.Expect(GetQueueWaitTime(&q, now)).To(Equal(2 * time.Minute))
+
+			q.Spec.NextProcessAt = &metav1.Time{Time: now.Add(2 * time.Minute)}
+			g.Expect(GetQueueWaitTime(&q, now)).To(BeZero())
+
+			q.Status.State = s2hv1.Testing
+			g.Expect(GetQueueWaitTime(&q, now)).To(BeZero())
+		})
+
+		It("should not change orders without ageing configuration", func() {
+			g := NewWithT(GinkgoT())
+
+			list := &s2hv1.QueueList{Items: []s2hv1.Queue{
+				newWaitingQueue("comp1", 1, time.Minute),
+				newWaitingQueue("comp2", 2, time.Hour),
+			}}
+
+			aged := AgeQueueList(list, nil, now)
+			g.Expect(getNames(aged)).To(Equal([]string{"comp1", "comp2"}))
+		})
+
+		It("should promote waiting queues by their waiting time", func() {
+			g := NewWithT(GinkgoT())
+
+			list := &s2hv1.QueueList{Items: []s2hv1.Queue{
+				newWaitingQueue("comp1", 1, 5*time.Minute),
+				newWaitingQueue("comp2", 2, 5*time.Minute),
+				newWaitingQueue("comp3", 3, 15*time.Minute),
+			}}
+			ageing := &s2hv1.ConfigQueueAgeing{Interval: metav1.Duration{Duration: 10 * time.Minute}}
+
+			aged := AgeQueueList(list, ageing, now)
+			g.Expect(getNames(aged)).To(Equal([]string{"comp1", "comp3", "comp2"}))
+			g.Expect(aged.Items[1].Spec.NoOfOrder).To(Equal(2))
+
+			// the original list is not changed
+			g.Expect(list.Items[2].Name).To(Equal("comp3"))
+			g.Expect(list.Items[2].Spec.NoOfOrder).To(Equal(3))
+		})
+
+		It("should move queues exceeding the maximum waiting time to the top", func() {
+			g := NewWithT(GinkgoT())
+
+			list := &s2hv1.QueueList{Items: []s2hv1.Queue{
+				newWaitingQueue("comp1", 1, time.Minute),
+				newWaitingQueue("comp2", 2, 2*time.Hour),
+				newWaitingQueue("comp3", 3, 3*time.Hour),
+			}}
+			ageing := &s2hv1.ConfigQueueAgeing{MaxWaitTime: metav1.Duration{Duration: time.Hour}}
+
+			g.Expect(IsQueueWaitSLOBreached(&list.Items[0], ageing, now)).To(BeFalse())
+			g.Expect(IsQueueWaitSLOBreached(&list.Items[1], ageing, now)).To(BeTrue())
+
+			aged := AgeQueueList(list, ageing, now)
+			g.Expect(getNames(aged)).To(Equal([]string{"comp3", "comp2", "comp1"}))
+		})
 	})
 })
 
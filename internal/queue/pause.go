@@ -0,0 +1,60 @@
+package queue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+const (
+	// PausedReasonManual is a reason when the team queues are paused manually
+	PausedReasonManual = "paused"
+	// PausedReasonMaintenance is a reason when the team queues are in a maintenance window
+	PausedReasonMaintenance = "maintenance"
+)
+
+// IsTeamQueuePaused returns whether the team queues are paused at the given time,
+// the reason will be returned if the queues are paused
+func IsTeamQueuePaused(teamQueue *s2hv1.TeamQueue, now time.Time) (paused bool, reason string) {
+	if teamQueue == nil {
+		return false, ""
+	}
+
+	if teamQueue.Paused {
+		return true, PausedReasonManual
+	}
+
+	for _, window := range teamQueue.MaintenanceWindows {
+		active, err := IsInMaintenanceWindow(window, now)
+		if err != nil {
+			logger.Error(err, "cannot parse maintenance window", "schedule", window.Schedule)
+			continue
+		}
+
+		if active {
+			return true, PausedReasonMaintenance
+		}
+	}
+
+	return false, ""
+}
+
+// IsInMaintenanceWindow returns whether the given time is in the maintenance window
+func IsInMaintenanceWindow(window s2hv1.MaintenanceWindow, now time.Time) (bool, error) {
+	schedule := window.Schedule
+	if window.TimeZone != "" {
+		schedule = fmt.Sprintf("CRON_TZ=%s %s", window.TimeZone, schedule)
+	}
+
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return false, err
+	}
+
+	// the latest window which has not ended yet must start after (now - duration)
+	start := sched.Next(now.Add(-window.Duration.Duration))
+	return !start.After(now), nil
+}
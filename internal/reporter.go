@@ -2,7 +2,9 @@ package internal
 
 import (
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
@@ -18,6 +20,9 @@ const (
 	PullRequestTriggerType       EventType = "PullRequestTrigger"
 	PullRequestQueueType         EventType = "PullRequestQueue"
 	ActiveEnvironmentDeletedType EventType = "ActiveEnvironmentDeleted"
+	QueueActionType              EventType = "QueueAction"
+	QueueWaitSLOBreachedType     EventType = "QueueWaitSLOBreached"
+	ActivePromotionApprovalType  EventType = "ActivePromotionApproval"
 )
 
 // ComponentUpgradeOption allows specifying various configuration
@@ -55,13 +60,29 @@ func WithComponentUpgradeOptCredential(creds s2hv1.Credential) ComponentUpgradeO
 	}
 }
 
+// WithPinned specifies whether the components are pinned when creating component upgrade reporter object
+func WithPinned(pinned bool) ComponentUpgradeOption {
+	return func(c *ComponentUpgradeReporter) {
+		c.IsPinned = pinned
+	}
+}
+
+// WithTestStages specifies results of test stages when creating component upgrade reporter object
+func WithTestStages(stages []s2hv1.QueueTestStage) ComponentUpgradeOption {
+	return func(c *ComponentUpgradeReporter) {
+		c.TestStages = stages
+	}
+}
+
 // ComponentUpgradeReporter manages component upgrade report
 type ComponentUpgradeReporter struct {
-	IssueTypeStr IssueType        `json:"issueTypeStr,omitempty"`
-	StatusStr    StatusType       `json:"statusStr,omitempty"`
-	StatusInt    int32            `json:"statusInt,omitempty"`
-	TestRunner   s2hv1.TestRunner `json:"testRunner,omitempty"`
-	Credential   s2hv1.Credential `json:"credential,omitempty"`
+	IssueTypeStr IssueType              `json:"issueTypeStr,omitempty"`
+	StatusStr    StatusType             `json:"statusStr,omitempty"`
+	StatusInt    int32                  `json:"statusInt,omitempty"`
+	TestRunner   s2hv1.TestRunner       `json:"testRunner,omitempty"`
+	Credential   s2hv1.Credential       `json:"credential,omitempty"`
+	IsPinned     bool                   `json:"isPinned,omitempty"`
+	TestStages   []s2hv1.QueueTestStage `json:"testStages,omitempty"`
 	Envs         map[string]string
 
 	*rpc.ComponentUpgrade
@@ -226,6 +247,147 @@ func NewActiveEnvironmentDeletedReporter(teamname, activeNs, deletedBy, deleteAt
 	return c
 }
 
+// QueueAction represents an action which is applied to a queue manually
+type QueueAction string
+
+const (
+	QueueActionMoveTop    QueueAction = "move-top"
+	QueueActionMoveBottom QueueAction = "move-bottom"
+	QueueActionCancel     QueueAction = "cancel"
+	QueueActionRetry      QueueAction = "retry"
+	QueueActionEnqueue    QueueAction = "enqueue"
+	QueueActionPin        QueueAction = "pin"
+	QueueActionUnpin      QueueAction = "unpin"
+	QueueActionPause      QueueAction = "pause"
+	QueueActionResume     QueueAction = "resume"
+)
+
+// QueueActionReporter manages manual queue action report
+type QueueActionReporter struct {
+	TeamName       string                  `json:"teamName,omitempty"`
+	QueueName      string                  `json:"queueName,omitempty"`
+	Action         QueueAction             `json:"action,omitempty"`
+	Components     []*s2hv1.QueueComponent `json:"components,omitempty"`
+	SkipTestRunner bool                    `json:"skipTestRunner,omitempty"`
+	ActionBy       string                  `json:"actionBy,omitempty"`
+	ActionAt       string                  `json:"actionAt,omitempty"`
+}
+
+// NewQueueActionReporter creates manual queue action reporter object
+func NewQueueActionReporter(q *s2hv1.Queue, action QueueAction, actionBy, actionAt string) *QueueActionReporter {
+	return &QueueActionReporter{
+		TeamName:       q.Spec.TeamName,
+		QueueName:      q.Name,
+		Action:         action,
+		Components:     q.Spec.Components,
+		SkipTestRunner: q.Spec.SkipTestRunner,
+		ActionBy:       actionBy,
+		ActionAt:       actionAt,
+	}
+}
+
+// NewTeamQueueActionReporter creates manual queue action reporter object of the action
+// which is applied to all queues of the team
+func NewTeamQueueActionReporter(teamName string, action QueueAction, actionBy, actionAt string) *QueueActionReporter {
+	return &QueueActionReporter{
+		TeamName: teamName,
+		Action:   action,
+		ActionBy: actionBy,
+		ActionAt: actionAt,
+	}
+}
+
+// QueueWaitSLOReporter manages report of queue which exceeds the maximum waiting time
+type QueueWaitSLOReporter struct {
+	TeamName    string                  `json:"teamName,omitempty"`
+	QueueName   string                  `json:"queueName,omitempty"`
+	Components  []*s2hv1.QueueComponent `json:"components,omitempty"`
+	NoOfOrder   int                     `json:"noOfOrder,omitempty"`
+	WaitTime    string                  `json:"waitTime,omitempty"`
+	MaxWaitTime string                  `json:"maxWaitTime,omitempty"`
+	BreachedAt  string                  `json:"breachedAt,omitempty"`
+}
+
+// NewQueueWaitSLOReporter creates queue wait-time SLO reporter object
+func NewQueueWaitSLOReporter(q *s2hv1.Queue, waitTime, maxWaitTime time.Duration, breachedAt string) *QueueWaitSLOReporter {
+	return &QueueWaitSLOReporter{
+		TeamName:    q.Spec.TeamName,
+		QueueName:   q.Name,
+		Components:  q.Spec.Components,
+		NoOfOrder:   q.Spec.NoOfOrder,
+		WaitTime:    waitTime.Round(time.Second).String(),
+		MaxWaitTime: maxWaitTime.String(),
+		BreachedAt:  breachedAt,
+	}
+}
+
+// GetComponentDiffs returns components which repository or version are different
+// between active and pre-active environments sorted by component name
+func GetComponentDiffs(activeComps, preActiveComps map[string]s2hv1.StableComponent) []s2hv1.ComponentDiff {
+	names := make(map[string]struct{})
+	for name := range activeComps {
+		names[name] = struct{}{}
+	}
+	for name := range preActiveComps {
+		names[name] = struct{}{}
+	}
+
+	diffs := make([]s2hv1.ComponentDiff, 0)
+	for name := range names {
+		activeComp := activeComps[name]
+		preActiveComp := preActiveComps[name]
+		if activeComp.Spec.Repository == preActiveComp.Spec.Repository &&
+			activeComp.Spec.Version == preActiveComp.Spec.Version {
+			continue
+		}
+
+		diffs = append(diffs, s2hv1.ComponentDiff{
+			Name:                name,
+			ActiveRepository:    activeComp.Spec.Repository,
+			ActiveVersion:       activeComp.Spec.Version,
+			PreActiveRepository: preActiveComp.Spec.Repository,
+			PreActiveVersion:    preActiveComp.Spec.Version,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+	return diffs
+}
+
+// ActivePromotionApprovalReporter manages report of active promotion which is waiting for approvals
+type ActivePromotionApprovalReporter struct {
+	TeamName               string                `json:"teamName,omitempty"`
+	CurrentActiveNamespace string                `json:"currentActiveNamespace,omitempty"`
+	PreActiveNamespace     string                `json:"preActiveNamespace,omitempty"`
+	RequiredApprovals      int                   `json:"requiredApprovals,omitempty"`
+	Approvers              []string              `json:"approvers,omitempty"`
+	Timeout                string                `json:"timeout,omitempty"`
+	ComponentDiffs         []s2hv1.ComponentDiff `json:"componentDiffs,omitempty"`
+	SamsahaiConfig
+}
+
+// NewActivePromotionApprovalReporter creates active promotion approval reporter object
+func NewActivePromotionApprovalReporter(atp *s2hv1.ActivePromotion, s2hConfig SamsahaiConfig, timeout string,
+	diffs []s2hv1.ComponentDiff) *ActivePromotionApprovalReporter {
+
+	c := &ActivePromotionApprovalReporter{
+		SamsahaiConfig:         s2hConfig,
+		TeamName:               atp.Name,
+		CurrentActiveNamespace: atp.Status.PreviousActiveNamespace,
+		PreActiveNamespace:     atp.Status.TargetNamespace,
+		Timeout:                timeout,
+		ComponentDiffs:         diffs,
+	}
+
+	if atp.Status.Approval != nil {
+		c.RequiredApprovals = atp.Status.Approval.RequiredApprovals
+		c.Approvers = atp.Status.Approval.Approvers
+	}
+
+	return c
+}
+
 func convertIssueType(issueType rpc.ComponentUpgrade_IssueType) IssueType {
 	switch issueType {
 	case rpc.ComponentUpgrade_IssueType_DESIRED_VERSION_FAILED:
@@ -282,4 +444,13 @@ type Reporter interface {
 
 	// SendActiveEnvironmentDeleted send active namespace deleted information
 	SendActiveEnvironmentDeleted(configCtrl ConfigController, activeNsDeletedRpt *ActiveEnvironmentDeletedReporter) error
+
+	// SendQueueAction sends information of action which is applied to a queue manually
+	SendQueueAction(configCtrl ConfigController, queueActionRpt *QueueActionReporter) error
+
+	// SendQueueWaitSLOBreached sends information of queue which exceeds the maximum waiting time
+	SendQueueWaitSLOBreached(configCtrl ConfigController, queueWaitSLORpt *QueueWaitSLOReporter) error
+
+	// SendActivePromotionApproval sends information of active promotion which is waiting for approvals
+	SendActivePromotionApproval(configCtrl ConfigController, atpApprovalRpt *ActivePromotionApprovalReporter) error
 }
@@ -128,6 +128,30 @@ func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigContro
 	return nil
 }
 
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporter) SendQueueAction(configCtrl internal.ConfigController,
+	queueActionRpt *internal.QueueActionReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporter) SendQueueWaitSLOBreached(configCtrl internal.ConfigController,
+	queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+
+	// does not support
+	return nil
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporter) SendActivePromotionApproval(configCtrl internal.ConfigController,
+	atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+
+	// does not support
+	return nil
+}
+
 func (r *reporter) convertCommitStatus(rpcStatus rpc.ComponentUpgrade_UpgradeStatus) github.CommitStatus {
 	switch rpcStatus {
 	case rpc.ComponentUpgrade_UpgradeStatus_SUCCESS:
@@ -133,6 +133,11 @@ func (r *reporter) SendActivePromotionStatus(configCtrl internal.ConfigControlle
 		message += r.makeImageMissingListReport(imageMissingList, "")
 	}
 
+	if atpRpt.Diff != nil && (len(atpRpt.Diff.Components) > 0 || len(atpRpt.Diff.Releases) > 0) {
+		message += "<hr/>"
+		message += r.makeActivePromotionDiffReport(atpRpt.Diff)
+	}
+
 	if atpRpt.HasOutdatedComponent {
 		message += "<hr/>"
 		message += r.makeOutdatedComponentsReport(atpRpt.OutdatedComponents)
@@ -218,12 +223,57 @@ func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigContro
 	return nil
 }
 
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporter) SendQueueAction(configCtrl internal.ConfigController,
+	queueActionRpt *internal.QueueActionReporter) error {
+
+	msTeamsConfig, err := r.getMSTeamsConfig(queueActionRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeQueueActionReport(queueActionRpt)
+
+	return r.post(msTeamsConfig, message, internal.QueueActionType)
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporter) SendQueueWaitSLOBreached(configCtrl internal.ConfigController,
+	queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+
+	msTeamsConfig, err := r.getMSTeamsConfig(queueWaitSLORpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeQueueWaitSLOReport(queueWaitSLORpt)
+
+	return r.post(msTeamsConfig, message, internal.QueueWaitSLOBreachedType)
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporter) SendActivePromotionApproval(configCtrl internal.ConfigController,
+	atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+
+	msTeamsConfig, err := r.getMSTeamsConfig(atpApprovalRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeActivePromotionApprovalReport(atpApprovalRpt)
+
+	return r.post(msTeamsConfig, message, internal.ActivePromotionApprovalType)
+}
+
 func (r *reporter) makeComponentUpgradeReport(comp *internal.ComponentUpgradeReporter) string {
 	queueHistURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/queue/histories/{{ .QueueHistoryName }}`
 	queueLogURL := `{{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/queue/histories/{{ .QueueHistoryName }}/log`
 
 	message := `
 <b>Component Upgrade:</b><span {{ if eq .Status 1 }}` + styleInfo + `> Success {{ else }}` + styleDanger + `> Failure{{ end }}</span>
+{{- if .IsPinned }}
+<br/><b>Pinned:</b> true
+{{- end }}
 ` + r.makeDeploymentQueueReport(comp, queueHistURL, queueLogURL)
 	return strings.TrimSpace(template.TextRender("MSTeamsComponentUpgrade", message, comp))
 }
@@ -257,6 +307,14 @@ func (r *reporter) makeDeploymentQueueReport(comp *internal.ComponentUpgradeRepo
 {{- end }}
 <br/><b>Owner:</b> {{ .TeamName }}
 <br/><b>Namespace:</b> {{ .Namespace }}
+{{- if .TestStages }}
+<br/><b>Test Stages:</b>
+  {{- range .TestStages }}
+<li><b>- {{ .Name }}:</b> {{ .Result }}
+    {{- if .TestRunner.Teamcity.BuildURL }} <a href="{{ .TestRunner.Teamcity.BuildURL }}">Teamcity {{ .TestRunner.Teamcity.BuildNumber }}</a>{{ end }}
+    {{- if .TestRunner.Gitlab.PipelineURL }} <a href="{{ .TestRunner.Gitlab.PipelineURL }}">GitLab {{ .TestRunner.Gitlab.PipelineNumber }}</a>{{ end }}</li>
+  {{- end }}
+{{- end }}
 {{- if eq .Status 0 }}
 {{- if .ComponentUpgrade.DeploymentIssues }}
 <br/><b>Deployment Issues:</b>
@@ -294,6 +352,9 @@ func (r *reporter) makeActivePromotionStatusReport(comp *internal.ActivePromotio
 <br/><b>Run:</b> #{{ .Runs }}
 <br/><b>Current Active Namespace:</b> {{ .CurrentActiveNamespace }}
 <br/><b>Owner:</b> {{ .TeamName }}
+{{- if .PromotedComponents }}
+<br/><b>Promoted Components:</b> {{ range $i, $name := .PromotedComponents }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}
+{{- end }}
 {{- if eq .Result "Failure" }}
   {{- if .PreActiveQueue.DeploymentIssues }}
 <br/><b>Deployment Issues:</b>
@@ -306,6 +367,14 @@ func (r *reporter) makeActivePromotionStatusReport(comp *internal.ActivePromotio
   {{- end }} 
   {{- end }} 
 {{- end }}
+{{- if .PreActiveQueue.TestStages }}
+<br/><b>Test Stages:</b>
+  {{- range .PreActiveQueue.TestStages }}
+<li><b>- {{ .Name }}:</b> {{ .Result }}
+    {{- if .TestRunner.Teamcity.BuildURL }} <a href="{{ .TestRunner.Teamcity.BuildURL }}">Teamcity {{ .TestRunner.Teamcity.BuildNumber }}</a>{{ end }}
+    {{- if .TestRunner.Gitlab.PipelineURL }} <a href="{{ .TestRunner.Gitlab.PipelineURL }}">GitLab {{ .TestRunner.Gitlab.PipelineNumber }}</a>{{ end }}</li>
+  {{- end }}
+{{- end }}
 {{- if .PreActiveQueue.TestRunner }}
 {{- if and .PreActiveQueue.TestRunner.Teamcity .PreActiveQueue.TestRunner.Teamcity.BuildURL }}
 <br/><b>Teamcity URL:</b> <a href="{{ .PreActiveQueue.TestRunner.Teamcity.BuildURL }}">#{{ .PreActiveQueue.TestRunner.Teamcity.BuildNumber }}</a>
@@ -323,6 +392,27 @@ func (r *reporter) makeActivePromotionStatusReport(comp *internal.ActivePromotio
 	return strings.TrimSpace(template.TextRender("MSTeamsActivePromotionStatus", message, comp))
 }
 
+func (r *reporter) makeActivePromotionDiffReport(diff *s2hv1.ActivePromotionDiff) string {
+	var message = `
+{{- if .Components }}
+<b>Component Changes:</b>
+{{- range .Components }}
+<li><b>{{ .Name }}:</b> {{ if .ActiveVersion }}{{ .ActiveVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveVersion }}{{ .PreActiveVersion }}{{ else }}-{{ end }}</li>
+{{- end }}
+{{- end }}
+{{- if .Releases }}
+<br/><b>Release Changes:</b>
+{{- range .Releases }}
+<li><b>{{ .Component }}:</b>
+  {{- if ne .ActiveChartVersion .PreActiveChartVersion }} chart {{ if .ActiveChartVersion }}{{ .ActiveChartVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveChartVersion }}{{ .PreActiveChartVersion }}{{ else }}-{{ end }}{{ end }}
+  {{- if .Values }} {{ len .Values }}{{ if .IsValuesTruncated }}+{{ end }} value(s) changed{{ end }}</li>
+{{- end }}
+{{- end }}
+`
+
+	return strings.TrimSpace(template.TextRender("MSTeamsActivePromotionDiff", message, diff))
+}
+
 func (r *reporter) makeOutdatedComponentsReport(comps map[string]s2hv1.OutdatedComponent) string {
 	var message = `
 <b>Outdated Components:</b>
@@ -409,6 +499,70 @@ func (r *reporter) makePullRequestTriggerResultReport(prTriggerRpt *internal.Pul
 	return strings.TrimSpace(template.TextRender("MSTeamsPullRequestTriggerResult", message, prTriggerRpt))
 }
 
+func (r *reporter) makeQueueActionReport(queueActionRpt *internal.QueueActionReporter) string {
+	var message = `
+<b>Queue Action:</b> {{ .Action }}
+{{- if .QueueName }}
+<br/><b>Queue:</b> {{ .QueueName }}
+<br/><b>Components:</b>
+{{- range .Components }}
+<li><b>- Name:</b> {{ .Name }}</li>
+<li><b>&nbsp;&nbsp;Version:</b> {{ .Version }}</li>
+{{- end }}
+{{- end }}
+{{- if .SkipTestRunner }}
+<br/><b>Skip Test Runner:</b> true
+{{- end }}
+<br/><b>Owner:</b> {{ .TeamName }}
+{{- if .ActionBy }}
+<br/><b>By:</b> {{ .ActionBy }}
+{{- end }}
+<br/><b>At:</b> {{ .ActionAt }}
+`
+
+	return strings.TrimSpace(template.TextRender("MSTeamsQueueAction", message, queueActionRpt))
+}
+
+func (r *reporter) makeQueueWaitSLOReport(queueWaitSLORpt *internal.QueueWaitSLOReporter) string {
+	var message = `
+<b>Queue Wait-Time SLO:</b> Breached
+<br/><b>Queue:</b> {{ .QueueName }}
+<br/><b>Components:</b>
+{{- range .Components }}
+<li><b>- Name:</b> {{ .Name }}</li>
+<li><b>&nbsp;&nbsp;Version:</b> {{ .Version }}</li>
+{{- end }}
+<br/><b>Order:</b> {{ .NoOfOrder }}
+<br/><b>Waiting Time:</b> {{ .WaitTime }}
+<br/><b>Max Waiting Time:</b> {{ .MaxWaitTime }}
+<br/><b>Owner:</b> {{ .TeamName }}
+<br/><b>At:</b> {{ .BreachedAt }}
+`
+
+	return strings.TrimSpace(template.TextRender("MSTeamsQueueWaitSLO", message, queueWaitSLORpt))
+}
+
+func (r *reporter) makeActivePromotionApprovalReport(atpApprovalRpt *internal.ActivePromotionApprovalReporter) string {
+	var message = `
+<b>Active Promotion:</b> Waiting for Approval
+<br/><b>Current Active Namespace:</b> {{ .CurrentActiveNamespace }}
+<br/><b>Pre-Active Namespace:</b> {{ .PreActiveNamespace }}
+<br/><b>Component Changes:</b>
+{{- range .ComponentDiffs }}
+<li><b>- Name:</b> {{ .Name }}</li>
+<li><b>&nbsp;&nbsp;Version:</b> {{ if .ActiveVersion }}{{ .ActiveVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveVersion }}{{ .PreActiveVersion }}{{ else }}-{{ end }}</li>
+{{- end }}
+<br/><b>Approvals:</b> {{ len .Approvers }}/{{ .RequiredApprovals }}
+{{- if .Timeout }}
+<br/><b>Timeout:</b> {{ .Timeout }}
+{{- end }}
+<br/><b>Owner:</b> {{ .TeamName }}
+<br/><b>Approve:</b> POST {{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/approve
+`
+
+	return strings.TrimSpace(template.TextRender("MSTeamsActivePromotionApproval", message, atpApprovalRpt))
+}
+
 func (r *reporter) post(msTeamsConfig *s2hv1.ReporterMSTeams, message string, event internal.EventType) error {
 	logger.Debug("start sending message to Microsoft Teams groups and channels",
 		"event", event, "groups", msTeamsConfig.Groups)
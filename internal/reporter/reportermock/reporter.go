@@ -54,3 +54,18 @@ func (r *reporterMock) SendPullRequestTriggerResult(configCtrl internal.ConfigCo
 func (r *reporterMock) SendActiveEnvironmentDeleted(configCtrl internal.ConfigController, activeNsDeletedRpt *internal.ActiveEnvironmentDeletedReporter) error {
 	return nil
 }
+
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporterMock) SendQueueAction(configCtrl internal.ConfigController, queueActionRpt *internal.QueueActionReporter) error {
+	return nil
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporterMock) SendQueueWaitSLOBreached(configCtrl internal.ConfigController, queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+	return nil
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporterMock) SendActivePromotionApproval(configCtrl internal.ConfigController, atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+	return nil
+}
@@ -50,6 +50,21 @@ type pullRequestTriggerRest struct {
 	internal.PullRequestTriggerReporter
 }
 
+type queueActionRest struct {
+	ReporterJSON
+	internal.QueueActionReporter
+}
+
+type queueWaitSLORest struct {
+	ReporterJSON
+	internal.QueueWaitSLOReporter
+}
+
+type activePromotionApprovalRest struct {
+	ReporterJSON
+	internal.ActivePromotionApprovalReporter
+}
+
 // NewReporterJSON creates new reporter json
 func NewReporterJSON() ReporterJSON {
 	unixTimestamp := time.Now().UnixNano()
@@ -247,6 +262,99 @@ func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigContro
 	return nil
 }
 
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporter) SendQueueAction(configCtrl internal.ConfigController,
+	queueActionRpt *internal.QueueActionReporter) error {
+
+	config, err := configCtrl.Get(queueActionRpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Rest == nil ||
+		config.Status.Used.Reporter.Rest.QueueAction == nil {
+		return nil
+	}
+
+	for _, ep := range config.Status.Used.Reporter.Rest.QueueAction.Endpoints {
+		restObj := &queueActionRest{NewReporterJSON(), *queueActionRpt}
+		body, err := json.Marshal(restObj)
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("cannot convert struct to json object, %v", body))
+			return err
+		}
+
+		if err = r.send(ep.URL, body, internal.QueueActionType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporter) SendQueueWaitSLOBreached(configCtrl internal.ConfigController,
+	queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+
+	config, err := configCtrl.Get(queueWaitSLORpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Rest == nil ||
+		config.Status.Used.Reporter.Rest.QueueWaitSLOBreached == nil {
+		return nil
+	}
+
+	for _, ep := range config.Status.Used.Reporter.Rest.QueueWaitSLOBreached.Endpoints {
+		restObj := &queueWaitSLORest{NewReporterJSON(), *queueWaitSLORpt}
+		body, err := json.Marshal(restObj)
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("cannot convert struct to json object, %v", body))
+			return err
+		}
+
+		if err = r.send(ep.URL, body, internal.QueueWaitSLOBreachedType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporter) SendActivePromotionApproval(configCtrl internal.ConfigController,
+	atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+
+	config, err := configCtrl.Get(atpApprovalRpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Rest == nil ||
+		config.Status.Used.Reporter.Rest.ActivePromotionApproval == nil {
+		return nil
+	}
+
+	for _, ep := range config.Status.Used.Reporter.Rest.ActivePromotionApproval.Endpoints {
+		restObj := &activePromotionApprovalRest{NewReporterJSON(), *atpApprovalRpt}
+		body, err := json.Marshal(restObj)
+		if err != nil {
+			logger.Error(err, fmt.Sprintf("cannot convert struct to json object, %v", body))
+			return err
+		}
+
+		if err = r.send(ep.URL, body, internal.ActivePromotionApprovalType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // send provides handling convert ReporterJSON to []byte and sent it via http POST
 func (r *reporter) send(url string, body []byte, event internal.EventType) error {
 	restCli := r.rest
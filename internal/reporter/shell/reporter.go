@@ -196,6 +196,76 @@ func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigContro
 	return nil
 }
 
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporter) SendQueueAction(configCtrl internal.ConfigController, queueActionRpt *internal.QueueActionReporter) error {
+	config, err := configCtrl.Get(queueActionRpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Shell == nil ||
+		config.Status.Used.Reporter.Shell.QueueAction == nil {
+		return nil
+	}
+
+	cmdObj := cmd.RenderTemplate(config.Status.Used.Reporter.Shell.QueueAction.Command,
+		config.Status.Used.Reporter.Shell.QueueAction.Args, queueActionRpt)
+	if err := r.execute(cmdObj, internal.QueueActionType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporter) SendQueueWaitSLOBreached(configCtrl internal.ConfigController,
+	queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+
+	config, err := configCtrl.Get(queueWaitSLORpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Shell == nil ||
+		config.Status.Used.Reporter.Shell.QueueWaitSLOBreached == nil {
+		return nil
+	}
+
+	cmdObj := cmd.RenderTemplate(config.Status.Used.Reporter.Shell.QueueWaitSLOBreached.Command,
+		config.Status.Used.Reporter.Shell.QueueWaitSLOBreached.Args, queueWaitSLORpt)
+	if err := r.execute(cmdObj, internal.QueueWaitSLOBreachedType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporter) SendActivePromotionApproval(configCtrl internal.ConfigController,
+	atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+
+	config, err := configCtrl.Get(atpApprovalRpt.TeamName)
+	if err != nil {
+		return err
+	}
+
+	if config.Status.Used.Reporter == nil ||
+		config.Status.Used.Reporter.Shell == nil ||
+		config.Status.Used.Reporter.Shell.ActivePromotionApproval == nil {
+		return nil
+	}
+
+	cmdObj := cmd.RenderTemplate(config.Status.Used.Reporter.Shell.ActivePromotionApproval.Command,
+		config.Status.Used.Reporter.Shell.ActivePromotionApproval.Args, atpApprovalRpt)
+	if err := r.execute(cmdObj, internal.ActivePromotionApprovalType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *reporter) execute(cmdObj *s2hv1.CommandAndArgs, event internal.EventType) error {
 	logger.Debug("start executing command", "event", event)
 
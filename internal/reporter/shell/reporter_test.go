@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
@@ -158,6 +159,28 @@ var _ = Describe("shell command reporter", func() {
 			g.Expect(testCmdObj.Args).To(Equal([]string{"echo executing deleted active namespace command of teamtest , namespace : s2h-active-ns-test ,deleted-by : user, deleted-at : 2020-11-06T05:14:23"}))
 		})
 
+		It("should correctly send queue action", func() {
+			testCmdObj := &s2hv1.CommandAndArgs{}
+			mockExecCommand := func(ctx context.Context, configPath string, cmdObj *s2hv1.CommandAndArgs) ([]byte, error) {
+				testCmdObj = cmdObj
+				return []byte{}, nil
+			}
+
+			r := shell.New(shell.WithExecCommand(mockExecCommand))
+			configCtrl := newMockConfigCtrl("")
+
+			q := &s2hv1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis"},
+				Spec:       s2hv1.QueueSpec{TeamName: "teamtest"},
+			}
+			queueAction := internal.NewQueueActionReporter(q, internal.QueueActionCancel, "user", "2020-11-06T05:14:23")
+			err := r.SendQueueAction(configCtrl, queueAction)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			g.Expect(testCmdObj.Command).To(Equal([]string{"/bin/sh", "-c"}))
+			g.Expect(testCmdObj.Args).To(Equal([]string{"echo cancel queue redis of teamtest by user"}))
+		})
+
 		It("should correctly execute command with environment variables", func() {
 			testCmdObj := &s2hv1.CommandAndArgs{}
 			mockExecCommand := func(ctx context.Context, configPath string, cmdObj *s2hv1.CommandAndArgs) ([]byte, error) {
@@ -289,6 +312,10 @@ func (c *mockConfigCtrl) Get(configName string) (*s2hv1.Config, error) {
 								Command: []string{"/bin/sh", "-c"},
 								Args:    []string{"echo executing deleted active namespace command of {{ .TeamName }} , namespace : {{ .ActiveNamespace }} ,deleted-by : {{ .DeletedBy }}, deleted-at : {{ .DeletedAt }}"},
 							},
+							QueueAction: &s2hv1.CommandAndArgs{
+								Command: []string{"/bin/sh", "-c"},
+								Args:    []string{"echo {{ .Action }} queue {{ .QueueName }} of {{ .TeamName }} by {{ .ActionBy }}"},
+							},
 						},
 					},
 				},
@@ -132,6 +132,11 @@ func (r *reporter) SendActivePromotionStatus(configCtrl internal.ConfigControlle
 		message += r.makeImageMissingListReport(imageMissingList, "")
 	}
 
+	if atpRpt.Diff != nil && (len(atpRpt.Diff.Components) > 0 || len(atpRpt.Diff.Releases) > 0) {
+		message += "\n"
+		message += r.makeActivePromotionDiffReport(atpRpt.Diff)
+	}
+
 	message += "\n"
 	if atpRpt.HasOutdatedComponent {
 		message += r.makeOutdatedComponentsReport(atpRpt.OutdatedComponents)
@@ -204,6 +209,48 @@ func (r *reporter) SendActiveEnvironmentDeleted(configCtrl internal.ConfigContro
 	return nil
 }
 
+// SendQueueAction implements the reporter SendQueueAction function
+func (r *reporter) SendQueueAction(configCtrl internal.ConfigController,
+	queueActionRpt *internal.QueueActionReporter) error {
+
+	slackConfig, err := r.getSlackConfig(queueActionRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeQueueActionReport(queueActionRpt)
+
+	return r.post(slackConfig, message, internal.QueueActionType)
+}
+
+// SendQueueWaitSLOBreached implements the reporter SendQueueWaitSLOBreached function
+func (r *reporter) SendQueueWaitSLOBreached(configCtrl internal.ConfigController,
+	queueWaitSLORpt *internal.QueueWaitSLOReporter) error {
+
+	slackConfig, err := r.getSlackConfig(queueWaitSLORpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeQueueWaitSLOReport(queueWaitSLORpt)
+
+	return r.post(slackConfig, message, internal.QueueWaitSLOBreachedType)
+}
+
+// SendActivePromotionApproval implements the reporter SendActivePromotionApproval function
+func (r *reporter) SendActivePromotionApproval(configCtrl internal.ConfigController,
+	atpApprovalRpt *internal.ActivePromotionApprovalReporter) error {
+
+	slackConfig, err := r.getSlackConfig(atpApprovalRpt.TeamName, configCtrl)
+	if err != nil {
+		return nil
+	}
+
+	message := r.makeActivePromotionApprovalReport(atpApprovalRpt)
+
+	return r.post(slackConfig, message, internal.ActivePromotionApprovalType)
+}
+
 func convertRPCImageListToK8SImageList(images []*rpc.Image) []s2hv1.Image {
 	k8sImages := make([]s2hv1.Image, 0)
 	for _, img := range images {
@@ -222,6 +269,9 @@ func (r *reporter) makeComponentUpgradeReport(comp *internal.ComponentUpgradeRep
 
 	message := `
 *Component Upgrade:* {{ .StatusStr }}
+{{- if .IsPinned }}
+*Pinned:* true
+{{- end }}
 ` + r.makeDeploymentQueueReport(comp, queueHistURL, queueLogURL)
 	return strings.TrimSpace(template.TextRender("SlackComponentUpgrade", message, comp))
 }
@@ -255,6 +305,14 @@ func (r *reporter) makeDeploymentQueueReport(comp *internal.ComponentUpgradeRepo
 {{- end }}
 *Owner:* {{ .TeamName }}
 *Namespace:* {{ .Namespace }}
+{{- if .TestStages }}
+*Test Stages:*
+  {{- range .TestStages }}
+>- *{{ .Name }}:* {{ .Result }}
+    {{- if .TestRunner.Teamcity.BuildURL }} <{{ .TestRunner.Teamcity.BuildURL }}|Teamcity {{ .TestRunner.Teamcity.BuildNumber }}>{{ end }}
+    {{- if .TestRunner.Gitlab.PipelineURL }} <{{ .TestRunner.Gitlab.PipelineURL }}|GitLab {{ .TestRunner.Gitlab.PipelineNumber }}>{{ end }}
+  {{- end }}
+{{- end }}
 {{- if eq .Status 0 }}
   {{- if .ComponentUpgrade.DeploymentIssues }}
 *Deployment Issues:*
@@ -292,6 +350,9 @@ func (r *reporter) makeActivePromotionStatusReport(atpRpt *internal.ActivePromot
 *Run:* #{{ .Runs }}
 *Current Active Namespace:* {{ .CurrentActiveNamespace }}
 *Owner:* {{ .TeamName }}
+{{- if .PromotedComponents }}
+*Promoted Components:* {{ range $i, $name := .PromotedComponents }}{{ if $i }}, {{ end }}{{ $name }}{{ end }}
+{{- end }}
 {{- if eq .Result "Failure" }}
   {{- if .PreActiveQueue.DeploymentIssues }}
 *Deployment Issues:*
@@ -304,6 +365,14 @@ func (r *reporter) makeActivePromotionStatusReport(atpRpt *internal.ActivePromot
   {{- end }} 
   {{- end }}
 {{- end }}
+{{- if .PreActiveQueue.TestStages }}
+*Test Stages:*
+  {{- range .PreActiveQueue.TestStages }}
+>- *{{ .Name }}:* {{ .Result }}
+    {{- if .TestRunner.Teamcity.BuildURL }} <{{ .TestRunner.Teamcity.BuildURL }}|Teamcity {{ .TestRunner.Teamcity.BuildNumber }}>{{ end }}
+    {{- if .TestRunner.Gitlab.PipelineURL }} <{{ .TestRunner.Gitlab.PipelineURL }}|GitLab {{ .TestRunner.Gitlab.PipelineNumber }}>{{ end }}
+  {{- end }}
+{{- end }}
 {{- if .PreActiveQueue.TestRunner }}
 {{- if and .PreActiveQueue.TestRunner.Teamcity .PreActiveQueue.TestRunner.Teamcity.BuildURL }}
 *Teamcity URL:* <{{ .PreActiveQueue.TestRunner.Teamcity.BuildURL }}|{{ .PreActiveQueue.TestRunner.Teamcity.BuildNumber }}>
@@ -321,6 +390,27 @@ func (r *reporter) makeActivePromotionStatusReport(atpRpt *internal.ActivePromot
 	return strings.TrimSpace(template.TextRender("SlackActivePromotionStatus", message, atpRpt))
 }
 
+func (r *reporter) makeActivePromotionDiffReport(diff *s2hv1.ActivePromotionDiff) string {
+	var message = `
+{{- if .Components }}
+*Component Changes:*
+{{- range .Components }}
+>- *{{ .Name }}:* {{ if .ActiveVersion }}{{ .ActiveVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveVersion }}{{ .PreActiveVersion }}{{ else }}-{{ end }}
+{{- end }}
+{{- end }}
+{{- if .Releases }}
+*Release Changes:*
+{{- range .Releases }}
+>- *{{ .Component }}:*
+  {{- if ne .ActiveChartVersion .PreActiveChartVersion }} chart {{ if .ActiveChartVersion }}{{ .ActiveChartVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveChartVersion }}{{ .PreActiveChartVersion }}{{ else }}-{{ end }}{{ end }}
+  {{- if .Values }} {{ len .Values }}{{ if .IsValuesTruncated }}+{{ end }} value(s) changed{{ end }}
+{{- end }}
+{{- end }}
+`
+
+	return strings.TrimSpace(template.TextRender("SlackActivePromotionDiff", message, diff))
+}
+
 func (r *reporter) makeOutdatedComponentsReport(comps map[string]s2hv1.OutdatedComponent) string {
 	var message = `
 *Outdated Components:*
@@ -406,6 +496,70 @@ func (r *reporter) makePullRequestTriggerResultReport(prTriggerRpt *internal.Pul
 	return strings.TrimSpace(template.TextRender("SlackPullRequestTriggerResult", message, prTriggerRpt))
 }
 
+func (r *reporter) makeQueueActionReport(queueActionRpt *internal.QueueActionReporter) string {
+	var message = `
+*Queue Action:* {{ .Action }}
+{{- if .QueueName }}
+*Queue:* {{ .QueueName }}
+*Components*
+{{- range .Components }}
+>- *Name:* {{ .Name }}
+>   *Version:* {{ .Version }}
+{{- end }}
+{{- end }}
+{{- if .SkipTestRunner }}
+*Skip Test Runner:* true
+{{- end }}
+*Owner:* {{ .TeamName }}
+{{- if .ActionBy }}
+*By:* {{ .ActionBy }}
+{{- end }}
+*At:* {{ .ActionAt }}
+`
+
+	return strings.TrimSpace(template.TextRender("SlackQueueAction", message, queueActionRpt))
+}
+
+func (r *reporter) makeQueueWaitSLOReport(queueWaitSLORpt *internal.QueueWaitSLOReporter) string {
+	var message = `
+*Queue Wait-Time SLO:* Breached
+*Queue:* {{ .QueueName }}
+*Components*
+{{- range .Components }}
+>- *Name:* {{ .Name }}
+>   *Version:* {{ .Version }}
+{{- end }}
+*Order:* {{ .NoOfOrder }}
+*Waiting Time:* {{ .WaitTime }}
+*Max Waiting Time:* {{ .MaxWaitTime }}
+*Owner:* {{ .TeamName }}
+*At:* {{ .BreachedAt }}
+`
+
+	return strings.TrimSpace(template.TextRender("SlackQueueWaitSLO", message, queueWaitSLORpt))
+}
+
+func (r *reporter) makeActivePromotionApprovalReport(atpApprovalRpt *internal.ActivePromotionApprovalReporter) string {
+	var message = `
+*Active Promotion:* Waiting for Approval
+*Current Active Namespace:* {{ .CurrentActiveNamespace }}
+*Pre-Active Namespace:* {{ .PreActiveNamespace }}
+*Component Changes*
+{{- range .ComponentDiffs }}
+>- *Name:* {{ .Name }}
+>   *Version:* {{ if .ActiveVersion }}{{ .ActiveVersion }}{{ else }}-{{ end }} -> {{ if .PreActiveVersion }}{{ .PreActiveVersion }}{{ else }}-{{ end }}
+{{- end }}
+*Approvals:* {{ len .Approvers }}/{{ .RequiredApprovals }}
+{{- if .Timeout }}
+*Timeout:* {{ .Timeout }}
+{{- end }}
+*Owner:* {{ .TeamName }}
+*Approve:* ` + "`POST {{ .SamsahaiExternalURL }}/teams/{{ .TeamName }}/activepromotions/approve`" + `
+`
+
+	return strings.TrimSpace(template.TextRender("SlackActivePromotionApproval", message, atpApprovalRpt))
+}
+
 func (r *reporter) post(slackConfig *s2hv1.ReporterSlack, message string, event internal.EventType) error {
 	logger.Debug("start sending message to slack channels",
 		"event", event, "channels", slackConfig.Channels)
@@ -222,6 +222,69 @@ var _ = Describe("send slack message", func() {
 			g.Expect(mockSlackCli.message).Should(ContainSubstring("<gitlab-url|gitlab-pipeline-number>"))
 			g.Expect(mockSlackCli.message).Should(ContainSubstring("owner"))
 		})
+
+		It("should correctly send component upgrade failure of pinned component", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:   "comp1",
+				Status: rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				Components: []*rpc.Component{
+					{
+						Name:  "comp1",
+						Image: &rpc.Image{Repository: "image-1", Tag: "1.0.0"},
+					},
+				},
+				TeamName: "owner",
+			}
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{},
+				internal.WithPinned(true))
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Component Upgrade:* Failure\n*Pinned:* true"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Name:* comp1"))
+		})
+
+		It("should correctly send component upgrade failure with test stages", func() {
+			configCtrl := newMockConfigCtrl("", s2hv1.IntervalEveryTime, "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			rpcComp := &rpc.ComponentUpgrade{
+				Name:   "comp1",
+				Status: rpc.ComponentUpgrade_UpgradeStatus_FAILURE,
+				Components: []*rpc.Component{
+					{
+						Name:  "comp1",
+						Image: &rpc.Image{Repository: "image-1", Tag: "1.0.0"},
+					},
+				},
+				TeamName: "owner",
+			}
+			testStages := []s2hv1.QueueTestStage{
+				{
+					Name:   "smoke",
+					Result: s2hv1.TestStagePassed,
+					TestRunner: s2hv1.TestRunner{
+						Teamcity: s2hv1.Teamcity{BuildURL: "teamcity-url", BuildNumber: "1"},
+					},
+				},
+				{Name: "regression", Result: s2hv1.TestStageFailed},
+				{Name: "performance", Result: s2hv1.TestStageSkipped},
+			}
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			comp := internal.NewComponentUpgradeReporter(rpcComp, internal.SamsahaiConfig{},
+				internal.WithTestStages(testStages))
+			err := r.SendComponentUpgrade(configCtrl, comp)
+			g.Expect(err).Should(BeNil())
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Test Stages:*"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*smoke:* passed <teamcity-url|Teamcity 1>"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*regression:* failed"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*performance:* skipped"))
+		})
 	})
 
 	Describe("send pull request queue", func() {
@@ -344,6 +407,64 @@ var _ = Describe("send slack message", func() {
 			g.Expect(err).Should(BeNil())
 		})
 
+		It("should correctly send active promotion with component and release changes", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			status := s2hv1.ActivePromotionStatus{
+				Result: s2hv1.ActivePromotionSuccess,
+				Diff: &s2hv1.ActivePromotionDiff{
+					Components: []s2hv1.ComponentDiff{
+						{Name: "comp1", ActiveVersion: "1.1.0", PreActiveVersion: "1.1.2"},
+					},
+					Releases: []s2hv1.ReleaseDiff{
+						{
+							Component:             "comp1",
+							ActiveChartVersion:    "0.1.0",
+							PreActiveChartVersion: "0.2.0",
+							Values:                []s2hv1.ValuesDiff{{Path: "image.tag", Active: "1.1.0"}},
+						},
+						{
+							Component: "comp2",
+							Values:    []s2hv1.ValuesDiff{{Path: "replicas", Active: "1", PreActive: "2"}},
+						},
+					},
+				},
+			}
+			atpRpt := internal.NewActivePromotionReporter(status, internal.SamsahaiConfig{}, "owner",
+				"owner-123456", 1)
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			err := r.SendActivePromotionStatus(configCtrl, atpRpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Component Changes:*"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring(">- *comp1:* 1.1.0 -> 1.1.2"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Release Changes:*"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring(">- *comp1:* chart 0.1.0 -> 0.2.0 1 value(s) changed"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring(">- *comp2:* 1 value(s) changed"))
+			g.Expect(err).Should(BeNil())
+		})
+
+		It("should correctly send active promotion with promoted components message", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			status := s2hv1.ActivePromotionStatus{
+				Result:             s2hv1.ActivePromotionSuccess,
+				PromotedComponents: []string{"comp1", "comp2"},
+			}
+			atpRpt := internal.NewActivePromotionReporter(status, internal.SamsahaiConfig{}, "owner",
+				"owner-123456", 1)
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			err := r.SendActivePromotionStatus(configCtrl, atpRpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Promoted Components:* comp1, comp2"))
+			g.Expect(err).Should(BeNil())
+		})
+
 		It("should correctly send active promotion success without outdated components message", func() {
 			configCtrl := newMockConfigCtrl("", "", "")
 			g.Expect(configCtrl).ShouldNot(BeNil())
@@ -534,6 +655,116 @@ var _ = Describe("send slack message", func() {
 		})
 	})
 
+	Describe("send queue action", func() {
+		It("should correctly send queue action message", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			q := &s2hv1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1"},
+				Spec: s2hv1.QueueSpec{
+					TeamName:       "owner",
+					Components:     s2hv1.QueueComponents{{Name: "comp1", Version: "1.0.0"}},
+					SkipTestRunner: true,
+				},
+			}
+			queueActionRpt := internal.NewQueueActionReporter(q, internal.QueueActionEnqueue, "user",
+				"2020-11-06T05:14:23")
+			err := r.SendQueueAction(configCtrl, queueActionRpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Queue Action:* enqueue"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Version:* 1.0.0"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Skip Test Runner:* true"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*By:* user"))
+			g.Expect(err).Should(BeNil())
+		})
+
+		It("should correctly send team queue action message", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			queueActionRpt := internal.NewTeamQueueActionReporter("owner", internal.QueueActionPause, "user",
+				"2020-11-06T05:14:23")
+			err := r.SendQueueAction(configCtrl, queueActionRpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Queue Action:* pause"))
+			g.Expect(mockSlackCli.message).ShouldNot(ContainSubstring("*Queue:*"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*By:* user"))
+			g.Expect(err).Should(BeNil())
+		})
+	})
+
+	Describe("send queue wait-time SLO breached", func() {
+		It("should correctly send queue wait-time SLO breached message", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			q := &s2hv1.Queue{
+				ObjectMeta: metav1.ObjectMeta{Name: "comp1"},
+				Spec: s2hv1.QueueSpec{
+					TeamName:   "owner",
+					Components: s2hv1.QueueComponents{{Name: "comp1", Version: "1.0.0"}},
+					NoOfOrder:  3,
+				},
+			}
+			queueWaitSLORpt := internal.NewQueueWaitSLOReporter(q, 4*time.Hour+10*time.Minute, 4*time.Hour,
+				"2020-11-06T05:14:23")
+			err := r.SendQueueWaitSLOBreached(configCtrl, queueWaitSLORpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Queue Wait-Time SLO:* Breached"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Version:* 1.0.0"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Order:* 3"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Waiting Time:* 4h10m0s"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Max Waiting Time:* 4h0m0s"))
+			g.Expect(err).Should(BeNil())
+		})
+	})
+
+	Describe("send active promotion approval", func() {
+		It("should correctly send active promotion approval message with component diffs", func() {
+			configCtrl := newMockConfigCtrl("", "", "")
+			g.Expect(configCtrl).ShouldNot(BeNil())
+
+			mockSlackCli := &mockSlack{}
+			r := s2hslack.New("mock-token", s2hslack.WithSlackClient(mockSlackCli))
+			atp := &s2hv1.ActivePromotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner"},
+				Status: s2hv1.ActivePromotionStatus{
+					TargetNamespace:         "owner-abcdef",
+					PreviousActiveNamespace: "owner-123456",
+					Approval: &s2hv1.ActivePromotionApproval{
+						RequiredApprovals: 2,
+						Approvers:         []string{"user1"},
+					},
+				},
+			}
+			diffs := []s2hv1.ComponentDiff{
+				{Name: "comp1", ActiveVersion: "1.0.0", PreActiveVersion: "1.1.0"},
+				{Name: "comp2", PreActiveVersion: "2.0.0"},
+			}
+			atpApprovalRpt := internal.NewActivePromotionApprovalReporter(atp, internal.SamsahaiConfig{
+				SamsahaiExternalURL: "http://example.com",
+			}, "2h0m0s", diffs)
+			err := r.SendActivePromotionApproval(configCtrl, atpApprovalRpt)
+			g.Expect(mockSlackCli.postMessageCalls).Should(Equal(2))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Active Promotion:* Waiting for Approval"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Pre-Active Namespace:* owner-abcdef"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Version:* 1.0.0 -> 1.1.0"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Version:* - -> 2.0.0"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Approvals:* 1/2"))
+			g.Expect(mockSlackCli.message).Should(ContainSubstring("*Timeout:* 2h0m0s"))
+			g.Expect(mockSlackCli.message).Should(
+				ContainSubstring("http://example.com/teams/owner/activepromotions/approve"))
+			g.Expect(err).Should(BeNil())
+		})
+	})
+
 	Describe("send pull request trigger result", func() {
 		It("should correctly send pull request trigger failure message", func() {
 			configCtrl := newMockConfigCtrl("", "", "")
@@ -37,6 +37,9 @@ type SamsahaiCredential struct {
 	TeamcityUsername  string
 	TeamcityPassword  string
 	GitlabToken       string
+
+	// RegistryWebhookSecret is a shared secret for verifying container registry push webhooks
+	RegistryWebhookSecret string
 }
 
 type MSTeamsCredential struct {
@@ -83,8 +86,8 @@ type SamsahaiConfig struct {
 		s2hv1.CommandAndArgs
 	} `json:"postNamespaceCreation,omitempty" yaml:"postNamespaceCreation,omitempty"`
 
-	// CheckerResources defines cpu/memory of cronjobs for sending new component webhook
-	CheckerResources corev1.ResourceList `json:"checkerResources,omitempty" yaml:"checkerResources,omitempty"`
+	// VersionPolling defines configuration of scheduled component version polling
+	VersionPolling VersionPollingConfig `json:"versionPolling,omitempty" yaml:"versionPolling,omitempty"`
 
 	// InitialResourcesQuota defines required minimum cpu/memory of resources quota
 	// which will be used for mock deployment engine.
@@ -116,6 +119,18 @@ type PullRequestConfig struct {
 	MaxHistoryDays int `json:"maxHistoryDays" yaml:"maxHistoryDays"`
 }
 
+// VersionPollingConfig represents configuration of scheduled component version polling
+type VersionPollingConfig struct {
+	// Interval defines how often `Component.Schedules` of every team are evaluated
+	Interval metav1.Duration `json:"interval" yaml:"interval"`
+
+	// Concurrences defines maximum number of components to be polled in each interval
+	Concurrences int `json:"concurrences" yaml:"concurrences"`
+
+	// MaxJitter defines maximum random delay before calling the checker of a polled component
+	MaxJitter metav1.Duration `json:"maxJitter" yaml:"maxJitter"`
+}
+
 // ActivePromotionConfig represents configuration of active promotion
 type ActivePromotionConfig struct {
 	// Concurrences defines number of active promotion concurrences
@@ -166,6 +181,16 @@ type SamsahaiController interface {
 	// GetPlugins returns samsahai plugins
 	GetPlugins() map[string]Plugin
 
+	// RegisterPlugin registers out-of-process plugin as a checker at runtime,
+	// the existing plugin with the same name will be replaced
+	RegisterPlugin(p Plugin) error
+
+	// UnregisterPlugin unregisters plugin by name
+	UnregisterPlugin(name string)
+
+	// GetRegistryWebhookSecret returns a shared secret for verifying container registry push webhooks
+	GetRegistryWebhookSecret() string
+
 	// GetActivePromotionDeployEngine returns samsahai deploy engine
 	GetActivePromotionDeployEngine(teamName, ns string) DeployEngine
 
@@ -187,6 +212,9 @@ type SamsahaiController interface {
 	// PromoteActiveEnvironment switches environment from pre-active to active and stores current active components
 	PromoteActiveEnvironment(teamComp *s2hv1.Team, namespace, promotedBy string, comps map[string]s2hv1.StableComponent) error
 
+	// SwitchActiveGateway points service aliases in the active gateway namespace to services of the given namespace
+	SwitchActiveGateway(teamName, activeNs string) error
+
 	// DestroyActiveEnvironment destroys active environment when active demotion is failure.
 	DestroyActiveEnvironment(teamName, namespace string) error
 
@@ -196,6 +224,12 @@ type SamsahaiController interface {
 	// DestroyPreviousActiveEnvironment destroys previous active environment when active promotion is success.
 	DestroyPreviousActiveEnvironment(teamName, namespace string) error
 
+	// RetainPreviousActiveEnvironment scales down previous active environment and keeps it for instant rollback
+	RetainPreviousActiveEnvironment(teamName, namespace string) error
+
+	// DestroyRetainedActiveEnvironment destroys retained previous active environment which exceeds the retention
+	DestroyRetainedActiveEnvironment(teamName, namespace string) error
+
 	// SetPreviousActiveNamespace updates previous active namespace to team status
 	SetPreviousActiveNamespace(teamComp *s2hv1.Team, namespace string) error
 
@@ -211,6 +245,9 @@ type SamsahaiController interface {
 	// NotifyActivePromotionReport sends active promotion status report
 	NotifyActivePromotionReport(atpRpt *ActivePromotionReporter)
 
+	// NotifyActivePromotionApproval sends information of active promotion which is waiting for approvals
+	NotifyActivePromotionApproval(atpApprovalRpt *ActivePromotionApprovalReporter)
+
 	// TriggerPullRequestDeployment creates PullRequestTrigger crd object
 	TriggerPullRequestDeployment(teamName, component, prNumber, commitSHA string, bundleCompTag map[string]string) error
 
@@ -255,8 +292,57 @@ type SamsahaiController interface {
 	// GetActivePromotionHistory returns ActivePromotion by name
 	GetActivePromotionHistory(name string) (*s2hv1.ActivePromotionHistory, error)
 
+	// GetActivePromotionDiff returns differences of component versions, chart versions and rendered values
+	// between the active and pre-active environments of the active promotion
+	GetActivePromotionDiff(atp *s2hv1.ActivePromotion) (*s2hv1.ActivePromotionDiff, error)
+
 	// DeleteTeamActiveEnvironment deletes all component in namespace and namespace object
 	DeleteTeamActiveEnvironment(teamName, namespace, deletedBy string) error
+
+	// SetTeamQueuePaused pauses or resumes the team queues
+	SetTeamQueuePaused(teamName string, paused bool, actionBy string) error
+
+	// VerifyAuthToken verifies the given token with samsahai internal auth token
+	VerifyAuthToken(token string) bool
+
+	// MoveQueueToTop moves the waiting queue to the top of queues
+	MoveQueueToTop(teamName, queueName, actionBy string) error
+
+	// MoveQueueToBottom moves the waiting queue to the bottom of queues
+	MoveQueueToBottom(teamName, queueName, actionBy string) error
+
+	// CancelQueue cancels the waiting or running queue
+	CancelQueue(teamName, queueName, actionBy string) error
+
+	// RetryQueue adds the latest failed component upgrade queue back to queues
+	RetryQueue(teamName, actionBy string) (*s2hv1.Queue, error)
+
+	// EnqueueComponent adds a specific component version to the top of queues
+	EnqueueComponent(teamName, compName, version string, skipTestRunner bool, actionBy string) (*s2hv1.Queue, error)
+
+	// PinComponent adds a pin queue of the component version to the top of queues
+	PinComponent(teamName, compName, repository, version string, skipTestRunner bool, actionBy string) (
+		*s2hv1.Queue, error)
+
+	// UnpinComponent unpins the stable component
+	UnpinComponent(teamName, compName, actionBy string) error
+
+	// ApproveActivePromotion approves the active promotion which is waiting for approvals by the team owner
+	// the approver is identified by the approver token of the team owner
+	ApproveActivePromotion(teamName, approverToken string) (*s2hv1.ActivePromotion, error)
+
+	// CreateActivePromotion creates an active promotion of the team
+	CreateActivePromotion(teamName string, spec s2hv1.ActivePromotionSpec) (*s2hv1.ActivePromotion, error)
+
+	// CancelActivePromotion cancels the current active promotion of the team
+	CancelActivePromotion(teamName, canceledBy string) (*s2hv1.ActivePromotion, error)
+
+	// RetryActivePromotion creates an active promotion of the team from the latest failed active promotion history
+	RetryActivePromotion(teamName, retriedBy string) (*s2hv1.ActivePromotion, error)
+
+	// RollbackActiveEnvironment switches active environment back to the retained previous active environment
+	// at the given position, 1 is the latest one
+	RollbackActiveEnvironment(teamName string, position int, rolledBackBy string) (*s2hv1.Team, error)
 }
 
 type Connection struct {
@@ -275,6 +361,9 @@ type ActivePromotionController interface {
 type StableComponentController interface {
 }
 
+type PluginController interface {
+}
+
 // GitInfo represents git repo, branch info. for process the update
 type GitInfo struct {
 	Name         string
@@ -296,6 +385,11 @@ func GenStagingNamespace(teamName string) string {
 	return AppPrefix + teamName
 }
 
+// GenStagingSlotNamespace returns the name of additional staging namespace by team name and slot number
+func GenStagingSlotNamespace(teamName string, slot int) string {
+	return fmt.Sprintf("%s-slot-%d", GenStagingNamespace(teamName), slot)
+}
+
 // GenPullRequestBundleName generates PullRequest object name from bundle name and pull request number
 func GenPullRequestBundleName(bundle, prNumber string) string {
 	return fmt.Sprintf("%s-%s", bundle, prNumber)
@@ -0,0 +1,256 @@
+package samsahai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const activeNamespaceAnnotation = "samsahai.io/active-namespace"
+
+// SwitchActiveGateway points service aliases in the active gateway namespace to services of the given namespace,
+// aliases of services which do not exist in the given namespace are deleted
+func (c *controller) SwitchActiveGateway(teamName, activeNs string) error {
+	config, err := c.GetConfigController().Get(teamName)
+	if err != nil {
+		return err
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.Gateway == nil || atpConfig.Gateway.Namespace == "" || activeNs == "" {
+		return nil
+	}
+
+	ctx := context.TODO()
+	gatewayNs := atpConfig.Gateway.Namespace
+	if err := c.ensureActiveGatewayNamespace(ctx, teamName, gatewayNs); err != nil {
+		return err
+	}
+
+	activeSvcList := &corev1.ServiceList{}
+	if err := c.client.List(ctx, activeSvcList, &client.ListOptions{Namespace: activeNs}); err != nil {
+		return errors.Wrapf(err, "cannot list services of active namespace %s", activeNs)
+	}
+
+	gatewaySvcList := &corev1.ServiceList{}
+	listOpts := []client.ListOption{client.InNamespace(gatewayNs), client.MatchingLabels(s2h.GetDefaultLabels(teamName))}
+	if err := c.client.List(ctx, gatewaySvcList, listOpts...); err != nil {
+		return errors.Wrapf(err, "cannot list services of active gateway namespace %s", gatewayNs)
+	}
+
+	aliasList := genActiveGatewayServices(teamName, activeNs, gatewayNs, c.configs.ClusterDomain, activeSvcList.Items)
+	for i := range aliasList.Items {
+		if err := c.ensureActiveGatewayService(ctx, teamName, &aliasList.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	for _, svc := range c.getDifferentServices(aliasList, gatewaySvcList) {
+		svc := svc
+		if err := c.client.Delete(ctx, &svc); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete service alias %s", svc.Name)
+		}
+	}
+
+	for _, ing := range atpConfig.Gateway.Ingresses {
+		if err := c.ensureActiveGatewayIngress(ctx, teamName, genActiveGatewayIngress(teamName, gatewayNs, ing)); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("active gateway has been switched",
+		"team", teamName, "gatewayNamespace", gatewayNs, "activeNamespace", activeNs)
+
+	return nil
+}
+
+// ensureActiveGatewayNamespace creates the gateway namespace,
+// the existing namespace which has not been created by samsahai for the team is rejected
+func (c *controller) ensureActiveGatewayNamespace(ctx context.Context, teamName, gatewayNs string) error {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   gatewayNs,
+			Labels: s2h.GetDefaultLabels(teamName),
+		},
+	}
+
+	err := c.client.Create(ctx, ns)
+	if err == nil {
+		return nil
+	} else if !k8serrors.IsAlreadyExists(err) {
+		return errors.Wrapf(err, "cannot create active gateway namespace %s", gatewayNs)
+	}
+
+	fetched := &corev1.Namespace{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: gatewayNs}, fetched); err != nil {
+		return errors.Wrapf(err, "cannot get active gateway namespace %s", gatewayNs)
+	}
+
+	if !isManagedByTeam(fetched.Labels, teamName) {
+		return errors.Wrapf(s2herrors.ErrActiveGatewayNotOwnedByTeam, "namespace %s", gatewayNs)
+	}
+
+	return nil
+}
+
+// ensureActiveGatewayService updates the existing alias in place, so that the alias is switched without downtime,
+// the existing service which is not managed by samsahai for the team is not overwritten
+func (c *controller) ensureActiveGatewayService(ctx context.Context, teamName string, svc *corev1.Service) error {
+	fetched := &corev1.Service{}
+	err := c.client.Get(ctx, types.NamespacedName{Name: svc.Name, Namespace: svc.Namespace}, fetched)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		if err := c.client.Create(ctx, svc); err != nil {
+			return errors.Wrapf(err, "cannot create service alias %s", svc.Name)
+		}
+		return nil
+	}
+
+	if !isManagedByTeam(fetched.Labels, teamName) {
+		return errors.Wrapf(s2herrors.ErrActiveGatewayNotOwnedByTeam, "service %s/%s", svc.Namespace, svc.Name)
+	}
+
+	fetched.Labels = svc.Labels
+	fetched.Annotations = svc.Annotations
+	fetched.Spec.Type = svc.Spec.Type
+	fetched.Spec.ExternalName = svc.Spec.ExternalName
+	fetched.Spec.Ports = svc.Spec.Ports
+	fetched.Spec.Selector = nil
+	fetched.Spec.ClusterIP = ""
+	if err := c.client.Update(ctx, fetched); err != nil {
+		return errors.Wrapf(err, "cannot update service alias %s", svc.Name)
+	}
+
+	return nil
+}
+
+// ensureActiveGatewayIngress creates or updates the gateway ingress,
+// the existing ingress which is not managed by samsahai for the team is not overwritten
+func (c *controller) ensureActiveGatewayIngress(ctx context.Context, teamName string,
+	ing *networkingv1beta1.Ingress) error {
+
+	fetched := &networkingv1beta1.Ingress{}
+	err := c.client.Get(ctx, types.NamespacedName{Name: ing.Name, Namespace: ing.Namespace}, fetched)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+
+		if err := c.client.Create(ctx, ing); err != nil {
+			return errors.Wrapf(err, "cannot create active gateway ingress %s", ing.Name)
+		}
+		return nil
+	}
+
+	if !isManagedByTeam(fetched.Labels, teamName) {
+		return errors.Wrapf(s2herrors.ErrActiveGatewayNotOwnedByTeam, "ingress %s/%s", ing.Namespace, ing.Name)
+	}
+
+	fetched.Labels = ing.Labels
+	fetched.Annotations = ing.Annotations
+	fetched.Spec = ing.Spec
+	if err := c.client.Update(ctx, fetched); err != nil {
+		return errors.Wrapf(err, "cannot update active gateway ingress %s", ing.Name)
+	}
+
+	return nil
+}
+
+// isManagedByTeam returns true if the labels contain default labels of the team
+func isManagedByTeam(labels map[string]string, teamName string) bool {
+	for k, v := range s2h.GetDefaultLabels(teamName) {
+		if labels[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// genActiveGatewayServices returns ExternalName services in the gateway namespace
+// pointing to services of the active namespace
+func genActiveGatewayServices(
+	teamName, activeNs, gatewayNs, clusterDomain string,
+	activeSvcs []corev1.Service,
+) *corev1.ServiceList {
+	aliasList := &corev1.ServiceList{}
+	for _, svc := range activeSvcs {
+		ports := make([]corev1.ServicePort, 0, len(svc.Spec.Ports))
+		for _, port := range svc.Spec.Ports {
+			ports = append(ports, corev1.ServicePort{
+				Name:     port.Name,
+				Protocol: port.Protocol,
+				Port:     port.Port,
+			})
+		}
+
+		aliasList.Items = append(aliasList.Items, corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        genActiveServiceAlias(svc.Name, activeNs),
+				Namespace:   gatewayNs,
+				Labels:      s2h.GetDefaultLabels(teamName),
+				Annotations: map[string]string{activeNamespaceAnnotation: activeNs},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:         corev1.ServiceTypeExternalName,
+				ExternalName: fmt.Sprintf("%s.%s.svc.%s", svc.Name, activeNs, clusterDomain),
+				Ports:        ports,
+			},
+		})
+	}
+
+	return aliasList
+}
+
+// genActiveServiceAlias returns a service name without the release name prefix of the active namespace,
+// the alias name is kept the same across active namespaces
+func genActiveServiceAlias(svcName, activeNs string) string {
+	return strings.ReplaceAll(svcName, s2h.GenReleaseName(activeNs, ""), "")
+}
+
+func genActiveGatewayIngress(teamName, gatewayNs string, ing s2hv1.ActiveGatewayIngress) *networkingv1beta1.Ingress {
+	return &networkingv1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ing.Name,
+			Namespace:   gatewayNs,
+			Labels:      s2h.GetDefaultLabels(teamName),
+			Annotations: ing.Annotations,
+		},
+		Spec: networkingv1beta1.IngressSpec{
+			Rules: []networkingv1beta1.IngressRule{
+				{
+					Host: ing.Host,
+					IngressRuleValue: networkingv1beta1.IngressRuleValue{
+						HTTP: &networkingv1beta1.HTTPIngressRuleValue{
+							Paths: []networkingv1beta1.HTTPIngressPath{
+								{
+									Path: ing.Path,
+									Backend: networkingv1beta1.IngressBackend{
+										ServiceName: ing.ServiceName,
+										ServicePort: intstr.FromInt(int(ing.ServicePort)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
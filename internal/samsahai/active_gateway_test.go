@@ -0,0 +1,55 @@
+package samsahai
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+)
+
+var _ = Describe("S2H active gateway", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should generate service aliases pointing to the active namespace", func() {
+		activeNs := "s2h-teamtest-abc123"
+		activeSvcs := []corev1.Service{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "s2h-teamtest-abc123-wordpress", Namespace: activeNs},
+				Spec: corev1.ServiceSpec{
+					Type:  corev1.ServiceTypeClusterIP,
+					Ports: []corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: activeNs},
+			},
+		}
+
+		aliasList := genActiveGatewayServices("teamtest", activeNs, "teamtest-gateway", "cluster.local", activeSvcs)
+		g.Expect(aliasList.Items).To(HaveLen(2))
+
+		wordpress := aliasList.Items[0]
+		g.Expect(wordpress.Name).To(Equal("wordpress"))
+		g.Expect(wordpress.Namespace).To(Equal("teamtest-gateway"))
+		g.Expect(wordpress.Annotations).To(HaveKeyWithValue(activeNamespaceAnnotation, activeNs))
+		g.Expect(wordpress.Spec.Type).To(Equal(corev1.ServiceTypeExternalName))
+		g.Expect(wordpress.Spec.ExternalName).To(Equal("s2h-teamtest-abc123-wordpress.s2h-teamtest-abc123.svc.cluster.local"))
+		g.Expect(wordpress.Spec.Ports).To(Equal([]corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80}}))
+
+		g.Expect(aliasList.Items[1].Name).To(Equal("redis"))
+	})
+
+	It("should check whether resources are managed by samsahai for the team", func() {
+		g.Expect(isManagedByTeam(s2h.GetDefaultLabels("teamtest"), "teamtest")).To(BeTrue())
+		g.Expect(isManagedByTeam(s2h.GetDefaultLabels("teamtest"), "otherteam")).To(BeFalse())
+		g.Expect(isManagedByTeam(map[string]string{s2h.GetTeamLabelKey(): "teamtest"}, "teamtest")).To(BeFalse())
+		g.Expect(isManagedByTeam(nil, "teamtest")).To(BeFalse())
+	})
+
+	It("should keep the same alias name across active namespaces", func() {
+		g.Expect(genActiveServiceAlias("s2h-teamtest-abc123-mariadb", "s2h-teamtest-abc123")).To(Equal("mariadb"))
+		g.Expect(genActiveServiceAlias("s2h-teamtest-xyz789-mariadb", "s2h-teamtest-xyz789")).To(Equal("mariadb"))
+	})
+})
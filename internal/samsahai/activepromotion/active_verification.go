@@ -0,0 +1,274 @@
+package activepromotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+const postActiveCheckTimeout = 10 * time.Second
+
+// postActiveCheckData represents data for rendering probe urls and metric queries
+type postActiveCheckData struct {
+	TeamName  string
+	Namespace string
+}
+
+// verifyActiveEnvironment checks the new active environment before the previous active environment is destroyed,
+// the active promotion is rolled back if the checks have not been passed within the verification timeout
+func (c *controller) verifyActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	targetNs := c.getTargetNamespace(atpComp)
+
+	verificationConfig := c.getPostActiveVerificationConfig(teamName)
+	if verificationConfig == nil {
+		// verification has been removed from the configuration
+		return c.completeActivePromotion(ctx, atpComp)
+	}
+
+	startedTime := atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActiveVerificationStarted)
+	if startedTime != nil && metav1.Now().Sub(startedTime.Time) < verificationConfig.InitialDelay.Duration {
+		return s2herrors.ErrEnsureActiveEnvironmentVerified
+	}
+
+	checkData := postActiveCheckData{TeamName: teamName, Namespace: targetNs}
+	checkErr := c.runPostActiveChecks(ctx, verificationConfig, checkData)
+	if checkErr == nil {
+		logger.Info("new active environment has been verified", "team", teamName, "namespace", targetNs)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveVerified, corev1.ConditionTrue,
+			"New active environment has been verified")
+
+		return c.completeActivePromotion(ctx, atpComp)
+	}
+
+	isTimeout, err := c.isTimeoutFromConfig(atpComp, timeoutPostActiveVerification)
+	if err != nil {
+		return err
+	}
+
+	if !isTimeout {
+		logger.Debug("new active environment has not been verified yet",
+			"team", teamName, "namespace", targetNs, "reason", checkErr.Error())
+		return s2herrors.ErrEnsureActiveEnvironmentVerified
+	}
+
+	logger.Warn("post-active verification failed, rolling back active promotion",
+		"team", teamName, "namespace", targetNs, "reason", checkErr.Error())
+	msg := fmt.Sprintf("Post-active verification failed: %s", checkErr.Error())
+	atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveVerified, corev1.ConditionFalse, msg)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActivePromoted, corev1.ConditionFalse,
+		"New active environment has not been verified")
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondRollbackStarted, corev1.ConditionTrue,
+		"Rollback process has been started due to post-active verification failure")
+	atpComp.SetState(s2hv1.ActivePromotionRollback, msg)
+
+	return nil
+}
+
+// runPostActiveChecks returns an error of the first check which has not been passed
+func (c *controller) runPostActiveChecks(
+	ctx context.Context,
+	verificationConfig *s2hv1.ConfigPostActiveVerification,
+	data postActiveCheckData,
+) error {
+	if verificationConfig.Readiness {
+		if err := c.checkPodsReady(ctx, data.Namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, probe := range verificationConfig.Probes {
+		if err := checkProbe(probe, data); err != nil {
+			return err
+		}
+	}
+
+	for _, metric := range verificationConfig.Metrics {
+		if err := checkMetric(metric, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) checkPodsReady(ctx context.Context, ns string) error {
+	pods := &corev1.PodList{}
+	if err := c.client.List(ctx, pods, &client.ListOptions{Namespace: ns}); err != nil {
+		return errors.Wrapf(err, "cannot list pods of namespace %s", ns)
+	}
+
+	for _, pod := range pods.Items {
+		if !isPodReady(pod) {
+			return fmt.Errorf("pod %s is not ready", pod.Name)
+		}
+	}
+
+	return nil
+}
+
+// isPodReady returns true if the pod is ready or has been completed e.g. pods of jobs
+func isPodReady(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return true
+	}
+
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func checkProbe(probe s2hv1.PostActiveProbe, data postActiveCheckData) error {
+	probeURL := template.TextRender("PostActiveProbeURL", probe.URL, data)
+	statusCode, _, err := http.Get(probeURL, http.WithTimeout(postActiveCheckTimeout))
+	if probe.ExpectedStatusCode != 0 {
+		if statusCode != probe.ExpectedStatusCode {
+			return fmt.Errorf("probe %s returns status code %d, expected %d",
+				probeURL, statusCode, probe.ExpectedStatusCode)
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "probe %s failed", probeURL)
+	}
+
+	return nil
+}
+
+func checkMetric(metric s2hv1.PostActiveMetric, data postActiveCheckData) error {
+	threshold, err := strconv.ParseFloat(metric.Threshold, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid threshold of metric %s", metric.Name)
+	}
+
+	query := template.TextRender("PostActiveMetricQuery", metric.Query, data)
+	queryURL := fmt.Sprintf("%s/api/v1/query?query=%s", strings.TrimSuffix(metric.URL, "/"), url.QueryEscape(query))
+	_, body, err := http.Get(queryURL, http.WithTimeout(postActiveCheckTimeout))
+	if err != nil {
+		return errors.Wrapf(err, "cannot query metric %s", metric.Name)
+	}
+
+	values, err := parsePrometheusQueryValues(body)
+	if err != nil {
+		return errors.Wrapf(err, "cannot parse result of metric %s", metric.Name)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("metric %s returns no data", metric.Name)
+	}
+
+	for _, value := range values {
+		ok, err := compareWithThreshold(value, metric.Operator, threshold)
+		if err != nil {
+			return errors.Wrapf(err, "cannot compare metric %s", metric.Name)
+		}
+
+		if !ok {
+			return fmt.Errorf("metric %s value %v does not satisfy %s %s",
+				metric.Name, value, metric.Operator, metric.Threshold)
+		}
+	}
+
+	return nil
+}
+
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSample struct {
+	Value []interface{} `json:"value"`
+}
+
+// parsePrometheusQueryValues returns values of an instant query result, only vector and scalar are supported
+func parsePrometheusQueryValues(body []byte) ([]float64, error) {
+	resp := prometheusQueryResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("query status is %s: %s", resp.Status, resp.Error)
+	}
+
+	var samples []prometheusSample
+	switch resp.Data.ResultType {
+	case "vector":
+		if err := json.Unmarshal(resp.Data.Result, &samples); err != nil {
+			return nil, err
+		}
+	case "scalar":
+		sample := prometheusSample{}
+		if err := json.Unmarshal(resp.Data.Result, &sample.Value); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	default:
+		return nil, fmt.Errorf("result type %s is not supported", resp.Data.ResultType)
+	}
+
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		if len(sample.Value) != 2 {
+			return nil, fmt.Errorf("invalid sample value %v", sample.Value)
+		}
+
+		strVal, ok := sample.Value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid sample value %v", sample.Value[1])
+		}
+
+		value, err := strconv.ParseFloat(strVal, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func compareWithThreshold(value float64, operator string, threshold float64) (bool, error) {
+	switch operator {
+	case "<":
+		return value < threshold, nil
+	case "<=":
+		return value <= threshold, nil
+	case ">":
+		return value > threshold, nil
+	case ">=":
+		return value >= threshold, nil
+	case "==":
+		return value == threshold, nil
+	case "!=":
+		return value != threshold, nil
+	default:
+		return false, fmt.Errorf("operator %s is not supported", operator)
+	}
+}
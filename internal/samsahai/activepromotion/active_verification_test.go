@@ -0,0 +1,110 @@
+package activepromotion
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestActivePromotion(t *testing.T) {
+	unittest.InitGinkgo(t, "Active Promotion Controller")
+}
+
+var _ = Describe("Post-active verification", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should parse values of vector and scalar query results", func() {
+		values, err := parsePrometheusQueryValues([]byte(`{"status":"success","data":{"resultType":"vector",
+"result":[{"metric":{"pod":"a"},"value":[1600000000,"0.5"]},{"metric":{"pod":"b"},"value":[1600000000,"1"]}]}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(values).To(Equal([]float64{0.5, 1}))
+
+		values, err = parsePrometheusQueryValues([]byte(`{"status":"success","data":{"resultType":"scalar",
+"result":[1600000000,"2"]}}`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(values).To(Equal([]float64{2}))
+
+		_, err = parsePrometheusQueryValues([]byte(`{"status":"error","error":"bad query"}`))
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = parsePrometheusQueryValues([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should compare value with threshold", func() {
+		ok, err := compareWithThreshold(0.01, "<", 0.05)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ok).To(BeTrue())
+
+		ok, err = compareWithThreshold(0.05, ">=", 0.1)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(ok).To(BeFalse())
+
+		_, err = compareWithThreshold(1, "~", 1)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should check metric of the new active namespace", func() {
+		var query string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query().Get("query")
+			_, _ = fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector",
+"result":[{"metric":{},"value":[1600000000,"0.02"]}]}}`)
+		}))
+		defer server.Close()
+
+		data := postActiveCheckData{TeamName: "teamtest", Namespace: "s2h-teamtest-abcdef"}
+		metric := s2hv1.PostActiveMetric{
+			Name:      "error-rate",
+			URL:       server.URL + "/",
+			Query:     `sum(rate(errors{namespace="{{ .Namespace }}"}[1m]))`,
+			Operator:  "<",
+			Threshold: "0.05",
+		}
+		g.Expect(checkMetric(metric, data)).To(BeNil())
+		g.Expect(query).To(Equal(`sum(rate(errors{namespace="s2h-teamtest-abcdef"}[1m]))`))
+
+		metric.Threshold = "0.01"
+		g.Expect(checkMetric(metric, data)).NotTo(BeNil())
+	})
+
+	It("should check probe of the new active namespace", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/s2h-teamtest-abcdef/healthz" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		data := postActiveCheckData{TeamName: "teamtest", Namespace: "s2h-teamtest-abcdef"}
+		g.Expect(checkProbe(s2hv1.PostActiveProbe{URL: server.URL + "/{{ .Namespace }}/healthz"}, data)).To(BeNil())
+		g.Expect(checkProbe(s2hv1.PostActiveProbe{URL: server.URL + "/unknown"}, data)).NotTo(BeNil())
+		g.Expect(checkProbe(s2hv1.PostActiveProbe{
+			URL:                server.URL + "/unknown",
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+		}, data)).To(BeNil())
+	})
+
+	It("should check pod readiness", func() {
+		g.Expect(isPodReady(corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodSucceeded}})).To(BeTrue())
+		g.Expect(isPodReady(corev1.Pod{Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		}})).To(BeTrue())
+		g.Expect(isPodReady(corev1.Pod{Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+		}})).To(BeFalse())
+		g.Expect(isPodReady(corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}})).To(BeFalse())
+	})
+})
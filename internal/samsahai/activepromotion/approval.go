@@ -0,0 +1,113 @@
+package activepromotion
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const defaultMinApprovals = 1
+
+// isApprovalRequired returns true if the team requires approvals before switching the pre-active to be active
+// and the active promotion has not been approved yet
+func (c *controller) isApprovalRequired(atpComp *s2hv1.ActivePromotion) bool {
+	return c.getApprovalConfig(atpComp.Name) != nil &&
+		!atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondApproved)
+}
+
+// requestApproval starts waiting for approvals from team owners and notifies reporters
+func (c *controller) requestApproval(ctx context.Context, atpComp *s2hv1.ActivePromotion) {
+	approvalConfig := c.getApprovalConfig(atpComp.Name)
+	if approvalConfig == nil {
+		return
+	}
+
+	minApprovals := approvalConfig.MinApprovals
+	if minApprovals <= 0 {
+		minApprovals = defaultMinApprovals
+	}
+
+	now := metav1.Now()
+	atpComp.Status.Approval = &s2hv1.ActivePromotionApproval{
+		RequiredApprovals: minApprovals,
+		StartedAt:         &now,
+	}
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondApproved, corev1.ConditionFalse,
+		"Waiting for approvals from team owners")
+	atpComp.SetState(s2hv1.ActivePromotionWaitingForApproval, "Waiting for approvals from team owners")
+
+	var diffs []s2hv1.ComponentDiff
+	if atpComp.Status.Diff != nil {
+		diffs = atpComp.Status.Diff.Components
+	} else {
+		teamComp, err := c.getTeam(ctx, atpComp.Name)
+		if err != nil {
+			logger.Error(err, "cannot get team for listing component diffs", "team", atpComp.Name)
+		}
+		diffs = internal.GetComponentDiffs(teamComp.Status.ActiveComponents, atpComp.Status.ActiveComponents)
+	}
+
+	timeout := ""
+	if approvalConfig.Timeout.Duration != 0 {
+		timeout = approvalConfig.Timeout.Duration.String()
+	}
+
+	atpApprovalRpt := internal.NewActivePromotionApprovalReporter(atpComp, c.configs, timeout, diffs)
+	c.s2hCtrl.NotifyActivePromotionApproval(atpApprovalRpt)
+
+	logger.Info("activepromotion is waiting for approvals", "team", atpComp.Name,
+		"requiredApprovals", minApprovals)
+}
+
+// waitForApproval switches to demoting state once the active promotion has been approved,
+// the pre-active environment will be destroyed if approvals are not given in time
+func (c *controller) waitForApproval(atpComp *s2hv1.ActivePromotion) error {
+	approval := atpComp.Status.Approval
+	if approval == nil {
+		// approval status has been lost, start waiting for approvals again
+		c.requestApproval(context.TODO(), atpComp)
+		return nil
+	}
+
+	if approval.IsApproved() {
+		if approval.ApprovedAt == nil {
+			now := metav1.Now()
+			approval.ApprovedAt = &now
+		}
+
+		logger.Info("activepromotion has been approved", "team", atpComp.Name, "approvers", approval.Approvers)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondApproved, corev1.ConditionTrue,
+			"Active promotion has been approved")
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveDemotionStarted, corev1.ConditionTrue,
+			"Active demotion has been started")
+		atpComp.SetState(s2hv1.ActivePromotionDemoting, "Demoting an active environment")
+
+		return nil
+	}
+
+	approvalConfig := c.getApprovalConfig(atpComp.Name)
+	if approvalConfig != nil && approvalConfig.Timeout.Duration != 0 &&
+		approval.GetWaitingDuration(metav1.Now().Time) > approvalConfig.Timeout.Duration {
+
+		logger.Debug("active promotion approval has been timeout", "team", atpComp.Name)
+		if approvalConfig.CancelOnTimeout {
+			atpComp.Status.SetResult(s2hv1.ActivePromotionCanceled)
+		} else {
+			atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
+		}
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondApproved, corev1.ConditionFalse,
+			"Approval has been timeout")
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerified, corev1.ConditionFalse,
+			"Approval has been timeout")
+		atpComp.SetState(s2hv1.ActivePromotionCollectingPreActiveResult, "Approval has been timeout")
+
+		return nil
+	}
+
+	return s2herrors.ErrEnsureActivePromotionApproved
+}
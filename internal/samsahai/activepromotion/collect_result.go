@@ -2,37 +2,45 @@ package activepromotion
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/queue"
+	"github.com/agoda-com/samsahai/internal/util/outdated"
 )
 
 func (c *controller) collectResult(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	teamName := atpComp.Name
 	targetNs := c.getTargetNamespace(atpComp)
-	q, err := queue.EnsurePreActiveComponents(c.client, teamName, targetNs, atpComp.Spec.SkipTestRunner)
-	if err != nil {
-		return errors.Wrapf(err, "cannot ensure pre-active components, namespace %s", targetNs)
-	}
 
-	if !atpComp.IsActivePromotionCanceled() && !atpComp.Status.IsTimeout {
-		// to save pre-active queue after pre-active queue finished
-		q, err = c.ensurePreActiveComponentsTested(teamName, targetNs, atpComp.Spec.SkipTestRunner)
+	// there is no pre-active queue in case of in-place promotion
+	if !atpComp.Status.IsInPlacePromotion() {
+		q, err := queue.EnsurePreActiveComponents(c.client, teamName, targetNs, atpComp.Spec.SkipTestRunner,
+			getPreActiveQueueComponents(atpComp))
 		if err != nil {
-			return errors.Wrapf(err, "cannot ensure pre-active components finished, namespace %s", targetNs)
+			return errors.Wrapf(err, "cannot ensure pre-active components, namespace %s", targetNs)
 		}
-	}
 
-	if q != nil {
-		atpComp.Status.SetPreActiveQueue(q.Status)
-	}
+		if !atpComp.IsActivePromotionCanceled() && !atpComp.Status.IsTimeout {
+			// to save pre-active queue after pre-active queue finished
+			q, err = c.ensurePreActiveComponentsTested(atpComp)
+			if err != nil {
+				return errors.Wrapf(err, "cannot ensure pre-active components finished, namespace %s", targetNs)
+			}
+		}
+
+		if q != nil {
+			atpComp.Status.SetPreActiveQueue(q.Status)
 
-	if len(q.Status.ImageMissingList) > 0 {
-		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerified, corev1.ConditionTrue,
-			"Image missing")
+			if len(q.Status.ImageMissingList) > 0 {
+				atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerified, corev1.ConditionTrue,
+					"Image missing")
+			}
+		}
 	}
 
 	if atpComp.IsActivePromotionFailure() || atpComp.IsActivePromotionCanceled() {
@@ -47,6 +55,14 @@ func (c *controller) collectResult(ctx context.Context, atpComp *s2hv1.ActivePro
 		return nil
 	}
 
+	c.setActivePromotionDiff(atpComp)
+	c.setOutdatedWarning(ctx, atpComp)
+
+	if c.isApprovalRequired(atpComp) {
+		c.requestApproval(ctx, atpComp)
+		return nil
+	}
+
 	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveDemotionStarted, corev1.ConditionTrue,
 		"Active demotion has been started")
 	atpComp.SetState(s2hv1.ActivePromotionDemoting, "Demoting an active environment")
@@ -67,3 +83,50 @@ func (c *controller) getActivePromotionVerificationReason(atpComp *s2hv1.ActiveP
 
 	return "Active environment has not been promoted"
 }
+
+// setActivePromotionDiff stores differences between the active and pre-active environments before promoting,
+// the active promotion will not be blocked if the diff cannot be created
+func (c *controller) setActivePromotionDiff(atpComp *s2hv1.ActivePromotion) {
+	diff, err := c.s2hCtrl.GetActivePromotionDiff(atpComp)
+	if err != nil {
+		logger.Error(err, "cannot get active promotion diff", "team", atpComp.Name)
+		return
+	}
+
+	atpComp.Status.Diff = diff
+}
+
+// setOutdatedWarning raises a warning if the active promotion would leave components outdated
+// longer than their warning duration, the active promotion will not be blocked
+func (c *controller) setOutdatedWarning(ctx context.Context, atpComp *s2hv1.ActivePromotion) {
+	teamName := atpComp.Name
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		logger.Error(err, "cannot get configuration", "team", teamName)
+		return
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.OutdatedNotification == nil {
+		return
+	}
+
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		logger.Error(err, "cannot get team", "team", teamName)
+		return
+	}
+
+	o := outdated.New(&config.Status.Used, teamComp.Status.DesiredComponentImageCreatedTime,
+		atpComp.Status.ActiveComponents)
+	warningComps := o.GetWarningComponents()
+	if len(warningComps) == 0 {
+		return
+	}
+
+	logger.Warn("active promotion would leave components outdated",
+		"team", teamName, "components", warningComps)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondOutdatedWarning, corev1.ConditionTrue,
+		fmt.Sprintf("Components would be outdated longer than the warning duration after promoting: %s",
+			strings.Join(warningComps, ", ")))
+}
@@ -1,6 +1,8 @@
 package activepromotion
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
@@ -14,6 +16,12 @@ const (
 	timeoutActiveDemotion            timeoutType = "ActiveDemotionTimeout"
 	timeoutActivePromotionRollback   timeoutType = "ActivePromotionRollbackTimeout"
 	timeoutActiveDemotionForRollback timeoutType = "ActiveDemotionForRollbackTimeout"
+	timeoutPostActiveVerification    timeoutType = "PostActiveVerificationTimeout"
+)
+
+const (
+	defaultPostActiveVerificationTimeout = 10 * time.Minute
+	defaultInPlaceDeployTimeout          = 30 * time.Minute
 )
 
 func (c *controller) isTimeoutFromConfig(atpComp *s2hv1.ActivePromotion, timeoutType timeoutType) (bool, error) {
@@ -26,6 +34,10 @@ func (c *controller) isTimeoutFromConfig(atpComp *s2hv1.ActivePromotion, timeout
 	case timeoutActivePromotion:
 		timeout = c.getActivePromotionTimeout(atpComp.Name, configCtrl)
 		startedTime = atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondStarted)
+		// waiting for approvals is not counted as promoting duration
+		if atpComp.Status.Approval != nil {
+			timeout.Duration += atpComp.Status.Approval.GetWaitingDuration(now.Time)
+		}
 	case timeoutActiveDemotion:
 		timeout = c.getActiveDemotionTimeout(atpComp.Name, configCtrl)
 		startedTime = atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActiveDemotionStarted)
@@ -35,6 +47,9 @@ func (c *controller) isTimeoutFromConfig(atpComp *s2hv1.ActivePromotion, timeout
 	case timeoutActiveDemotionForRollback:
 		timeout = c.getActiveDemotionTimeout(atpComp.Name, configCtrl)
 		startedTime = atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondRollbackStarted)
+	case timeoutPostActiveVerification:
+		timeout = c.getPostActiveVerificationTimeout(atpComp.Name)
+		startedTime = atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActiveVerificationStarted)
 	}
 
 	if startedTime == nil {
@@ -89,6 +104,75 @@ func (c *controller) getActivePromotionRollbackTimeout(teamName string, configCt
 	return timeout
 }
 
+func (c *controller) getApprovalConfig(teamName string) *s2hv1.ConfigActivePromotionApproval {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return nil
+	}
+
+	if config.Status.Used.ActivePromotion == nil {
+		return nil
+	}
+
+	return config.Status.Used.ActivePromotion.Approval
+}
+
+func (c *controller) getPostActiveVerificationConfig(teamName string) *s2hv1.ConfigPostActiveVerification {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return nil
+	}
+
+	if config.Status.Used.ActivePromotion == nil {
+		return nil
+	}
+
+	return config.Status.Used.ActivePromotion.PostActiveVerification
+}
+
+// getPostActiveVerificationTimeout returns the verification timeout including the initial delay
+func (c *controller) getPostActiveVerificationTimeout(teamName string) metav1.Duration {
+	timeout := metav1.Duration{Duration: defaultPostActiveVerificationTimeout}
+	verificationConfig := c.getPostActiveVerificationConfig(teamName)
+	if verificationConfig == nil {
+		return timeout
+	}
+
+	if verificationConfig.Timeout.Duration != 0 {
+		timeout = verificationConfig.Timeout
+	}
+	timeout.Duration += verificationConfig.InitialDelay.Duration
+
+	return timeout
+}
+
+func (c *controller) getActivePromotionStrategy(teamName string) s2hv1.ActivePromotionStrategy {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return s2hv1.ActivePromotionStrategyBlueGreen
+	}
+
+	if config.Status.Used.ActivePromotion == nil || config.Status.Used.ActivePromotion.Strategy == "" {
+		return s2hv1.ActivePromotionStrategyBlueGreen
+	}
+
+	return config.Status.Used.ActivePromotion.Strategy
+}
+
+func (c *controller) getInPlaceDeployTimeout(teamName string) time.Duration {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return defaultInPlaceDeployTimeout
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.Deployment == nil || atpConfig.Deployment.Timeout.Duration == 0 {
+		return defaultInPlaceDeployTimeout
+	}
+
+	return atpConfig.Deployment.Timeout.Duration
+}
+
 func (c *controller) getMaxActivePromotionRetry(teamName string) int {
 	configCtrl := c.s2hCtrl.GetConfigController()
 
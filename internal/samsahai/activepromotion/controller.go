@@ -2,6 +2,7 @@ package activepromotion
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -48,6 +49,10 @@ type controller struct {
 
 	wg       sync.WaitGroup
 	shutdown chan struct{}
+
+	// inPlaceUpgrades stores results of releases being upgraded in place, keyed by team and release name
+	inPlaceUpgrades map[string]chan error
+	inPlaceMtx      sync.Mutex
 }
 
 func New(
@@ -72,6 +77,8 @@ func New(
 		deployEngines: map[string]internal.DeployEngine{},
 		shutdown:      make(chan struct{}),
 		wg:            sync.WaitGroup{},
+
+		inPlaceUpgrades: map[string]chan error{},
 	}
 
 	if err := add(mgr, c); err != nil {
@@ -131,7 +138,13 @@ func (c *controller) checkActivePromotionTimeout(ctx context.Context, atpComp *s
 	}
 
 	// these states cannot be timeout
-	if c.stateCannotBeTimeoutOrCancel(atpComp.Status.State) {
+	if StateCannotBeTimeoutOrCancel(atpComp.Status.State) {
+		return nil
+	}
+
+	// waiting for approvals and post-active verification have their own timeout
+	if atpComp.Status.State == s2hv1.ActivePromotionWaitingForApproval ||
+		atpComp.Status.State == s2hv1.ActivePromotionVerifyingActive {
 		return nil
 	}
 
@@ -217,13 +230,18 @@ func (c *controller) deleteFinalizerWhenFinished(ctx context.Context, atpComp *s
 				return
 			}
 
-			if c.stateCannotBeTimeoutOrCancel(atpComp.Status.State) {
+			if StateCannotBeTimeoutOrCancel(atpComp.Status.State) {
 				return
 			}
 
+			canceledMsg := "Active promotion has been canceled"
+			if atpComp.Status.CanceledBy != "" {
+				canceledMsg = fmt.Sprintf("Active promotion has been canceled by %s", atpComp.Status.CanceledBy)
+			}
+
 			atpComp.Status.SetResult(s2hv1.ActivePromotionCanceled)
 			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActivePromoted, corev1.ConditionFalse,
-				"Active promotion has been canceled")
+				canceledMsg)
 
 			if c.isToRollbackState(atpComp) {
 				atpComp.Status.SetCondition(s2hv1.ActivePromotionCondRollbackStarted, corev1.ConditionTrue,
@@ -231,7 +249,7 @@ func (c *controller) deleteFinalizerWhenFinished(ctx context.Context, atpComp *s
 				atpComp.SetState(s2hv1.ActivePromotionRollback, "Active promotion has been canceled")
 			} else {
 				atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerified, corev1.ConditionFalse,
-					"Active promotion has been canceled")
+					canceledMsg)
 				atpComp.SetState(s2hv1.ActivePromotionCollectingPreActiveResult,
 					"Active promotion has been canceled")
 			}
@@ -257,7 +275,8 @@ func (c *controller) addFinalizer(atpComp *s2hv1.ActivePromotion) {
 	}
 }
 
-func (c *controller) stateCannotBeTimeoutOrCancel(state s2hv1.ActivePromotionState) bool {
+// StateCannotBeTimeoutOrCancel returns true if the active promotion in the given state cannot be timeout or canceled
+func StateCannotBeTimeoutOrCancel(state s2hv1.ActivePromotionState) bool {
 	// waiting state doesn't have finalizer
 	return state == s2hv1.ActivePromotionWaiting ||
 		state == s2hv1.ActivePromotionDestroyingPreviousActive ||
@@ -267,6 +286,11 @@ func (c *controller) stateCannotBeTimeoutOrCancel(state s2hv1.ActivePromotionSta
 }
 
 func (c *controller) isToRollbackState(atpComp *s2hv1.ActivePromotion) bool {
+	// active environment has not been touched while waiting for approvals
+	if atpComp.Status.State == s2hv1.ActivePromotionWaitingForApproval {
+		return false
+	}
+
 	return atpComp.Status.IsConditionTrue(s2hv1.ActivePromotionCondVerified)
 }
 
@@ -393,6 +417,17 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 			return reconcile.Result{}, err
 		}
 
+	case s2hv1.ActivePromotionWaitingForApproval:
+		if err := c.waitForApproval(atpComp); err != nil {
+			if s2herrors.IsEnsuringActivePromotionApproved(err) {
+				return reconcile.Result{
+					Requeue:      true,
+					RequeueAfter: 5 * time.Second,
+				}, nil
+			}
+			return reconcile.Result{}, err
+		}
+
 	case s2hv1.ActivePromotionDemoting:
 		if err := c.demoteActiveEnvironment(ctx, atpComp); err != nil {
 			if s2herrors.IsEnsuringActiveDemoted(err) || s2herrors.IsErrActiveDemotionTimeout(err) {
@@ -415,6 +450,17 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 			return reconcile.Result{}, err
 		}
 
+	case s2hv1.ActivePromotionVerifyingActive:
+		if err := c.verifyActiveEnvironment(ctx, atpComp); err != nil {
+			if s2herrors.IsEnsuringActiveEnvironmentVerified(err) {
+				return reconcile.Result{
+					Requeue:      true,
+					RequeueAfter: 5 * time.Second,
+				}, nil
+			}
+			return reconcile.Result{}, err
+		}
+
 	case s2hv1.ActivePromotionDestroyingPreviousActive:
 		if err := c.destroyPreviousActiveEnvironment(ctx, atpComp); err != nil {
 			if s2herrors.IsEnsuringNamespaceDestroyed(err) {
@@ -1,6 +1,8 @@
 package activepromotion
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
@@ -10,9 +12,8 @@ import (
 )
 
 func (c *controller) deployComponentsToTargetNamespace(atpComp *s2hv1.ActivePromotion) error {
-	teamName := atpComp.Name
 	targetNs := c.getTargetNamespace(atpComp)
-	q, err := c.ensurePreActiveComponentsDeployed(teamName, targetNs, atpComp.Spec.SkipTestRunner)
+	q, err := c.ensurePreActiveComponentsDeployed(atpComp)
 	if err != nil {
 		return err
 	}
@@ -35,8 +36,10 @@ func (c *controller) deployComponentsToTargetNamespace(atpComp *s2hv1.ActiveProm
 	return nil
 }
 
-func (c *controller) ensurePreActiveComponentsDeployed(teamName, targetNs string, skipTest bool) (*s2hv1.Queue, error) {
-	q, err := queue.EnsurePreActiveComponents(c.client, teamName, targetNs, skipTest)
+func (c *controller) ensurePreActiveComponentsDeployed(atpComp *s2hv1.ActivePromotion) (*s2hv1.Queue, error) {
+	targetNs := c.getTargetNamespace(atpComp)
+	q, err := queue.EnsurePreActiveComponents(c.client, atpComp.Name, targetNs, atpComp.Spec.SkipTestRunner,
+		getPreActiveQueueComponents(atpComp))
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot ensure pre-active components, namespace %s", targetNs)
 	}
@@ -54,9 +57,8 @@ func (c *controller) ensurePreActiveComponentsDeployed(teamName, targetNs string
 }
 
 func (c *controller) testPreActiveEnvironment(atpComp *s2hv1.ActivePromotion) error {
-	teamName := atpComp.Name
 	targetNs := c.getTargetNamespace(atpComp)
-	q, err := c.ensurePreActiveComponentsTested(teamName, targetNs, atpComp.Spec.SkipTestRunner)
+	q, err := c.ensurePreActiveComponentsTested(atpComp)
 	if err != nil {
 		return err
 	}
@@ -70,7 +72,9 @@ func (c *controller) testPreActiveEnvironment(atpComp *s2hv1.ActivePromotion) er
 	} else {
 		// in case failure test
 		message := "Test failed"
-		if q.IsTeamcityTestSuccess() || q.IsGitlabTestSuccess() {
+		if failedStage := q.Status.GetFailedTestStage(); failedStage != nil {
+			message = fmt.Sprintf("Test stage %s %s", failedStage.Name, failedStage.Result)
+		} else if q.IsTeamcityTestSuccess() || q.IsGitlabTestSuccess() {
 			if !q.IsTeamcityTestSuccess() {
 				message = "Test in Teamcity failed"
 			} else if !q.IsGitlabTestSuccess() {
@@ -88,8 +92,10 @@ func (c *controller) testPreActiveEnvironment(atpComp *s2hv1.ActivePromotion) er
 	return nil
 }
 
-func (c *controller) ensurePreActiveComponentsTested(teamName, targetNs string, skipTest bool) (*s2hv1.Queue, error) {
-	q, err := queue.EnsurePreActiveComponents(c.client, teamName, targetNs, skipTest)
+func (c *controller) ensurePreActiveComponentsTested(atpComp *s2hv1.ActivePromotion) (*s2hv1.Queue, error) {
+	targetNs := c.getTargetNamespace(atpComp)
+	q, err := queue.EnsurePreActiveComponents(c.client, atpComp.Name, targetNs, atpComp.Spec.SkipTestRunner,
+		getPreActiveQueueComponents(atpComp))
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot ensure pre-active components, namespace %s", targetNs)
 	}
@@ -100,3 +106,28 @@ func (c *controller) ensurePreActiveComponentsTested(teamName, targetNs string,
 
 	return nil, s2herrors.ErrEnsureComponentTested
 }
+
+// getPreActiveQueueComponents returns promoted components in case of promoting a subset of components,
+// only these components are verified in the pre-active environment
+func getPreActiveQueueComponents(atpComp *s2hv1.ActivePromotion) s2hv1.QueueComponents {
+	if len(atpComp.Status.PromotedComponents) == 0 {
+		return nil
+	}
+
+	comps := make(s2hv1.QueueComponents, 0, len(atpComp.Status.PromotedComponents))
+	for _, compName := range atpComp.Status.PromotedComponents {
+		stableComp, ok := atpComp.Status.ActiveComponents[compName]
+		if !ok {
+			continue
+		}
+
+		comps = append(comps, &s2hv1.QueueComponent{
+			Name:         compName,
+			Repository:   stableComp.Spec.Repository,
+			Version:      stableComp.Spec.Version,
+			ChartVersion: stableComp.Spec.ChartVersion,
+		})
+	}
+
+	return comps
+}
@@ -22,16 +22,27 @@ const (
 	activeEnvironment         envType = "active"
 	preActiveEnvironment      envType = "preActive"
 	previousActiveEnvironment envType = "previousActive"
+	retainedActiveEnvironment envType = "retainedActive"
 )
 
 func (c *controller) destroyPreviousActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	teamName := atpComp.Name
 	prevNs := atpComp.Status.PreviousActiveNamespace
 	destroyedTime := atpComp.Status.DestroyedTime
+	retention := c.getRetainedPreviousActives(teamName)
+	if retention > 0 {
+		return c.retainPreviousActiveEnvironment(ctx, atpComp, retention)
+	}
+
 	if err := c.destroyPreviousActiveEnvironmentAt(ctx, teamName, prevNs, destroyedTime); err != nil {
 		return err
 	}
 
+	// namespaces which have been retained before the retention was reduced to zero are destroyed as well
+	if err := c.destroyExpiredRetainedActiveEnvironments(ctx, teamName, retention, destroyedTime); err != nil {
+		return err
+	}
+
 	logger.Debug("previous active namespace has been destroyed",
 		"team", teamName, "status", atpComp.Status.Result, "namespace", prevNs)
 	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondPreviousActiveDestroyed, corev1.ConditionTrue,
@@ -64,10 +75,95 @@ func (c *controller) destroyPreviousActiveEnvironmentAt(ctx context.Context, tea
 	return nil
 }
 
+// retainPreviousActiveEnvironment scales down the previous active namespace instead of destroying it,
+// retained namespaces which exceed the retention are destroyed
+func (c *controller) retainPreviousActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion, retention int) error {
+	teamName := atpComp.Name
+	prevNs := atpComp.Status.PreviousActiveNamespace
+	destroyedTime := atpComp.Status.DestroyedTime
+
+	if prevNs != "" {
+		if destroyedTime.IsZero() || !metav1.Now().After(destroyedTime.Time) {
+			return s2herrors.ErrEnsureNamespaceDestroyed
+		}
+
+		teamComp, err := c.getTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		if teamComp.Status.Namespace.PreviousActive == prevNs {
+			if err := c.s2hCtrl.RetainPreviousActiveEnvironment(teamName, prevNs); err != nil {
+				return errors.Wrapf(err, "cannot retain previous active environment, namespace %s", prevNs)
+			}
+		}
+	}
+
+	if err := c.destroyExpiredRetainedActiveEnvironments(ctx, teamName, retention, destroyedTime); err != nil {
+		return err
+	}
+
+	logger.Debug("previous active namespace has been retained",
+		"team", teamName, "status", atpComp.Status.Result, "namespace", prevNs)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondPreviousActiveDestroyed, corev1.ConditionTrue,
+		"Previous active namespace has been scaled down and retained for rollback")
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondFinished, corev1.ConditionTrue,
+		"Active promotion process has been finished")
+	atpComp.SetState(s2hv1.ActivePromotionFinished, "Completed")
+
+	return nil
+}
+
+func (c *controller) destroyExpiredRetainedActiveEnvironments(ctx context.Context, teamName string, retention int,
+	startedCleanupTime *metav1.Time) error {
+
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	retainedNamespaces := teamComp.Status.Namespace.RetainedActives
+	if len(retainedNamespaces) <= retention {
+		return nil
+	}
+
+	for _, ns := range retainedNamespaces[retention:] {
+		if err := c.ensureDestroyEnvironment(ctx, retainedActiveEnvironment, teamName, ns, startedCleanupTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *controller) getRetainedPreviousActives(teamName string) int {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return 0
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil {
+		return 0
+	}
+
+	return atpConfig.RetainedPreviousActives
+}
+
 func (c *controller) destroyPreActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	targetNs := c.getTargetNamespace(atpComp)
 	teamName := atpComp.Name
 
+	if atpComp.Status.IsInPlacePromotion() {
+		// target namespace is the active namespace, nothing has been upgraded yet
+		logger.Debug("in-place promotion has not been promoted, skip destroying active namespace",
+			"team", teamName, "status", atpComp.Status.Result, "namespace", targetNs)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondFinished, corev1.ConditionTrue,
+			"Active promotion process has been finished")
+		atpComp.SetState(s2hv1.ActivePromotionFinished, "Completed")
+		return nil
+	}
+
 	startedCleaningTime := atpComp.Status.GetConditionLatestTime(s2hv1.ActivePromotionCondActivePromoted)
 	if err := c.ensureDestroyEnvironment(ctx, preActiveEnvironment, teamName, targetNs, startedCleaningTime); err != nil {
 		return err
@@ -125,6 +221,15 @@ func (c *controller) ensureDestroyEnvironment(ctx context.Context, envType envTy
 				return errors.Wrapf(err, "cannot destroy previous active environment, namespace %s", ns)
 			}
 
+			return s2herrors.ErrEnsureNamespaceDestroyed
+		}
+
+	case retainedActiveEnvironment:
+		if err := c.s2hCtrl.DestroyRetainedActiveEnvironment(teamName, ns); err != nil {
+			if !s2herrors.IsNamespaceStillExists(err) {
+				return errors.Wrapf(err, "cannot destroy retained active environment, namespace %s", ns)
+			}
+
 			return s2herrors.ErrEnsureNamespaceDestroyed
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -19,6 +20,20 @@ import (
 
 func (c *controller) createPreActiveEnvAndDeployStableCompObjects(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	teamName := atpComp.Name
+	if c.getActivePromotionStrategy(teamName) == s2hv1.ActivePromotionStrategyInPlace {
+		teamComp, err := c.getTeam(ctx, teamName)
+		if err != nil {
+			return err
+		}
+
+		if teamComp.Status.Namespace.Active != "" {
+			return c.prepareInPlacePromotion(ctx, atpComp, teamComp)
+		}
+
+		logger.Info("there is no active namespace to be upgraded in place, creating pre-active environment",
+			"team", teamName)
+	}
+
 	suffix := c.randomToken(tokenLength, atpComp.CreationTimestamp.UnixNano())
 	targetNs := fmt.Sprintf("%s%s-%s", internal.AppPrefix, teamName, suffix)
 
@@ -35,7 +50,8 @@ func (c *controller) createPreActiveEnvAndDeployStableCompObjects(ctx context.Co
 	logger.Debug("start copying stable component objects into target namespace",
 		"team", teamName, "namespace", targetNs)
 	stagingNs := teamComp.Status.Namespace.Staging
-	if err = c.copyStableComponentObjectsToTargetNamespace(ctx, atpComp, stagingNs, targetNs); err != nil {
+	activeNs := teamComp.Status.Namespace.Active
+	if err = c.copyStableComponentObjectsToTargetNamespace(ctx, atpComp, stagingNs, activeNs, targetNs); err != nil {
 		return err
 	}
 
@@ -101,11 +117,11 @@ func (c *controller) createPreActiveEnvironment(ctx context.Context, teamName, p
 func (c *controller) copyStableComponentObjectsToTargetNamespace(
 	ctx context.Context,
 	atpComp *s2hv1.ActivePromotion,
-	baseNs, targetNs string,
+	baseNs, activeNs, targetNs string,
 ) error {
-	stableComps, err := c.getStableComponentObjects(ctx, baseNs)
-	if err != nil && !k8serrors.IsNotFound(err) {
-		return errors.Wrapf(err, "cannot get stable components from staging namespace %s", baseNs)
+	stableComps, err := c.getStableComponentObjectsToPromote(ctx, atpComp, baseNs, activeNs)
+	if err != nil {
+		return err
 	}
 
 	if err = c.deployStableComponentObjects(ctx, stableComps, targetNs); err != nil {
@@ -117,6 +133,126 @@ func (c *controller) copyStableComponentObjectsToTargetNamespace(
 	return nil
 }
 
+// getStableComponentObjectsToPromote returns stable components of the staging namespace,
+// only components to be promoted are taken from staging in case of promoting a subset of components
+func (c *controller) getStableComponentObjectsToPromote(
+	ctx context.Context,
+	atpComp *s2hv1.ActivePromotion,
+	baseNs, activeNs string,
+) (*s2hv1.StableComponentList, error) {
+	stableComps, err := c.getStableComponentObjects(ctx, baseNs)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "cannot get stable components from staging namespace %s", baseNs)
+	}
+
+	if atpComp.Spec.IsPartialPromotion() {
+		return c.getPartialStableComponentObjects(ctx, atpComp, stableComps, activeNs)
+	}
+
+	return stableComps, nil
+}
+
+// getPartialStableComponentObjects returns staging stable components of the components to be promoted
+// including their dependencies, the other components are taken from the active namespace.
+// Components which do not exist in the active namespace are taken from staging and promoted as well.
+func (c *controller) getPartialStableComponentObjects(
+	ctx context.Context,
+	atpComp *s2hv1.ActivePromotion,
+	stagingComps *s2hv1.StableComponentList,
+	activeNs string,
+) (*s2hv1.StableComponentList, error) {
+	teamName := atpComp.Name
+	comps, err := c.s2hCtrl.GetConfigController().GetComponents(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	promotedComps := getPromotedComponents(atpComp.Spec.Components, comps)
+
+	stagingCompsMap := make(map[string]s2hv1.StableComponent)
+	for _, comp := range stagingComps.Items {
+		stagingCompsMap[comp.Spec.Name] = comp
+	}
+
+	for _, compName := range atpComp.Spec.Components {
+		if _, ok := stagingCompsMap[compName]; !ok {
+			return nil, fmt.Errorf("component %s to be promoted does not exist in staging", compName)
+		}
+	}
+
+	activeCompsMap := make(map[string]s2hv1.StableComponent)
+	if activeNs != "" {
+		activeComps, err := c.getStableComponentObjects(ctx, activeNs)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "cannot get stable components from active namespace %s", activeNs)
+		}
+
+		for _, comp := range activeComps.Items {
+			activeCompsMap[comp.Spec.Name] = comp
+		}
+	}
+
+	partialComps, promotedCompNames := mergePartialStableComponents(stagingComps, activeCompsMap, promotedComps)
+	for _, compName := range promotedCompNames {
+		if _, ok := promotedComps[compName]; !ok {
+			logger.Warn("component does not exist in active namespace, promoting staging version",
+				"team", teamName, "component", compName, "namespace", activeNs)
+		}
+	}
+
+	atpComp.Status.PromotedComponents = promotedCompNames
+
+	return partialComps, nil
+}
+
+// mergePartialStableComponents returns staging stable components of the promoted components
+// and active stable components of the others, components which do not exist in active are promoted from staging.
+// Sorted names of all components promoted from staging are returned
+func mergePartialStableComponents(
+	stagingComps *s2hv1.StableComponentList,
+	activeCompsMap map[string]s2hv1.StableComponent,
+	promotedComps map[string]struct{},
+) (*s2hv1.StableComponentList, []string) {
+	partialComps := &s2hv1.StableComponentList{}
+	promotedCompNames := make([]string, 0)
+	for _, comp := range stagingComps.Items {
+		compName := comp.Spec.Name
+		if _, ok := promotedComps[compName]; !ok {
+			if activeComp, ok := activeCompsMap[compName]; ok {
+				partialComps.Items = append(partialComps.Items, activeComp)
+				continue
+			}
+		}
+
+		partialComps.Items = append(partialComps.Items, comp)
+		promotedCompNames = append(promotedCompNames, compName)
+	}
+
+	sort.Strings(promotedCompNames)
+
+	return partialComps, promotedCompNames
+}
+
+// getPromotedComponents returns names of the given components and their dependencies
+func getPromotedComponents(compNames []string, comps map[string]*s2hv1.Component) map[string]struct{} {
+	promotedComps := make(map[string]struct{})
+	for _, compName := range compNames {
+		promotedComps[compName] = struct{}{}
+	}
+
+	for compName, comp := range comps {
+		if comp == nil || comp.Parent == "" {
+			continue
+		}
+
+		if _, ok := promotedComps[comp.Parent]; ok {
+			promotedComps[compName] = struct{}{}
+		}
+	}
+
+	return promotedComps
+}
+
 func (c *controller) getStableComponentObjects(ctx context.Context, ns string) (*s2hv1.StableComponentList, error) {
 	stableComps := &s2hv1.StableComponentList{}
 	if err := c.client.List(ctx, stableComps, &client.ListOptions{Namespace: ns}); err != nil {
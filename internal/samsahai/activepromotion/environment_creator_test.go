@@ -0,0 +1,67 @@
+package activepromotion
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Partial active promotion", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should include dependencies of the components to be promoted", func() {
+		comps := map[string]*s2hv1.Component{
+			"wordpress": {Name: "wordpress"},
+			"mariadb":   {Name: "mariadb", Parent: "wordpress"},
+			"redis":     {Name: "redis"},
+		}
+
+		promotedComps := getPromotedComponents([]string{"wordpress"}, comps)
+		g.Expect(promotedComps).To(HaveLen(2))
+		g.Expect(promotedComps).To(HaveKey("wordpress"))
+		g.Expect(promotedComps).To(HaveKey("mariadb"))
+	})
+
+	It("should promote components which do not exist in active from staging", func() {
+		newComp := func(name, version string) s2hv1.StableComponent {
+			return s2hv1.StableComponent{Spec: s2hv1.StableComponentSpec{Name: name, Version: version}}
+		}
+		stagingComps := &s2hv1.StableComponentList{Items: []s2hv1.StableComponent{
+			newComp("wordpress", "5.2.4"),
+			newComp("redis", "5.0.7"),
+			newComp("mongodb", "4.2.1"),
+		}}
+		activeCompsMap := map[string]s2hv1.StableComponent{
+			"wordpress": newComp("wordpress", "5.2.3"),
+			"redis":     newComp("redis", "5.0.5"),
+		}
+		promotedComps := map[string]struct{}{"redis": {}}
+
+		partialComps, promotedCompNames := mergePartialStableComponents(stagingComps, activeCompsMap, promotedComps)
+		g.Expect(partialComps.Items).To(Equal([]s2hv1.StableComponent{
+			newComp("wordpress", "5.2.3"),
+			newComp("redis", "5.0.7"),
+			newComp("mongodb", "4.2.1"),
+		}))
+		g.Expect(promotedCompNames).To(Equal([]string{"mongodb", "redis"}))
+	})
+
+	It("should verify only promoted components in pre-active queue", func() {
+		atpComp := &s2hv1.ActivePromotion{
+			Status: s2hv1.ActivePromotionStatus{
+				ActiveComponents: map[string]s2hv1.StableComponent{
+					"redis": {Spec: s2hv1.StableComponentSpec{Name: "redis", Repository: "bitnami/redis", Version: "5.0.7"}},
+					"mariadb": {Spec: s2hv1.StableComponentSpec{Name: "mariadb", Repository: "bitnami/mariadb",
+						Version: "10.3.18"}},
+				},
+			},
+		}
+		g.Expect(getPreActiveQueueComponents(atpComp)).To(BeNil())
+
+		atpComp.Status.PromotedComponents = []string{"redis"}
+		g.Expect(getPreActiveQueueComponents(atpComp)).To(Equal(s2hv1.QueueComponents{
+			{Name: "redis", Repository: "bitnami/redis", Version: "5.0.7"},
+		}))
+	})
+})
@@ -0,0 +1,491 @@
+package activepromotion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	configctrl "github.com/agoda-com/samsahai/internal/config"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/valuesutil"
+)
+
+// prepareInPlacePromotion plans releases to be upgraded in the active namespace instead of creating
+// a pre-active namespace, the pre-active verification is skipped
+func (c *controller) prepareInPlacePromotion(ctx context.Context, atpComp *s2hv1.ActivePromotion, teamComp *s2hv1.Team) error {
+	teamName := atpComp.Name
+	stagingNs := teamComp.Status.Namespace.Staging
+	activeNs := teamComp.Status.Namespace.Active
+
+	logger.Debug("start preparing in-place active promotion", "team", teamName, "namespace", activeNs)
+	stableComps, err := c.getStableComponentObjectsToPromote(ctx, atpComp, stagingNs, activeNs)
+	if err != nil {
+		return err
+	}
+
+	activeComps, err := c.getStableComponentObjects(ctx, activeNs)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot get stable components from active namespace %s", activeNs)
+	}
+
+	prevComps := make(map[string]s2hv1.StableComponent)
+	for _, comp := range activeComps.Items {
+		prevComps[comp.Spec.Name] = s2hv1.StableComponent{Spec: comp.Spec}
+	}
+
+	configCtrl := c.s2hCtrl.GetConfigController()
+	comps, err := configCtrl.GetComponents(teamName)
+	if err != nil {
+		return err
+	}
+
+	config, err := configCtrl.Get(teamName)
+	if err != nil {
+		return err
+	}
+
+	atpComp.Status.SetActiveComponents(stableComps.Items)
+	releases := planInPlaceReleases(comps, config.Status.Used.GetComponentDependencies(),
+		atpComp.Status.ActiveComponents, prevComps, activeNs)
+
+	atpComp.Status.InPlace = &s2hv1.ActivePromotionInPlace{
+		Releases:           releases,
+		PreviousComponents: prevComps,
+	}
+	atpComp.Status.SetNamespace(activeNs, "")
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondPreActiveCreated, corev1.ConditionTrue,
+		"Pre-active environment is skipped for in-place promotion")
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerificationStarted, corev1.ConditionTrue,
+		"Verifying pre-active environment")
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondVerified, corev1.ConditionTrue,
+		"Pre-active verification is skipped for in-place promotion")
+	atpComp.SetState(s2hv1.ActivePromotionCollectingPreActiveResult,
+		"Collecting in-place promotion result")
+
+	return nil
+}
+
+// planInPlaceReleases returns releases of parent components to be upgraded in dependency order,
+// a parent component is upgraded if itself or one of its dependencies has been changed
+func planInPlaceReleases(
+	comps map[string]*s2hv1.Component,
+	deps map[string][]string,
+	newComps map[string]s2hv1.StableComponent,
+	prevComps map[string]s2hv1.StableComponent,
+	activeNs string,
+) []s2hv1.InPlaceRelease {
+	changedParents := make(map[string]struct{})
+	for compName, comp := range comps {
+		newComp, ok := newComps[compName]
+		if !ok {
+			continue
+		}
+
+		if prevComp, ok := prevComps[compName]; ok && !prevComp.IsVersionChanged(&newComp) {
+			continue
+		}
+
+		changedParents[getParentName(comp)] = struct{}{}
+	}
+
+	releases := make([]s2hv1.InPlaceRelease, 0)
+	for _, compName := range sortComponentsByDependencies(changedParents, comps, deps) {
+		releases = append(releases, s2hv1.InPlaceRelease{
+			Component:   compName,
+			ReleaseName: internal.GenReleaseName(activeNs, compName),
+			State:       s2hv1.InPlaceReleasePending,
+		})
+	}
+
+	return releases
+}
+
+// sortComponentsByDependencies returns the given parent components sorted by their dependencies,
+// components which are depended on come first
+func sortComponentsByDependencies(
+	parents map[string]struct{},
+	comps map[string]*s2hv1.Component,
+	deps map[string][]string,
+) []string {
+	names := make([]string, 0, len(parents))
+	for name := range parents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sorted := make([]string, 0, len(names))
+	visited := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+
+		dependsOn := append([]string(nil), deps[name]...)
+		sort.Strings(dependsOn)
+		for _, dep := range dependsOn {
+			if comp, ok := comps[dep]; ok {
+				dep = getParentName(comp)
+			}
+			if dep != name {
+				visit(dep)
+			}
+		}
+
+		if _, ok := parents[name]; ok {
+			sorted = append(sorted, name)
+		}
+	}
+
+	for _, name := range names {
+		visit(name)
+	}
+
+	return sorted
+}
+
+func getParentName(comp *s2hv1.Component) string {
+	if comp.Parent != "" {
+		return comp.Parent
+	}
+
+	return comp.Name
+}
+
+// upgradeActiveReleasesInPlace upgrades planned releases in the active namespace one by one
+func (c *controller) upgradeActiveReleasesInPlace(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	activeNs := c.getTargetNamespace(atpComp)
+
+	for i := range atpComp.Status.InPlace.Releases {
+		rel := &atpComp.Status.InPlace.Releases[i]
+
+		switch rel.State {
+		case s2hv1.InPlaceReleaseUpgraded:
+			continue
+
+		case s2hv1.InPlaceReleaseFailed:
+			return s2herrors.ErrReleaseFailed
+
+		case s2hv1.InPlaceReleasePending:
+			prevRevision, err := c.getLatestReleaseRevision(teamName, activeNs, rel.ReleaseName)
+			if err != nil {
+				return err
+			}
+
+			if err := c.deployInPlaceStableComponents(ctx, atpComp, rel.Component); err != nil {
+				return err
+			}
+
+			rel.PreviousRevision = prevRevision
+			rel.State = s2hv1.InPlaceReleaseUpgrading
+			rel.Message = "Upgrading release"
+			if err := c.updateActivePromotion(ctx, atpComp); err != nil {
+				return err
+			}
+
+			logger.Info("start upgrading release in place",
+				"team", teamName, "namespace", activeNs, "release", rel.ReleaseName)
+			c.startInPlaceUpgrade(atpComp, rel.Component, rel.ReleaseName)
+			return s2herrors.ErrEnsureActivePromoted
+
+		case s2hv1.InPlaceReleaseUpgrading:
+			finished, err := c.getInPlaceUpgradeResult(atpComp, rel.Component, rel.ReleaseName)
+			if !finished {
+				return s2herrors.ErrEnsureActivePromoted
+			}
+
+			if err != nil {
+				logger.Error(err, "cannot upgrade release in place",
+					"team", teamName, "namespace", activeNs, "release", rel.ReleaseName)
+				rel.State = s2hv1.InPlaceReleaseFailed
+				rel.Message = err.Error()
+				return s2herrors.ErrReleaseFailed
+			}
+
+			logger.Info("release has been upgraded in place",
+				"team", teamName, "namespace", activeNs, "release", rel.ReleaseName)
+			rel.State = s2hv1.InPlaceReleaseUpgraded
+			rel.Message = "Release has been upgraded"
+		}
+	}
+
+	return nil
+}
+
+// startInPlaceUpgrade upgrades the release asynchronously,
+// the upgrade is restarted if the release is upgrading but there is no upgrade in progress e.g. samsahai restarted
+func (c *controller) startInPlaceUpgrade(atpComp *s2hv1.ActivePromotion, compName, releaseName string) {
+	key := genInPlaceUpgradeKey(atpComp.Name, releaseName)
+
+	c.inPlaceMtx.Lock()
+	defer c.inPlaceMtx.Unlock()
+
+	if _, ok := c.inPlaceUpgrades[key]; ok {
+		return
+	}
+
+	resultCh := make(chan error, 1)
+	c.inPlaceUpgrades[key] = resultCh
+
+	atp := atpComp.DeepCopy()
+	go func() {
+		resultCh <- c.upgradeRelease(atp, compName, releaseName)
+	}()
+}
+
+// getInPlaceUpgradeResult returns whether the release upgrading has been finished and its error
+func (c *controller) getInPlaceUpgradeResult(atpComp *s2hv1.ActivePromotion, compName, releaseName string) (bool, error) {
+	key := genInPlaceUpgradeKey(atpComp.Name, releaseName)
+
+	c.inPlaceMtx.Lock()
+	resultCh, ok := c.inPlaceUpgrades[key]
+	c.inPlaceMtx.Unlock()
+
+	if !ok {
+		c.startInPlaceUpgrade(atpComp, compName, releaseName)
+		return false, nil
+	}
+
+	select {
+	case err := <-resultCh:
+		c.inPlaceMtx.Lock()
+		delete(c.inPlaceUpgrades, key)
+		c.inPlaceMtx.Unlock()
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+// isInPlaceUpgradeRunning returns true if there is an upgrade of the release in progress
+func (c *controller) isInPlaceUpgradeRunning(teamName, releaseName string) bool {
+	key := genInPlaceUpgradeKey(teamName, releaseName)
+
+	c.inPlaceMtx.Lock()
+	defer c.inPlaceMtx.Unlock()
+
+	resultCh, ok := c.inPlaceUpgrades[key]
+	if !ok {
+		return false
+	}
+
+	select {
+	case <-resultCh:
+		delete(c.inPlaceUpgrades, key)
+		return false
+	default:
+		return true
+	}
+}
+
+func genInPlaceUpgradeKey(teamName, releaseName string) string {
+	return teamName + "/" + releaseName
+}
+
+// upgradeRelease upgrades the release of parent component with values of the new stable components
+func (c *controller) upgradeRelease(atpComp *s2hv1.ActivePromotion, compName, releaseName string) error {
+	teamName := atpComp.Name
+	activeNs := atpComp.Status.TargetNamespace
+
+	configCtrl := c.s2hCtrl.GetConfigController()
+	parentComps, err := configCtrl.GetParentComponents(teamName)
+	if err != nil {
+		return err
+	}
+
+	comp, ok := parentComps[compName]
+	if !ok {
+		return fmt.Errorf("component %s does not exist in config", compName)
+	}
+
+	config, err := configCtrl.Get(teamName)
+	if err != nil {
+		return err
+	}
+	cfg := &config.Status.Used
+
+	baseValues, err := configctrl.GetEnvComponentValues(cfg, compName, teamName, s2hv1.EnvBase)
+	if err != nil {
+		return err
+	}
+
+	stableMap := atpComp.Status.ActiveComponents
+	values := valuesutil.GenStableComponentValues(comp, stableMap, baseValues)
+
+	activeValues, err := configctrl.GetEnvValues(cfg, s2hv1.EnvActive, teamName)
+	if err != nil {
+		return err
+	}
+	if compValues, ok := activeValues[compName]; ok {
+		values = valuesutil.MergeValues(values, compValues)
+	}
+
+	chartComp := comp
+	if chartVersion := stableMap[compName].Spec.ChartVersion; chartVersion != "" && chartVersion != comp.Chart.Version {
+		chartComp = comp.DeepCopy()
+		chartComp.Chart.Version = chartVersion
+	}
+
+	deployTimeout := c.getInPlaceDeployTimeout(teamName)
+	deployEngine := c.s2hCtrl.GetActivePromotionDeployEngine(teamName, activeNs)
+	return deployEngine.Create(releaseName, chartComp, chartComp, values, &deployTimeout)
+}
+
+// getLatestReleaseRevision returns the latest revision of the release, 0 means the release has not been installed
+func (c *controller) getLatestReleaseRevision(teamName, ns, releaseName string) (int, error) {
+	deployEngine := c.s2hCtrl.GetActivePromotionDeployEngine(teamName, ns)
+	histories, err := deployEngine.GetHistories(releaseName)
+	if err != nil {
+		if errors.Cause(err) == driver.ErrReleaseNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "cannot get histories of release %s", releaseName)
+	}
+
+	revision := 0
+	for _, rel := range histories {
+		if rel.Version > revision {
+			revision = rel.Version
+		}
+	}
+
+	return revision, nil
+}
+
+// deployInPlaceStableComponents updates stable components of the parent component and its dependencies
+// in the active namespace
+func (c *controller) deployInPlaceStableComponents(ctx context.Context, atpComp *s2hv1.ActivePromotion, parentName string) error {
+	comps, err := c.s2hCtrl.GetConfigController().GetComponents(atpComp.Name)
+	if err != nil {
+		return err
+	}
+
+	stableComps := &s2hv1.StableComponentList{}
+	for compName, comp := range comps {
+		if getParentName(comp) != parentName {
+			continue
+		}
+
+		if stableComp, ok := atpComp.Status.ActiveComponents[compName]; ok {
+			stableComps.Items = append(stableComps.Items, newStableComponentObject(stableComp))
+		}
+	}
+
+	return c.deployStableComponentObjects(ctx, stableComps, atpComp.Status.TargetNamespace)
+}
+
+// rollbackActiveEnvironmentInPlace rolls back upgraded releases in reverse order
+// and restores stable components of the active namespace
+func (c *controller) rollbackActiveEnvironmentInPlace(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	if err := c.checkRollbackTimeout(ctx, atpComp); err != nil {
+		return err
+	}
+
+	teamName := atpComp.Name
+	activeNs := atpComp.Status.TargetNamespace
+	releases := atpComp.Status.InPlace.Releases
+
+	for _, rel := range releases {
+		if c.isInPlaceUpgradeRunning(teamName, rel.ReleaseName) {
+			logger.Debug("waiting for release upgrading before rolling back",
+				"team", teamName, "namespace", activeNs, "release", rel.ReleaseName)
+			return s2herrors.ErrRollingBackActivePromotion
+		}
+	}
+
+	deployEngine := c.s2hCtrl.GetActivePromotionDeployEngine(teamName, activeNs)
+	for i := len(releases) - 1; i >= 0; i-- {
+		rel := &releases[i]
+		if rel.State == s2hv1.InPlaceReleasePending || rel.State == s2hv1.InPlaceReleaseRolledBack {
+			continue
+		}
+
+		if rel.PreviousRevision == 0 {
+			if err := deployEngine.Delete(rel.ReleaseName); err != nil {
+				return errors.Wrapf(err, "cannot delete release %s", rel.ReleaseName)
+			}
+		} else if err := deployEngine.Rollback(rel.ReleaseName, rel.PreviousRevision); err != nil {
+			return errors.Wrapf(err, "cannot rollback release %s to revision %d", rel.ReleaseName, rel.PreviousRevision)
+		}
+
+		logger.Info("release has been rolled back in place",
+			"team", teamName, "namespace", activeNs, "release", rel.ReleaseName, "revision", rel.PreviousRevision)
+		rel.State = s2hv1.InPlaceReleaseRolledBack
+		rel.Message = fmt.Sprintf("Release has been rolled back to revision %d", rel.PreviousRevision)
+	}
+
+	if err := c.restoreInPlaceStableComponents(ctx, atpComp); err != nil {
+		return err
+	}
+
+	if err := c.s2hCtrl.SwitchActiveGateway(teamName, activeNs); err != nil {
+		return errors.Wrapf(err, "cannot switch active gateway back to namespace %s", activeNs)
+	}
+
+	logger.Debug("activepromotion has been rolled back in place",
+		"team", teamName, "status", atpComp.Status.Result, "namespace", activeNs)
+	atpComp.Status.SetRollbackStatus(s2hv1.ActivePromotionRollbackSuccess)
+	atpComp.Status.SetCondition(s2hv1.ActivePromotionCondFinished, corev1.ConditionTrue,
+		"Active promotion process has been finished, rolled back successfully")
+	atpComp.SetState(s2hv1.ActivePromotionFinished, "Completed")
+
+	return nil
+}
+
+// restoreInPlaceStableComponents restores stable components of the active namespace and active components of team
+// to the ones before upgrading
+func (c *controller) restoreInPlaceStableComponents(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	activeNs := atpComp.Status.TargetNamespace
+	prevComps := atpComp.Status.InPlace.PreviousComponents
+
+	for compName := range atpComp.Status.ActiveComponents {
+		if _, ok := prevComps[compName]; ok {
+			continue
+		}
+
+		stableComp := &s2hv1.StableComponent{
+			ObjectMeta: metav1.ObjectMeta{Name: compName, Namespace: activeNs},
+		}
+		if err := c.client.Delete(ctx, stableComp); err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete stable component %s from active namespace %s", compName, activeNs)
+		}
+	}
+
+	stableComps := &s2hv1.StableComponentList{}
+	for _, comp := range prevComps {
+		stableComps.Items = append(stableComps.Items, newStableComponentObject(comp))
+	}
+	if err := c.deployStableComponentObjects(ctx, stableComps, activeNs); err != nil {
+		return err
+	}
+
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	if teamComp.Status.Namespace.Active != activeNs {
+		return nil
+	}
+
+	teamComp.Status.SetActiveComponents(prevComps)
+	return c.s2hCtrl.SetActiveNamespace(teamComp, activeNs)
+}
+
+func newStableComponentObject(comp s2hv1.StableComponent) s2hv1.StableComponent {
+	return s2hv1.StableComponent{
+		ObjectMeta: metav1.ObjectMeta{Name: comp.Spec.Name},
+		Spec:       comp.Spec,
+	}
+}
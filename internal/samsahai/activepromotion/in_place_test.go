@@ -0,0 +1,54 @@
+package activepromotion
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("In-place active promotion", func() {
+	g := NewWithT(GinkgoT())
+
+	comps := map[string]*s2hv1.Component{
+		"wordpress": {Name: "wordpress"},
+		"mariadb":   {Name: "mariadb", Parent: "wordpress"},
+		"redis":     {Name: "redis"},
+		"api":       {Name: "api"},
+	}
+
+	newStableComp := func(name, version string) s2hv1.StableComponent {
+		return s2hv1.StableComponent{Spec: s2hv1.StableComponentSpec{Name: name, Repository: name, Version: version}}
+	}
+
+	It("should sort components by their dependencies", func() {
+		deps := map[string][]string{
+			"api":       {"wordpress", "redis"},
+			"wordpress": {"redis"},
+		}
+		parents := map[string]struct{}{"api": {}, "wordpress": {}, "redis": {}}
+
+		g.Expect(sortComponentsByDependencies(parents, comps, deps)).To(Equal([]string{"redis", "wordpress", "api"}))
+	})
+
+	It("should plan only releases of changed components", func() {
+		prevComps := map[string]s2hv1.StableComponent{
+			"wordpress": newStableComp("wordpress", "5.2"),
+			"mariadb":   newStableComp("mariadb", "10.3"),
+			"redis":     newStableComp("redis", "5.0"),
+		}
+		newComps := map[string]s2hv1.StableComponent{
+			"wordpress": newStableComp("wordpress", "5.2"),
+			"mariadb":   newStableComp("mariadb", "10.4"),
+			"redis":     newStableComp("redis", "5.0"),
+			"api":       newStableComp("api", "1.0"),
+		}
+		deps := map[string][]string{"wordpress": {"api"}}
+
+		releases := planInPlaceReleases(comps, deps, newComps, prevComps, "s2h-team-active")
+		g.Expect(releases).To(Equal([]s2hv1.InPlaceRelease{
+			{Component: "api", ReleaseName: "s2h-team-active-api", State: s2hv1.InPlaceReleasePending},
+			{Component: "wordpress", ReleaseName: "s2h-team-active-wordpress", State: s2hv1.InPlaceReleasePending},
+		}))
+	})
+})
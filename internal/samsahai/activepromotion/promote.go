@@ -15,13 +15,21 @@ import (
 func (c *controller) promoteActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	teamName := atpComp.Name
 	targetNs := c.getTargetNamespace(atpComp)
-	prevNs := atpComp.Status.PreviousActiveNamespace
-
-	if err := queue.DeletePreActiveQueue(c.client, targetNs); err != nil {
-		return err
-	}
 
-	if err := c.ensureQueuePromotedToActive(teamName, targetNs); err != nil {
+	if atpComp.Status.IsInPlacePromotion() {
+		if err := c.upgradeActiveReleasesInPlace(ctx, atpComp); err != nil {
+			if s2herrors.IsErrReleaseFailed(err) {
+				atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
+				atpComp.Status.SetCondition(s2hv1.ActivePromotionCondRollbackStarted, corev1.ConditionTrue,
+					"Rollback process has been started due to release upgrade failure")
+				atpComp.SetState(s2hv1.ActivePromotionRollback,
+					"Active promotion failed due to cannot upgrade releases in place")
+				return nil
+			}
+
+			return err
+		}
+	} else if err := c.promoteQueueToActive(teamName, targetNs); err != nil {
 		if s2herrors.IsErrReleaseFailed(err) {
 			atpComp.Status.SetResult(s2hv1.ActivePromotionFailure)
 			atpComp.Status.SetCondition(s2hv1.ActivePromotionCondRollbackStarted, corev1.ConditionTrue,
@@ -38,6 +46,30 @@ func (c *controller) promoteActiveEnvironment(ctx context.Context, atpComp *s2hv
 		return err
 	}
 
+	if err := c.s2hCtrl.SwitchActiveGateway(teamName, targetNs); err != nil {
+		return errors.Wrapf(err, "cannot switch active gateway to namespace %s", targetNs)
+	}
+
+	if c.getPostActiveVerificationConfig(teamName) != nil {
+		logger.Info("active environment has been promoted, verifying the new active environment",
+			"team", teamName, "namespace", targetNs)
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActivePromoted, corev1.ConditionTrue,
+			"Active environment has been promoted")
+		atpComp.Status.SetCondition(s2hv1.ActivePromotionCondActiveVerificationStarted, corev1.ConditionTrue,
+			"Post-active verification has been started")
+		atpComp.SetState(s2hv1.ActivePromotionVerifyingActive, "Verifying the new active environment")
+		return nil
+	}
+
+	return c.completeActivePromotion(ctx, atpComp)
+}
+
+// completeActivePromotion marks the active promotion as success and schedules destroying the previous active namespace
+func (c *controller) completeActivePromotion(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	targetNs := c.getTargetNamespace(atpComp)
+	prevNs := atpComp.Status.PreviousActiveNamespace
+
 	if prevNs != "" && atpComp.Status.DestroyedTime == nil {
 		logger.Debug("previous active namespace destroyed time has been set",
 			"team", teamName, "namespace", prevNs)
@@ -62,6 +94,15 @@ func (c *controller) promoteActiveEnvironment(ctx context.Context, atpComp *s2hv
 	return nil
 }
 
+// promoteQueueToActive deploys pre-active components with active values
+func (c *controller) promoteQueueToActive(teamName, ns string) error {
+	if err := queue.DeletePreActiveQueue(c.client, ns); err != nil {
+		return err
+	}
+
+	return c.ensureQueuePromotedToActive(teamName, ns)
+}
+
 func (c *controller) ensureQueuePromotedToActive(teamName, ns string) error {
 	q, err := queue.EnsurePromoteToActiveComponents(c.client, teamName, ns)
 	if err != nil {
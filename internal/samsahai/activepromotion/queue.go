@@ -2,6 +2,7 @@ package activepromotion
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -10,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/queue"
 )
 
 func (c *controller) manageQueue(ctx context.Context, currentAtpComp *s2hv1.ActivePromotion) (
@@ -41,21 +43,44 @@ func (c *controller) manageQueue(ctx context.Context, currentAtpComp *s2hv1.Acti
 
 	waitingAtpComps.SortASC()
 
+	// active promotions of paused teams are not started
+	var nextAtpComp *s2hv1.ActivePromotion
+	for i := range waitingAtpComps.Items {
+		if paused, reason := c.isTeamQueuePaused(ctx, waitingAtpComps.Items[i].Name); paused {
+			logger.Debug("team queue is paused, skip starting active promotion",
+				"team", waitingAtpComps.Items[i].Name, "reason", reason)
+			continue
+		}
+
+		if c.isInFreezeWindow(waitingAtpComps.Items[i].Name) {
+			logger.Debug("team is in freeze window, skip starting active promotion",
+				"team", waitingAtpComps.Items[i].Name)
+			continue
+		}
+
+		nextAtpComp = &waitingAtpComps.Items[i]
+		break
+	}
+
+	if nextAtpComp == nil {
+		return false, nil
+	}
+
 	if concurrentAtp-len(runningAtpComps.Items) > 0 {
-		logger.Info("start active promotion process", "team", waitingAtpComps.Items[0].Name)
+		logger.Info("start active promotion process", "team", nextAtpComp.Name)
 
-		c.addFinalizer(&waitingAtpComps.Items[0])
-		waitingAtpComps.Items[0].SetState(s2hv1.ActivePromotionCreatingPreActive,
+		c.addFinalizer(nextAtpComp)
+		nextAtpComp.SetState(s2hv1.ActivePromotionCreatingPreActive,
 			"Creating pre-active environment")
-		waitingAtpComps.Items[0].Status.SetCondition(s2hv1.ActivePromotionCondStarted, corev1.ConditionTrue,
+		nextAtpComp.Status.SetCondition(s2hv1.ActivePromotionCondStarted, corev1.ConditionTrue,
 			"Active promotion has been started")
-		c.appendStateLabel(&waitingAtpComps.Items[0], stateRunning)
-		if err = c.updateActivePromotion(ctx, &waitingAtpComps.Items[0]); err != nil {
+		c.appendStateLabel(nextAtpComp, stateRunning)
+		if err = c.updateActivePromotion(ctx, nextAtpComp); err != nil {
 			return
 		}
 
 		// should not continue the process due to current active promotion component has been updated
-		if waitingAtpComps.Items[0].Name == currentAtpComp.Name {
+		if nextAtpComp.Name == currentAtpComp.Name {
 			skipReconcile = true
 			return
 		}
@@ -64,6 +89,45 @@ func (c *controller) manageQueue(ctx context.Context, currentAtpComp *s2hv1.Acti
 	return
 }
 
+func (c *controller) isTeamQueuePaused(ctx context.Context, teamName string) (bool, string) {
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		return false, ""
+	}
+
+	return queue.IsTeamQueuePaused(teamComp.Status.Used.Queue, time.Now())
+}
+
+func (c *controller) isInFreezeWindow(teamName string) bool {
+	config, err := c.s2hCtrl.GetConfigController().Get(teamName)
+	if err != nil {
+		return false
+	}
+
+	return IsInFreezeWindow(config.Status.Used.ActivePromotion, time.Now())
+}
+
+// IsInFreezeWindow returns whether the given time is in any code-freeze window of the active promotion
+func IsInFreezeWindow(atpConfig *s2hv1.ConfigActivePromotion, now time.Time) bool {
+	if atpConfig == nil {
+		return false
+	}
+
+	for _, window := range atpConfig.FreezeWindows {
+		active, err := queue.IsInMaintenanceWindow(window, now)
+		if err != nil {
+			logger.Error(err, "cannot parse freeze window", "schedule", window.Schedule)
+			continue
+		}
+
+		if active {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *controller) checkRetryQueue(ctx context.Context, atpComp *s2hv1.ActivePromotion) (
 	skipReconcile bool, err error) {
 
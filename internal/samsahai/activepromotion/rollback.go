@@ -3,6 +3,7 @@ package activepromotion
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
@@ -11,10 +12,18 @@ import (
 )
 
 func (c *controller) rollbackActiveEnvironment(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	if atpComp.Status.IsInPlacePromotion() {
+		return c.rollbackActiveEnvironmentInPlace(ctx, atpComp)
+	}
+
 	if err := c.checkRollbackTimeout(ctx, atpComp); err != nil {
 		return err
 	}
 
+	if err := c.restorePreviousActiveNamespace(ctx, atpComp); err != nil {
+		return err
+	}
+
 	if err := c.demoteAndDestroyPreActive(ctx, atpComp); err != nil {
 		if s2herrors.IsEnsuringActiveDemoted(err) ||
 			s2herrors.IsEnsuringNamespaceDestroyed(err) {
@@ -33,6 +42,47 @@ func (c *controller) rollbackActiveEnvironment(ctx context.Context, atpComp *s2h
 	return nil
 }
 
+// restorePreviousActiveNamespace switches the team active namespace back to the previous active namespace
+// in case the pre-active namespace has already been promoted e.g. post-active verification failed
+func (c *controller) restorePreviousActiveNamespace(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
+	teamName := atpComp.Name
+	targetNs := c.getTargetNamespace(atpComp)
+	prevNs := atpComp.Status.PreviousActiveNamespace
+
+	teamComp, err := c.getTeam(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	if prevNs == "" || teamComp.Status.Namespace.Active != targetNs {
+		return nil
+	}
+
+	stableComps, err := c.getStableComponentObjects(ctx, prevNs)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get stable components from previous active namespace %s", prevNs)
+	}
+
+	activeComps := make(map[string]s2hv1.StableComponent)
+	for _, comp := range stableComps.Items {
+		activeComps[comp.Spec.Name] = comp
+	}
+	teamComp.Status.SetActiveComponents(activeComps)
+
+	if err := c.s2hCtrl.SetPreviousActiveNamespace(teamComp, ""); err != nil {
+		return err
+	}
+
+	if err := c.s2hCtrl.SetActiveNamespace(teamComp, prevNs); err != nil {
+		return err
+	}
+
+	logger.Info("previous active namespace has been switched back to active due to rollback",
+		"team", teamName, "namespace", prevNs)
+
+	return nil
+}
+
 func (c *controller) demoteAndDestroyPreActive(ctx context.Context, atpComp *s2hv1.ActivePromotion) error {
 	isTimeout, err := c.isTimeoutFromConfig(atpComp, timeoutActiveDemotionForRollback)
 	if err != nil {
@@ -125,6 +175,10 @@ func (c *controller) rePromoteCurrentActive(ctx context.Context, atpComp *s2hv1.
 		return err
 	}
 
+	if err := c.s2hCtrl.SwitchActiveGateway(teamName, currentNs); err != nil {
+		return errors.Wrapf(err, "cannot switch active gateway back to namespace %s", currentNs)
+	}
+
 	logger.Debug("activepromotion has been rolled back",
 		"team", teamName, "status", atpComp.Status.Result, "namespace", currentNs)
 	atpComp.Status.SetRollbackStatus(s2hv1.ActivePromotionRollbackSuccess)
@@ -0,0 +1,112 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
+)
+
+// CreateActivePromotion creates an active promotion of the team,
+// it will be waiting in queue if there are other running active promotions.
+// The active promotion cannot be created during code-freeze windows
+func (c *controller) CreateActivePromotion(teamName string, spec s2hv1.ActivePromotionSpec) (
+	*s2hv1.ActivePromotion, error) {
+
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	config, err := c.GetConfigController().Get(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if activepromotion.IsInFreezeWindow(config.Status.Used.ActivePromotion, time.Now()) {
+		return nil, errors.Wrapf(s2herrors.ErrActivePromotionInFreezeWindow,
+			"team %s is in code-freeze window", teamName)
+	}
+
+	atp := &s2hv1.ActivePromotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: teamName,
+		},
+		Spec: spec,
+	}
+
+	if err := c.client.Create(context.TODO(), atp); err != nil {
+		return nil, err
+	}
+
+	logger.Info("active promotion has been created", "team", teamName, "promotedBy", spec.PromotedBy)
+
+	return atp, nil
+}
+
+// CancelActivePromotion deletes the current active promotion of the team,
+// the active promotion will be rolled back or its pre-active environment will be destroyed by the finalizer
+func (c *controller) CancelActivePromotion(teamName, canceledBy string) (*s2hv1.ActivePromotion, error) {
+	atp, err := c.GetActivePromotion(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	// waiting active promotion can be removed from queue
+	state := atp.Status.State
+	if state != s2hv1.ActivePromotionWaiting && activepromotion.StateCannotBeTimeoutOrCancel(state) {
+		return nil, errors.Wrapf(s2herrors.ErrActivePromotionCannotBeCanceled,
+			"active promotion of team %s is %s", teamName, state)
+	}
+
+	ctx := context.TODO()
+	if canceledBy != "" && atp.Status.CanceledBy == "" {
+		atp.Status.CanceledBy = canceledBy
+		if err := c.client.Update(ctx, atp); err != nil {
+			return nil, errors.Wrapf(err, "cannot update active promotion of team %s", teamName)
+		}
+	}
+
+	if err := c.client.Delete(ctx, atp); err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "cannot delete active promotion of team %s", teamName)
+	}
+
+	logger.Info("active promotion has been canceled", "team", teamName, "canceledBy", canceledBy)
+
+	return atp, nil
+}
+
+// RetryActivePromotion creates an active promotion of the team from the latest active promotion history
+// if it was not successful
+func (c *controller) RetryActivePromotion(teamName, retriedBy string) (*s2hv1.ActivePromotion, error) {
+	atpHists, err := c.GetActivePromotionHistories(internal.GetDefaultLabels(teamName))
+	if err != nil {
+		return nil, err
+	}
+
+	atpHists.SortDESC()
+	if len(atpHists.Items) == 0 || atpHists.Items[0].Spec.IsSuccess ||
+		atpHists.Items[0].Spec.ActivePromotion == nil {
+		return nil, errors.Wrapf(s2herrors.ErrNoFailedActivePromotionHistory,
+			"latest active promotion of team %s is not failed", teamName)
+	}
+
+	atpHist := atpHists.Items[0]
+	prevSpec := atpHist.Spec.ActivePromotion.Spec
+	spec := s2hv1.ActivePromotionSpec{
+		TearDownDuration: prevSpec.TearDownDuration,
+		SkipTestRunner:   prevSpec.SkipTestRunner,
+		Components:       prevSpec.Components,
+		PromotedBy:       retriedBy,
+		RetriedFrom:      atpHist.Name,
+	}
+
+	return c.CreateActivePromotion(teamName, spec)
+}
@@ -0,0 +1,104 @@
+package samsahai
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/pkg/errors"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+// ApproveActivePromotion adds the team owner to approvers of the active promotion which is waiting for approvals,
+// the active promotion controller switches the pre-active to be active once required approvals are given.
+// The approver is identified by the approver token of the team owner which is loaded from the team secret
+func (c *controller) ApproveActivePromotion(teamName, approverToken string) (*s2hv1.ActivePromotion, error) {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	if err := c.LoadTeamSecret(teamComp); err != nil {
+		return nil, err
+	}
+
+	approver := getApproverByToken(teamComp, approverToken)
+	if approver == "" {
+		return nil, errors.Wrapf(s2herrors.ErrActivePromotionApproverUnauthorized, "team %s", teamName)
+	}
+
+	if !isTeamOwner(teamComp, approver) {
+		return nil, errors.Wrapf(s2herrors.ErrActivePromotionApproverNotOwner,
+			"%q is not an owner of team %s", approver, teamName)
+	}
+
+	atp, err := c.GetActivePromotion(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if atp.Status.State != s2hv1.ActivePromotionWaitingForApproval || atp.Status.Approval == nil {
+		return nil, errors.Wrapf(s2herrors.ErrActivePromotionNotWaitingForApproval,
+			"active promotion of team %s is %s", teamName, atp.Status.State)
+	}
+
+	if !atp.Status.Approval.AddApprover(approver) {
+		return atp, nil
+	}
+
+	if err := c.client.Update(context.TODO(), atp); err != nil {
+		return nil, errors.Wrapf(err, "cannot update active promotion of team %s", teamName)
+	}
+
+	logger.Info("active promotion has been approved", "team", teamName, "approver", approver,
+		"approvals", len(atp.Status.Approval.Approvers), "requiredApprovals", atp.Status.Approval.RequiredApprovals)
+
+	return atp, nil
+}
+
+// NotifyActivePromotionApproval sends information of active promotion which is waiting for approvals
+func (c *controller) NotifyActivePromotionApproval(atpApprovalRpt *internal.ActivePromotionApprovalReporter) {
+	configCtrl := c.GetConfigController()
+
+	for _, reporter := range c.reporters {
+		if err := reporter.SendActivePromotionApproval(configCtrl, atpApprovalRpt); err != nil {
+			logger.Error(err, "cannot send active promotion approval report")
+		}
+	}
+}
+
+// getApproverByToken returns the team owner who owns the approver token,
+// empty string will be returned if the token does not belong to any team owner
+func getApproverByToken(teamComp *s2hv1.Team, token string) string {
+	if token == "" {
+		return ""
+	}
+
+	for _, approverCred := range teamComp.Status.Used.Credential.Approvers {
+		if approverCred.Token == "" {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(approverCred.Token), []byte(token)) == 1 {
+			return approverCred.Owner
+		}
+	}
+
+	return ""
+}
+
+func isTeamOwner(teamComp *s2hv1.Team, name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, owner := range teamComp.Status.Used.Owners {
+		if owner == name {
+			return true
+		}
+	}
+
+	return false
+}
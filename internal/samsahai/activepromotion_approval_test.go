@@ -0,0 +1,79 @@
+package samsahai
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+)
+
+var _ = Describe("S2H active promotion approval", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should correctly check team owners", func() {
+		teamComp := &s2hv1.Team{
+			Status: s2hv1.TeamStatus{
+				Used: s2hv1.TeamSpec{Owners: []string{"owner1", "owner2"}},
+			},
+		}
+
+		g.Expect(isTeamOwner(teamComp, "owner2")).To(BeTrue())
+		g.Expect(isTeamOwner(teamComp, "someone")).To(BeFalse())
+		g.Expect(isTeamOwner(teamComp, "")).To(BeFalse())
+	})
+
+	It("should identify approver by approver token", func() {
+		teamComp := &s2hv1.Team{
+			Status: s2hv1.TeamStatus{
+				Used: s2hv1.TeamSpec{
+					Owners: []string{"owner1", "owner2"},
+					Credential: s2hv1.Credential{
+						Approvers: []s2hv1.ApproverCredential{
+							{Owner: "owner1", Token: "token1"},
+							{Owner: "owner2", Token: "token2"},
+							{Owner: "owner3"},
+						},
+					},
+				},
+			},
+		}
+
+		g.Expect(getApproverByToken(teamComp, "token2")).To(Equal("owner2"))
+		g.Expect(getApproverByToken(teamComp, "shared-token")).To(BeEmpty())
+		g.Expect(getApproverByToken(teamComp, "")).To(BeEmpty(), "approver without token should not be matched")
+	})
+
+	It("should list component diffs between active and pre-active", func() {
+		newComp := func(repository, version string) s2hv1.StableComponent {
+			return s2hv1.StableComponent{
+				Spec: s2hv1.StableComponentSpec{Repository: repository, Version: version},
+			}
+		}
+		activeComps := map[string]s2hv1.StableComponent{
+			"redis":   newComp("bitnami/redis", "5.0.5"),
+			"mariadb": newComp("bitnami/mariadb", "10.3.18"),
+			"mongodb": newComp("bitnami/mongodb", "4.2.0"),
+		}
+		preActiveComps := map[string]s2hv1.StableComponent{
+			"redis":     newComp("bitnami/redis", "5.0.7"),
+			"mariadb":   newComp("bitnami/mariadb", "10.3.18"),
+			"wordpress": newComp("bitnami/wordpress", "5.2.4"),
+		}
+
+		diffs := internal.GetComponentDiffs(activeComps, preActiveComps)
+		g.Expect(diffs).To(Equal([]s2hv1.ComponentDiff{
+			{Name: "mongodb", ActiveRepository: "bitnami/mongodb", ActiveVersion: "4.2.0"},
+			{
+				Name:                "redis",
+				ActiveRepository:    "bitnami/redis",
+				ActiveVersion:       "5.0.5",
+				PreActiveRepository: "bitnami/redis",
+				PreActiveVersion:    "5.0.7",
+			},
+			{Name: "wordpress", PreActiveRepository: "bitnami/wordpress", PreActiveVersion: "5.2.4"},
+		}))
+
+		g.Expect(internal.GetComponentDiffs(activeComps, activeComps)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,218 @@
+package samsahai
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+)
+
+// maxValuesDiffs is the maximum number of values diffs stored per release
+// to keep active promotion and its histories in a reasonable size
+const maxValuesDiffs = 100
+
+// GetActivePromotionDiff returns differences of component versions, chart versions and rendered values
+// between the active and pre-active environments of the active promotion
+func (c *controller) GetActivePromotionDiff(atp *s2hv1.ActivePromotion) (*s2hv1.ActivePromotionDiff, error) {
+	teamName := atp.Name
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	activeNs := atp.Status.PreviousActiveNamespace
+	if activeNs == "" {
+		activeNs = teamComp.Status.Namespace.Active
+	}
+	preActiveNs := atp.Status.TargetNamespace
+	if preActiveNs == "" {
+		return nil, fmt.Errorf("pre-active namespace of team %s has not been created", teamName)
+	}
+
+	now := metav1.Now()
+	diff := &s2hv1.ActivePromotionDiff{
+		ActiveNamespace:    activeNs,
+		PreActiveNamespace: preActiveNs,
+		Components:         internal.GetComponentDiffs(teamComp.Status.ActiveComponents, atp.Status.ActiveComponents),
+		CreatedAt:          &now,
+	}
+
+	parentComps, err := c.GetConfigController().GetParentComponents(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	preActiveReleases, err := c.getReleaseDetails(teamName, preActiveNs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get releases of namespace %s", preActiveNs)
+	}
+
+	activeReleases := map[string]releaseDetail{}
+	if activeNs != "" {
+		activeReleases, err = c.getReleaseDetails(teamName, activeNs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get releases of namespace %s", activeNs)
+		}
+	}
+
+	compNames := make([]string, 0, len(parentComps))
+	for compName := range parentComps {
+		compNames = append(compNames, compName)
+	}
+	sort.Strings(compNames)
+
+	for _, compName := range compNames {
+		activeRelease := activeReleases[internal.GenReleaseName(activeNs, compName)]
+		preActiveRelease := preActiveReleases[internal.GenReleaseName(preActiveNs, compName)]
+
+		valuesDiffs, err := getValuesDiffs(activeRelease.values, preActiveRelease.values)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot compare values of component %s", compName)
+		}
+
+		if activeRelease.chartVersion == preActiveRelease.chartVersion && len(valuesDiffs) == 0 {
+			continue
+		}
+
+		releaseDiff := s2hv1.ReleaseDiff{
+			Component:             compName,
+			ActiveChartVersion:    activeRelease.chartVersion,
+			PreActiveChartVersion: preActiveRelease.chartVersion,
+			Values:                valuesDiffs,
+		}
+		if len(valuesDiffs) > maxValuesDiffs {
+			releaseDiff.Values = valuesDiffs[:maxValuesDiffs]
+			releaseDiff.IsValuesTruncated = true
+		}
+
+		diff.Releases = append(diff.Releases, releaseDiff)
+	}
+
+	return diff, nil
+}
+
+type releaseDetail struct {
+	chartVersion string
+	values       []byte
+}
+
+// getReleaseDetails returns chart versions and yaml values of all releases in the namespace by release names
+func (c *controller) getReleaseDetails(teamName, ns string) (map[string]releaseDetail, error) {
+	deployEngine := c.GetActivePromotionDeployEngine(teamName, ns)
+
+	releases, err := deployEngine.GetReleases()
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := deployEngine.GetValues()
+	if err != nil {
+		return nil, err
+	}
+
+	details := make(map[string]releaseDetail)
+	for _, r := range releases {
+		details[r.Name] = releaseDetail{
+			chartVersion: getChartVersion(r),
+			values:       values[r.Name],
+		}
+	}
+
+	return details, nil
+}
+
+func getChartVersion(r *release.Release) string {
+	if r == nil || r.Chart == nil || r.Chart.Metadata == nil {
+		return ""
+	}
+
+	return r.Chart.Metadata.Version
+}
+
+// getValuesDiffs compares yaml values and returns values which are different sorted by path
+func getValuesDiffs(activeValues, preActiveValues []byte) ([]s2hv1.ValuesDiff, error) {
+	activeFlatValues, err := flattenYAMLValues(activeValues)
+	if err != nil {
+		return nil, err
+	}
+
+	preActiveFlatValues, err := flattenYAMLValues(preActiveValues)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]struct{})
+	for path := range activeFlatValues {
+		paths[path] = struct{}{}
+	}
+	for path := range preActiveFlatValues {
+		paths[path] = struct{}{}
+	}
+
+	diffs := make([]s2hv1.ValuesDiff, 0)
+	for path := range paths {
+		activeVal, preActiveVal := activeFlatValues[path], preActiveFlatValues[path]
+		if activeVal == preActiveVal {
+			continue
+		}
+
+		diffs = append(diffs, s2hv1.ValuesDiff{Path: path, Active: activeVal, PreActive: preActiveVal})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Path < diffs[j].Path })
+
+	return diffs, nil
+}
+
+// flattenYAMLValues converts nested yaml values to map of paths and values in json format
+func flattenYAMLValues(data []byte) (map[string]string, error) {
+	values := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := yaml.Unmarshal(data, &values); err != nil {
+			return nil, err
+		}
+	}
+
+	flatValues := make(map[string]string)
+	if err := flattenValues("", values, flatValues); err != nil {
+		return nil, err
+	}
+
+	return flatValues, nil
+}
+
+func flattenValues(prefix string, value interface{}, flatValues map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			path := key
+			if prefix != "" {
+				path = strings.Join([]string{prefix, key}, ".")
+			}
+
+			if err := flattenValues(path, val, flatValues); err != nil {
+				return err
+			}
+		}
+	default:
+		if prefix == "" {
+			return nil
+		}
+
+		jsonVal, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		flatValues[prefix] = string(jsonVal)
+	}
+
+	return nil
+}
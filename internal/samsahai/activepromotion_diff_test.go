@@ -0,0 +1,54 @@
+package samsahai
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("S2H active promotion diff", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should list values which are different between active and pre-active releases", func() {
+		activeValues := []byte(`
+image:
+  repository: bitnami/redis
+  tag: 5.0.5
+replicas: 1
+master:
+  persistence:
+    enabled: false
+`)
+		preActiveValues := []byte(`
+image:
+  repository: bitnami/redis
+  tag: 5.0.7
+replicas: 2
+master:
+  persistence:
+    enabled: false
+cluster:
+  enabled: true
+`)
+
+		diffs, err := getValuesDiffs(activeValues, preActiveValues)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(diffs).To(Equal([]s2hv1.ValuesDiff{
+			{Path: "cluster.enabled", PreActive: "true"},
+			{Path: "image.tag", Active: `"5.0.5"`, PreActive: `"5.0.7"`},
+			{Path: "replicas", Active: "1", PreActive: "2"},
+		}))
+
+		diffs, err = getValuesDiffs(activeValues, activeValues)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(diffs).To(BeEmpty())
+
+		diffs, err = getValuesDiffs(nil, []byte(`replicas: 1`))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(diffs).To(Equal([]s2hv1.ValuesDiff{{Path: "replicas", PreActive: "1"}}))
+
+		_, err = getValuesDiffs([]byte(`: invalid`), nil)
+		g.Expect(err).To(HaveOccurred())
+	})
+})
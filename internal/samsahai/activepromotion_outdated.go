@@ -0,0 +1,130 @@
+package samsahai
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
+	"github.com/agoda-com/samsahai/internal/util/outdated"
+)
+
+const (
+	// defaultForcedPromotionBackoff is used when neither forced promotion back-off nor maximum outdated duration is set
+	defaultForcedPromotionBackoff = 24 * time.Hour
+
+	// skippedReasonForcedBackoff is a reason when the last forced active promotion failed recently
+	skippedReasonForcedBackoff = "forced active promotion failed recently"
+)
+
+// promoteOutdatedComponents exports outdated durations of active components of the team
+// and creates the active promotion if any component is outdated longer than its maximum outdated duration,
+// forced and skipped runs are recorded in team status
+func (c *controller) promoteOutdatedComponents(teamName string, now time.Time) error {
+	team := &s2hv1.Team{}
+	if err := c.getTeam(teamName, team); err != nil {
+		return err
+	}
+
+	config, err := c.GetConfigController().Get(teamName)
+	if err != nil {
+		return err
+	}
+
+	o := outdated.New(&config.Status.Used, team.Status.DesiredComponentImageCreatedTime, team.Status.ActiveComponents)
+	exporter.SetOutdatedComponentMetric(teamName, o.GetOutdatedDurations())
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.OutdatedNotification == nil {
+		return nil
+	}
+
+	outdatedComps := o.GetExceededMaxOutdatedComponents()
+	if len(outdatedComps) == 0 {
+		return nil
+	}
+
+	status := s2hv1.OutdatedActivePromotionStatus{}
+	if team.Status.OutdatedActivePromotion != nil {
+		status = *team.Status.OutdatedActivePromotion.DeepCopy()
+	}
+
+	reason, err := c.getActivePromotionSkippedReason(team, atpConfig, now)
+	if err != nil {
+		return err
+	}
+
+	if reason == "" {
+		backoff := getForcedPromotionBackoff(atpConfig.OutdatedNotification)
+		isBackingOff, err := c.isForcedActivePromotionBackingOff(teamName, status.LastForcedAt, backoff, now)
+		if err != nil {
+			return err
+		}
+		if isBackingOff {
+			reason = skippedReasonForcedBackoff
+		}
+	}
+
+	if reason != "" {
+		logger.Debug("skip active promotion of outdated components",
+			"team", teamName, "components", outdatedComps, "reason", reason)
+		if !status.AddSkippedRun(metav1.Time{Time: now}, reason) {
+			return nil
+		}
+
+		team.Status.OutdatedActivePromotion = &status
+		return c.updateTeam(team)
+	}
+
+	logger.Info("start active promotion due to outdated components", "team", teamName, "components", outdatedComps)
+	if err := c.createActivePromotion(teamName); err != nil {
+		return err
+	}
+
+	status.LastForcedAt = &metav1.Time{Time: now}
+	team.Status.OutdatedActivePromotion = &status
+	return c.updateTeam(team)
+}
+
+// isForcedActivePromotionBackingOff returns true if the active promotion should not be forced again
+// because the last forced one has not succeeded within the back-off duration
+func (c *controller) isForcedActivePromotionBackingOff(teamName string, lastForcedAt *metav1.Time,
+	backoff time.Duration, now time.Time) (bool, error) {
+
+	if lastForcedAt == nil || !now.Before(lastForcedAt.Add(backoff)) {
+		return false, nil
+	}
+
+	atpHists, err := c.GetActivePromotionHistories(internal.GetDefaultLabels(teamName))
+	if err != nil {
+		return false, err
+	}
+
+	return !isForcedActivePromotionSucceeded(atpHists, lastForcedAt), nil
+}
+
+// isForcedActivePromotionSucceeded returns true if the latest active promotion history
+// since the last forced time is successful
+func isForcedActivePromotionSucceeded(atpHists *s2hv1.ActivePromotionHistoryList, lastForcedAt *metav1.Time) bool {
+	atpHists.SortDESC()
+	if len(atpHists.Items) == 0 {
+		return false
+	}
+
+	latest := atpHists.Items[0]
+	return latest.Spec.IsSuccess && !latest.CreationTimestamp.Before(lastForcedAt)
+}
+
+// getForcedPromotionBackoff returns how long the active promotion is not forced again after the forced one failed
+func getForcedPromotionBackoff(notiCfg *s2hv1.OutdatedNotification) time.Duration {
+	switch {
+	case notiCfg.ForcedPromotionBackoff.Duration > 0:
+		return notiCfg.ForcedPromotionBackoff.Duration
+	case notiCfg.MaxOutdatedDuration.Duration > 0:
+		return notiCfg.MaxOutdatedDuration.Duration
+	default:
+		return defaultForcedPromotionBackoff
+	}
+}
@@ -0,0 +1,68 @@
+package samsahai
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("S2H outdated active promotion", func() {
+	g := NewWithT(GinkgoT())
+	now := time.Date(2020, 10, 10, 4, 30, 0, 0, time.UTC)
+
+	It("should get forced promotion back-off correctly", func() {
+		notiCfg := &s2hv1.OutdatedNotification{}
+		g.Expect(getForcedPromotionBackoff(notiCfg)).To(Equal(defaultForcedPromotionBackoff))
+
+		notiCfg.MaxOutdatedDuration = metav1.Duration{Duration: 48 * time.Hour}
+		g.Expect(getForcedPromotionBackoff(notiCfg)).To(Equal(48 * time.Hour))
+
+		notiCfg.ForcedPromotionBackoff = metav1.Duration{Duration: 6 * time.Hour}
+		g.Expect(getForcedPromotionBackoff(notiCfg)).To(Equal(6 * time.Hour))
+	})
+
+	It("should check whether the forced active promotion has succeeded", func() {
+		lastForcedAt := &metav1.Time{Time: now.Add(-time.Hour)}
+		newHistory := func(createdAt time.Time, isSuccess bool) s2hv1.ActivePromotionHistory {
+			return s2hv1.ActivePromotionHistory{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: createdAt}},
+				Spec:       s2hv1.ActivePromotionHistorySpec{IsSuccess: isSuccess},
+			}
+		}
+
+		atpHists := &s2hv1.ActivePromotionHistoryList{}
+		g.Expect(isForcedActivePromotionSucceeded(atpHists, lastForcedAt)).To(BeFalse())
+
+		atpHists.Items = []s2hv1.ActivePromotionHistory{
+			newHistory(now.Add(-2*time.Hour), true),
+			newHistory(now.Add(-30*time.Minute), false),
+		}
+		g.Expect(isForcedActivePromotionSucceeded(atpHists, lastForcedAt)).To(BeFalse())
+
+		By("success before the last forced time should not be counted")
+		atpHists.Items = []s2hv1.ActivePromotionHistory{newHistory(now.Add(-2*time.Hour), true)}
+		g.Expect(isForcedActivePromotionSucceeded(atpHists, lastForcedAt)).To(BeFalse())
+
+		atpHists.Items = append(atpHists.Items, newHistory(now.Add(-30*time.Minute), true))
+		g.Expect(isForcedActivePromotionSucceeded(atpHists, lastForcedAt)).To(BeTrue())
+	})
+
+	It("should record skipped forced runs only when the reason changes", func() {
+		status := s2hv1.OutdatedActivePromotionStatus{}
+		g.Expect(status.AddSkippedRun(metav1.Time{Time: now}, skippedReasonInProgress)).To(BeTrue())
+		g.Expect(status.AddSkippedRun(metav1.Time{Time: now.Add(time.Minute)}, skippedReasonInProgress)).To(BeFalse())
+		g.Expect(status.AddSkippedRun(metav1.Time{Time: now.Add(2 * time.Minute)}, skippedReasonForcedBackoff)).
+			To(BeTrue())
+		g.Expect(status.SkippedRuns).To(HaveLen(2))
+
+		By("the same reason should be recorded again after forcing the active promotion")
+		status.LastForcedAt = &metav1.Time{Time: now.Add(3 * time.Minute)}
+		g.Expect(status.AddSkippedRun(metav1.Time{Time: now.Add(4 * time.Minute)}, skippedReasonForcedBackoff)).
+			To(BeTrue())
+		g.Expect(status.SkippedRuns).To(HaveLen(3))
+	})
+})
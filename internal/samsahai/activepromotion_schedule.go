@@ -0,0 +1,214 @@
+package samsahai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
+)
+
+const activePromotionScheduleInterval = time.Minute
+
+const (
+	// skippedReasonFreezeWindow is a reason when the scheduled run is in a code-freeze window
+	skippedReasonFreezeWindow = "active promotion is in a freeze window"
+	// skippedReasonInProgress is a reason when the active promotion of the team has not finished yet
+	skippedReasonInProgress = "active promotion is in progress"
+	// skippedReasonNoChanges is a reason when there is no component to be promoted
+	skippedReasonNoChanges = "stable components are the same as active components"
+)
+
+// scheduleActivePromotion triggers evaluating `ActivePromotion.Schedule` of every team
+type scheduleActivePromotion struct {
+}
+
+// scheduleActivePromotions creates active promotions of every team which schedules are due
+// or which active components are outdated longer than the maximum outdated duration
+func (c *controller) scheduleActivePromotions() error {
+	defer c.queue.AddAfter(scheduleActivePromotion{}, activePromotionScheduleInterval)
+
+	teamList, err := c.GetTeams()
+	if err != nil {
+		logger.Error(err, "cannot list teams for scheduling active promotions")
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for _, team := range teamList.Items {
+		if err := c.scheduleTeamActivePromotion(team.Name, now); err != nil {
+			logger.Error(err, "cannot schedule active promotion", "team", team.Name)
+		}
+
+		if err := c.promoteOutdatedComponents(team.Name, now); err != nil {
+			logger.Error(err, "cannot promote outdated components", "team", team.Name)
+		}
+	}
+
+	return nil
+}
+
+// scheduleTeamActivePromotion creates the active promotion of the team if its schedule is due,
+// skipped runs are recorded with reasons in team status
+func (c *controller) scheduleTeamActivePromotion(teamName string, now time.Time) error {
+	team := &s2hv1.Team{}
+	if err := c.getTeam(teamName, team); err != nil {
+		return err
+	}
+
+	config, err := c.GetConfigController().Get(teamName)
+	if err != nil {
+		return err
+	}
+
+	atpConfig := config.Status.Used.ActivePromotion
+	if atpConfig == nil || atpConfig.Schedule == nil || atpConfig.Schedule.Cron == "" {
+		if team.Status.ActivePromotionSchedule == nil {
+			return nil
+		}
+
+		team.Status.ActivePromotionSchedule = nil
+		return c.updateTeam(team)
+	}
+
+	status := s2hv1.ActivePromotionScheduleStatus{}
+	if team.Status.ActivePromotionSchedule != nil {
+		status = *team.Status.ActivePromotionSchedule.DeepCopy()
+	}
+
+	nextScheduleAt, err := getNextActivePromotionTime(atpConfig.Schedule, status, now)
+	if err != nil {
+		return err
+	}
+
+	isChanged := false
+	if !now.Before(nextScheduleAt) {
+		scheduledAt := metav1.Time{Time: nextScheduleAt}
+		reason, err := c.getActivePromotionSkippedReason(team, atpConfig, now)
+		if err != nil {
+			return err
+		}
+
+		if reason != "" {
+			logger.Info("skip scheduled active promotion", "team", teamName, "reason", reason)
+			status.AddSkippedRun(scheduledAt, reason)
+		} else {
+			logger.Info("start scheduled active promotion", "team", teamName)
+			if err := c.createActivePromotion(teamName); err != nil {
+				return err
+			}
+		}
+
+		// schedule has already been parsed successfully
+		nextScheduleAt, _ = getNextScheduledActivePromotionTime(atpConfig.Schedule, now)
+		status.LastScheduledAt = &scheduledAt
+		status.NextScheduleAt = nil
+		isChanged = true
+	}
+
+	if status.NextScheduleAt == nil || !status.NextScheduleAt.Time.Equal(nextScheduleAt) {
+		status.NextScheduleAt = &metav1.Time{Time: nextScheduleAt}
+		isChanged = true
+	}
+
+	if !isChanged {
+		return nil
+	}
+
+	team.Status.ActivePromotionSchedule = &status
+	return c.updateTeam(team)
+}
+
+// getActivePromotionSkippedReason returns why the scheduled active promotion should not be created,
+// empty reason will be returned if the active promotion can be created
+func (c *controller) getActivePromotionSkippedReason(team *s2hv1.Team, atpConfig *s2hv1.ConfigActivePromotion,
+	now time.Time) (string, error) {
+
+	if activepromotion.IsInFreezeWindow(atpConfig, now) {
+		return skippedReasonFreezeWindow, nil
+	}
+
+	atp := &s2hv1.ActivePromotion{}
+	err := c.client.Get(context.TODO(), types.NamespacedName{Name: team.Name}, atp)
+	if err == nil {
+		return skippedReasonInProgress, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return "", errors.Wrapf(err, "cannot get active promotion of team %s", team.Name)
+	}
+
+	if !isStableComponentsChanged(team.Status.StableComponents, team.Status.ActiveComponents) {
+		return skippedReasonNoChanges, nil
+	}
+
+	return "", nil
+}
+
+// isStableComponentsChanged returns true if any stable component differs from the active one
+func isStableComponentsChanged(stableComps, activeComps map[string]s2hv1.StableComponent) bool {
+	if len(stableComps) != len(activeComps) {
+		return true
+	}
+
+	for name, stableComp := range stableComps {
+		activeComp, ok := activeComps[name]
+		if !ok {
+			return true
+		}
+
+		if stableComp.IsVersionChanged(&activeComp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getNextActivePromotionTime returns the time that the active promotion should be scheduled.
+//
+// The next time is calculated from the last scheduled time, so missed schedules will be run only once.
+// If the active promotion has never been scheduled, the earlier of stored and newly calculated time will be used.
+func getNextActivePromotionTime(schedule *s2hv1.ActivePromotionSchedule, status s2hv1.ActivePromotionScheduleStatus,
+	now time.Time) (time.Time, error) {
+
+	from := now
+	if status.LastScheduledAt != nil {
+		from = status.LastScheduledAt.Time.UTC()
+	}
+
+	next, err := getNextScheduledActivePromotionTime(schedule, from)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if status.LastScheduledAt == nil && status.NextScheduleAt != nil && status.NextScheduleAt.Time.Before(next) {
+		return status.NextScheduleAt.Time.UTC(), nil
+	}
+
+	return next, nil
+}
+
+// getNextScheduledActivePromotionTime returns the activation time of the active promotion schedule
+// after the given time
+func getNextScheduledActivePromotionTime(schedule *s2hv1.ActivePromotionSchedule, from time.Time) (
+	time.Time, error) {
+
+	cronSchedule := schedule.Cron
+	if schedule.TimeZone != "" {
+		cronSchedule = fmt.Sprintf("CRON_TZ=%s %s", schedule.TimeZone, cronSchedule)
+	}
+
+	sched, err := cron.ParseStandard(cronSchedule)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "cannot parse active promotion schedule %q", cronSchedule)
+	}
+
+	return sched.Next(from).UTC(), nil
+}
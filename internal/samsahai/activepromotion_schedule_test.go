@@ -0,0 +1,104 @@
+package samsahai
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/samsahai/activepromotion"
+)
+
+var _ = Describe("S2H active promotion schedule", func() {
+	g := NewWithT(GinkgoT())
+	now := time.Date(2020, 10, 10, 4, 30, 0, 0, time.UTC)
+
+	It("should correctly get the next scheduled time with time zone", func() {
+		schedule := &s2hv1.ActivePromotionSchedule{Cron: "0 2 * * *"}
+		next, err := getNextScheduledActivePromotionTime(schedule, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 11, 2, 0, 0, 0, time.UTC)))
+
+		// 02:00 in Bangkok is 19:00 UTC of the previous day
+		schedule.TimeZone = "Asia/Bangkok"
+		next, err = getNextScheduledActivePromotionTime(schedule, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 19, 0, 0, 0, time.UTC)))
+
+		_, err = getNextScheduledActivePromotionTime(&s2hv1.ActivePromotionSchedule{Cron: "invalid"}, now)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should calculate next scheduled time from the last scheduled time", func() {
+		schedule := &s2hv1.ActivePromotionSchedule{Cron: "0 * * * *"}
+		status := s2hv1.ActivePromotionScheduleStatus{
+			LastScheduledAt: &metav1.Time{Time: now.Add(-3 * time.Hour)},
+		}
+		next, err := getNextActivePromotionTime(schedule, status, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 2, 0, 0, 0, time.UTC)),
+			"missed schedules should be due only once")
+
+		status = s2hv1.ActivePromotionScheduleStatus{
+			NextScheduleAt: &metav1.Time{Time: now.Add(24 * time.Hour)},
+		}
+		next, err = getNextActivePromotionTime(schedule, status, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 5, 0, 0, 0, time.UTC)),
+			"changed schedule should be applied")
+	})
+
+	It("should correctly check changed stable components", func() {
+		newComp := func(repository, version string) s2hv1.StableComponent {
+			return s2hv1.StableComponent{
+				Spec: s2hv1.StableComponentSpec{Repository: repository, Version: version},
+			}
+		}
+		stableComps := map[string]s2hv1.StableComponent{
+			"redis":   newComp("bitnami/redis", "5.0.7"),
+			"mariadb": newComp("bitnami/mariadb", "10.3.18"),
+		}
+
+		activeComps := map[string]s2hv1.StableComponent{
+			"redis":   newComp("bitnami/redis", "5.0.7"),
+			"mariadb": newComp("bitnami/mariadb", "10.3.18"),
+		}
+		g.Expect(isStableComponentsChanged(stableComps, activeComps)).To(BeFalse())
+
+		activeComps["redis"] = newComp("bitnami/redis", "5.0.5")
+		g.Expect(isStableComponentsChanged(stableComps, activeComps)).To(BeTrue())
+
+		chartComp := newComp("bitnami/redis", "5.0.7")
+		chartComp.Spec.ChartVersion = "10.5.0"
+		activeComps["redis"] = chartComp
+		g.Expect(isStableComponentsChanged(stableComps, activeComps)).To(BeTrue())
+
+		delete(activeComps, "redis")
+		g.Expect(isStableComponentsChanged(stableComps, activeComps)).To(BeTrue())
+		g.Expect(isStableComponentsChanged(stableComps, nil)).To(BeTrue())
+	})
+
+	It("should correctly check freeze windows", func() {
+		atpConfig := &s2hv1.ConfigActivePromotion{
+			FreezeWindows: []s2hv1.MaintenanceWindow{
+				{Schedule: "0 4 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+			},
+		}
+		g.Expect(activepromotion.IsInFreezeWindow(atpConfig, now)).To(BeTrue())
+		g.Expect(activepromotion.IsInFreezeWindow(atpConfig, now.Add(time.Hour))).To(BeFalse())
+		g.Expect(activepromotion.IsInFreezeWindow(nil, now)).To(BeFalse())
+	})
+
+	It("should keep only the latest skipped runs", func() {
+		status := s2hv1.ActivePromotionScheduleStatus{}
+		for i := 0; i < 12; i++ {
+			status.AddSkippedRun(metav1.Time{Time: now.Add(time.Duration(i) * time.Hour)}, skippedReasonNoChanges)
+		}
+
+		g.Expect(status.SkippedRuns).To(HaveLen(10))
+		g.Expect(status.SkippedRuns[0].ScheduledAt.Time).To(Equal(now.Add(2 * time.Hour)))
+		g.Expect(status.SkippedRuns[9].Reason).To(Equal(skippedReasonNoChanges))
+	})
+})
@@ -0,0 +1,305 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/template"
+)
+
+var logger = s2hlog.Log.WithName(CheckerName)
+
+const (
+	CheckerName = "git"
+
+	MaxRequestsTimeout   = 60 * time.Second
+	MaxOneRequestTimeout = 10 * time.Second
+
+	defaultGithubAPIURL = "https://api.github.com"
+	defaultGitlabURL    = "https://gitlab.com"
+	defaultTagTemplate  = "{{ .Commit }}"
+	shortCommitLength   = 7
+)
+
+type checker struct {
+	getChecker  func(source string) (internal.DesiredComponentChecker, error)
+	githubURL   string
+	githubToken string
+	gitlabToken string
+	httpOpts    []http.Option
+}
+
+// tagData represents data for rendering image tag template
+type tagData struct {
+	Commit      string
+	ShortCommit string
+	Branch      string
+}
+
+type githubCommitRes struct {
+	SHA string `json:"sha"`
+}
+
+type gitlabBranchRes struct {
+	Commit struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+}
+
+// Option allows specifying various configuration
+type Option func(*checker)
+
+// WithGithubURL specifies a default github url, e.g. url of Github Enterprise
+func WithGithubURL(url string) Option {
+	return func(c *checker) {
+		c.githubURL = url
+	}
+}
+
+// WithGithubToken specifies a github access token for private repositories
+func WithGithubToken(token string) Option {
+	return func(c *checker) {
+		c.githubToken = token
+	}
+}
+
+// WithGitlabToken specifies a gitlab access token for private repositories
+func WithGitlabToken(token string) Option {
+	return func(c *checker) {
+		c.gitlabToken = token
+	}
+}
+
+// WithHTTPOptions specifies options of http requests to git server
+func WithHTTPOptions(opts ...http.Option) Option {
+	return func(c *checker) {
+		c.httpOpts = opts
+	}
+}
+
+// New creates a checker which uses the latest commit of git branch as image tag,
+// getChecker is used for getting the checker which ensures the generated image tag exists
+func New(
+	getChecker func(source string) (internal.DesiredComponentChecker, error),
+	opts ...Option,
+) internal.DesiredComponentGitChecker {
+	c := &checker{
+		getChecker: getChecker,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *checker) GetName() string {
+	return CheckerName
+}
+
+// GetVersion always returns error, `image.gitRef` of component is required
+func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
+	return pattern, s2herrors.Wrap(s2herrors.ErrInternalCheckerError,
+		fmt.Sprintf("git reference of component %s is not defined", name))
+}
+
+// EnsureVersion does nothing, existence of image cannot be ensured without git reference
+func (c *checker) EnsureVersion(repository, name, version string) error {
+	return nil
+}
+
+func (c *checker) GetGitRefVersion(repository, name string, gitRef s2hv1.ComponentGitRef) (string, error) {
+	if gitRef.Repository == "" || gitRef.Branch == "" {
+		return "", s2herrors.Wrap(s2herrors.ErrInternalCheckerError,
+			fmt.Sprintf("git repository and branch of component %s are required", name))
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), MaxRequestsTimeout)
+	defer cancelFunc()
+
+	commit, err := c.getLatestCommit(ctx, gitRef)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			logger.Error(s2herrors.ErrRequestTimeout, fmt.Sprintf("checking took more than %v", MaxRequestsTimeout))
+			return "", s2herrors.ErrRequestTimeout
+		}
+
+		logger.Error(err, "cannot get the latest commit",
+			"provider", gitRef.Provider, "repository", gitRef.Repository, "branch", gitRef.Branch)
+		return "", err
+	}
+
+	tag := renderTag(gitRef, commit)
+	if gitRef.ImageSource == nil {
+		return tag, nil
+	}
+
+	imageChecker, err := c.getChecker(string(*gitRef.ImageSource))
+	if err != nil || imageChecker.GetName() == CheckerName {
+		return tag, s2herrors.Wrap(s2herrors.ErrInternalCheckerError,
+			fmt.Sprintf("invalid image source %s of component %s", *gitRef.ImageSource, name))
+	}
+
+	if err := imageChecker.EnsureVersion(repository, name, tag); err != nil {
+		logger.Debug("image of the latest commit not found",
+			"repository", repository, "tag", tag, "source", *gitRef.ImageSource)
+		return tag, err
+	}
+
+	return tag, nil
+}
+
+func (c *checker) getLatestCommit(ctx context.Context, gitRef s2hv1.ComponentGitRef) (string, error) {
+	switch gitRef.Provider {
+	case s2hv1.GitProviderGithub:
+		return c.getGithubCommit(ctx, gitRef)
+	case s2hv1.GitProviderGitlab:
+		return c.getGitlabCommit(ctx, gitRef)
+	case s2hv1.GitProviderLocal:
+		return getLocalCommit(gitRef.Repository, gitRef.Branch)
+	default:
+		return "", s2herrors.Wrap(s2herrors.ErrInternalCheckerError,
+			fmt.Sprintf("unsupported git provider %q", gitRef.Provider))
+	}
+}
+
+func (c *checker) getGithubCommit(ctx context.Context, gitRef s2hv1.ComponentGitRef) (string, error) {
+	baseURL := gitRef.URL
+	if baseURL == "" {
+		baseURL = c.githubURL
+	}
+
+	apiURL := defaultGithubAPIURL
+	if baseURL = strings.TrimSuffix(baseURL, "/"); baseURL != "" && baseURL != "https://github.com" {
+		// Github Enterprise
+		apiURL = baseURL + "/api/v3"
+	}
+
+	opts := c.getHTTPOpts(ctx)
+	opts = append(opts, http.WithHeader("Accept", "application/vnd.github.v3+json"))
+	if c.githubToken != "" {
+		opts = append(opts, http.WithHeader("Authorization", "token "+c.githubToken))
+	}
+
+	reqURL := fmt.Sprintf("%s/repos/%s/commits/%s", apiURL, gitRef.Repository, gitRef.Branch)
+	_, data, err := http.Get(reqURL, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var res githubCommitRes
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", err
+	}
+
+	if res.SHA == "" {
+		return "", fmt.Errorf("commit of branch %s not found", gitRef.Branch)
+	}
+
+	return res.SHA, nil
+}
+
+func (c *checker) getGitlabCommit(ctx context.Context, gitRef s2hv1.ComponentGitRef) (string, error) {
+	baseURL := strings.TrimSuffix(gitRef.URL, "/")
+	if baseURL == "" {
+		baseURL = defaultGitlabURL
+	}
+
+	opts := c.getHTTPOpts(ctx)
+	if c.gitlabToken != "" {
+		opts = append(opts, http.WithHeader("PRIVATE-TOKEN", c.gitlabToken))
+	}
+
+	reqURL := fmt.Sprintf("%s/api/v4/projects/%s/repository/branches/%s",
+		baseURL, url.PathEscape(gitRef.Repository), url.PathEscape(gitRef.Branch))
+	_, data, err := http.Get(reqURL, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var res gitlabBranchRes
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", err
+	}
+
+	if res.Commit.ID == "" {
+		return "", fmt.Errorf("commit of branch %s not found", gitRef.Branch)
+	}
+
+	return res.Commit.ID, nil
+}
+
+func (c *checker) getHTTPOpts(ctx context.Context) []http.Option {
+	opts := []http.Option{
+		http.WithTimeout(MaxOneRequestTimeout),
+		http.WithContext(ctx),
+	}
+	if len(c.httpOpts) > 0 {
+		opts = append(opts, c.httpOpts...)
+	}
+
+	return opts
+}
+
+// getLocalCommit returns the latest commit of branch from loose or packed refs of bare repository
+func getLocalCommit(repoPath, branch string) (string, error) {
+	ref := "refs/heads/" + branch
+	data, err := ioutil.ReadFile(filepath.Join(repoPath, filepath.FromSlash(ref)))
+	if err == nil {
+		return strings.TrimSpace(string(data)), nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	f, err := os.Open(filepath.Join(repoPath, "packed-refs"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// format: <commit> <ref>
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == ref {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("branch %s not found in %s", branch, repoPath)
+}
+
+func renderTag(gitRef s2hv1.ComponentGitRef, commit string) string {
+	tmpl := gitRef.TagTemplate
+	if tmpl == "" {
+		tmpl = defaultTagTemplate
+	}
+
+	shortCommit := commit
+	if len(shortCommit) > shortCommitLength {
+		shortCommit = shortCommit[:shortCommitLength]
+	}
+
+	return strings.TrimSpace(template.TextRender("git-tag", tmpl, tagData{
+		Commit:      commit,
+		ShortCommit: shortCommit,
+		Branch:      gitRef.Branch,
+	}))
+}
@@ -0,0 +1,182 @@
+package git
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestGitChecker(t *testing.T) {
+	unittest.InitGinkgo(t, "Git Checker")
+}
+
+const commit = "0a1b2c3d4e5f60718293a4b5c6d7e8f901234567"
+
+var _ = Describe("Git Checker", func() {
+	g := NewWithT(GinkgoT())
+
+	var checker internal.DesiredComponentGitChecker
+	var imageChecker *mockImageChecker
+
+	BeforeEach(func() {
+		imageChecker = &mockImageChecker{}
+		checker = New(func(source string) (internal.DesiredComponentChecker, error) {
+			if source != imageChecker.GetName() {
+				return nil, s2herrors.ErrInternalCheckerError
+			}
+			return imageChecker, nil
+		}, WithGithubToken("github-token"), WithGitlabToken("gitlab-token"))
+	})
+
+	It("should returns 'git' as name", func() {
+		Expect(checker.GetName()).To(Equal("git"))
+	})
+
+	It("should fail to get version without git reference", func() {
+		_, err := checker.GetVersion("samsahai/app", "app", "")
+		g.Expect(s2herrors.IsInternalCheckerError(err)).To(BeTrue())
+	})
+
+	It("should successfully get the latest commit from github", func(done Done) {
+		defer close(done)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			g.Expect(r.URL.Path).To(Equal("/api/v3/repos/agoda-com/app/commits/main"))
+			g.Expect(r.Header.Get("Authorization")).To(Equal("token github-token"))
+
+			_, err := w.Write([]byte(`{"sha": "` + commit + `"}`))
+			g.Expect(err).NotTo(HaveOccurred())
+		}))
+		defer server.Close()
+
+		version, err := checker.GetGitRefVersion("samsahai/app", "app", s2hv1.ComponentGitRef{
+			Provider:    s2hv1.GitProviderGithub,
+			URL:         server.URL,
+			Repository:  "agoda-com/app",
+			Branch:      "main",
+			TagTemplate: "{{ .Branch }}-{{ .ShortCommit }}",
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("main-0a1b2c3"))
+	})
+
+	It("should successfully get the latest commit from gitlab", func(done Done) {
+		defer close(done)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			g.Expect(r.URL.EscapedPath()).To(Equal("/api/v4/projects/group%2Fapp/repository/branches/main"))
+			g.Expect(r.Header.Get("PRIVATE-TOKEN")).To(Equal("gitlab-token"))
+
+			_, err := w.Write([]byte(`{"name": "main", "commit": {"id": "` + commit + `"}}`))
+			g.Expect(err).NotTo(HaveOccurred())
+		}))
+		defer server.Close()
+
+		version, err := checker.GetGitRefVersion("samsahai/app", "app", s2hv1.ComponentGitRef{
+			Provider:   s2hv1.GitProviderGitlab,
+			URL:        server.URL,
+			Repository: "group/app",
+			Branch:     "main",
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal(commit))
+	})
+
+	It("should successfully get the latest commit from local bare repository", func() {
+		repoPath, err := ioutil.TempDir("", "s2h-git-checker")
+		g.Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(repoPath)
+
+		g.Expect(os.MkdirAll(filepath.Join(repoPath, "refs", "heads", "feature"), 0755)).To(Succeed())
+		g.Expect(ioutil.WriteFile(filepath.Join(repoPath, "refs", "heads", "feature", "loose"),
+			[]byte(commit+"\n"), 0644)).To(Succeed())
+		g.Expect(ioutil.WriteFile(filepath.Join(repoPath, "packed-refs"),
+			[]byte("# pack-refs with: peeled fully-peeled sorted\n"+
+				"1111111111111111111111111111111111111111 refs/heads/main\n"), 0644)).To(Succeed())
+
+		gitRef := s2hv1.ComponentGitRef{
+			Provider:   s2hv1.GitProviderLocal,
+			Repository: repoPath,
+			Branch:     "feature/loose",
+		}
+		version, err := checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal(commit))
+
+		gitRef.Branch = "main"
+		version, err = checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("1111111111111111111111111111111111111111"))
+
+		gitRef.Branch = "unknown"
+		_, err = checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should ensure image of the latest commit exists via image source", func() {
+		repoPath, err := ioutil.TempDir("", "s2h-git-checker")
+		g.Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(repoPath)
+
+		g.Expect(os.MkdirAll(filepath.Join(repoPath, "refs", "heads"), 0755)).To(Succeed())
+		g.Expect(ioutil.WriteFile(filepath.Join(repoPath, "refs", "heads", "main"),
+			[]byte(commit), 0644)).To(Succeed())
+
+		imageSource := s2hv1.UpdatingSource("mock")
+		gitRef := s2hv1.ComponentGitRef{
+			Provider:    s2hv1.GitProviderLocal,
+			Repository:  repoPath,
+			Branch:      "main",
+			ImageSource: &imageSource,
+		}
+
+		_, err = checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+		g.Expect(imageChecker.ensuredVersion).To(Equal(commit))
+
+		imageChecker.tags = []string{commit}
+		version, err := checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal(commit))
+
+		invalidSource := s2hv1.UpdatingSource("invalid")
+		gitRef.ImageSource = &invalidSource
+		_, err = checker.GetGitRefVersion("samsahai/app", "app", gitRef)
+		g.Expect(s2herrors.IsInternalCheckerError(err)).To(BeTrue())
+	})
+})
+
+type mockImageChecker struct {
+	tags           []string
+	ensuredVersion string
+}
+
+func (c *mockImageChecker) GetName() string {
+	return "mock"
+}
+
+func (c *mockImageChecker) GetVersion(repository, name, pattern string) (string, error) {
+	return "", s2herrors.ErrImageVersionNotFound
+}
+
+func (c *mockImageChecker) EnsureVersion(repository, name, version string) error {
+	c.ensuredVersion = version
+	for _, tag := range c.tags {
+		if tag == version {
+			return nil
+		}
+	}
+
+	return s2herrors.ErrImageVersionNotFound
+}
@@ -0,0 +1,269 @@
+package helmchart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/util/http"
+)
+
+var logger = s2hlog.Log.WithName(CheckerName)
+
+const (
+	CheckerName = "helm-chart"
+
+	MaxRequestsTimeout   = 60 * time.Second
+	MaxOneRequestTimeout = 10 * time.Second
+
+	ociScheme = "oci://"
+)
+
+var challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+type checker struct {
+	httpOpts []http.Option
+}
+
+type chartIndex struct {
+	Entries map[string][]chartIndexEntry `json:"entries"`
+}
+
+type chartIndexEntry struct {
+	Version string `json:"version"`
+}
+
+type ociTagList struct {
+	Tags []string `json:"tags"`
+}
+
+type ociToken struct {
+	Token       string `json:"token"`
+	AccessToken string `json:"access_token"`
+}
+
+// New creates a checker of chart versions from Helm chart repository,
+// both of http repository which serves `index.yaml` and OCI registry (`oci://<host>/<path>`) are supported.
+func New(opts ...http.Option) internal.DesiredComponentChecker {
+	return &checker{
+		httpOpts: opts,
+	}
+}
+
+func (c *checker) GetName() string {
+	return CheckerName
+}
+
+func (c *checker) GetVersion(repository, name, pattern string) (string, error) {
+	if pattern == "" {
+		pattern = ".*"
+	}
+
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		logger.Error(err, "invalid pattern", "pattern", pattern)
+		return "", err
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), MaxRequestsTimeout)
+	defer cancelFunc()
+
+	versionCh, errCh := c.check(ctx, repository, name, matcher)
+
+	select {
+	case <-ctx.Done():
+		logger.Error(s2herrors.ErrRequestTimeout, fmt.Sprintf("checking took more than %v", MaxRequestsTimeout))
+		return pattern, s2herrors.ErrRequestTimeout
+	case err := <-errCh:
+		return pattern, err
+	case version := <-versionCh:
+		return version, nil
+	}
+}
+
+func (c *checker) EnsureVersion(repository, name, version string) error {
+	_, err := c.GetVersion(repository, name, "^"+regexp.QuoteMeta(version)+"$")
+	return err
+}
+
+// check returns the latest matched chart version from chart repository
+func (c *checker) check(ctx context.Context, repository, name string, matcher *regexp.Regexp) (<-chan string, <-chan error) {
+	// channels are buffered so that the goroutine does not leak when the caller has been timeout
+	versionCh := make(chan string, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		var versions []string
+		var err error
+		if strings.HasPrefix(repository, ociScheme) {
+			versions, err = c.getOCIVersions(ctx, strings.TrimPrefix(repository, ociScheme), name)
+		} else {
+			versions, err = c.getIndexVersions(ctx, repository, name)
+		}
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		var matchedVersions []string
+		for _, version := range versions {
+			if matcher.MatchString(version) {
+				matchedVersions = append(matchedVersions, version)
+			}
+		}
+
+		if len(matchedVersions) == 0 {
+			logger.Debug("chart version not found",
+				"repository", repository, "chart", name, "pattern", matcher.String())
+			errCh <- s2herrors.ErrImageVersionNotFound
+			return
+		}
+
+		sort.Sort(internal.SortableVersion(matchedVersions))
+		versionCh <- matchedVersions[len(matchedVersions)-1]
+	}()
+
+	return versionCh, errCh
+}
+
+// getIndexVersions returns all versions of the chart from `index.yaml` of http chart repository
+func (c *checker) getIndexVersions(ctx context.Context, repository, name string) ([]string, error) {
+	reqURL := strings.TrimSuffix(repository, "/") + "/index.yaml"
+	_, data, err := http.Get(reqURL, c.getHTTPOpts(ctx)...)
+	if err != nil {
+		logger.Error(err, "GET request failed", "url", reqURL)
+		return nil, err
+	}
+
+	index := chartIndex{}
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		logger.Error(err, "cannot unmarshal chart repository index", "url", reqURL)
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(index.Entries[name]))
+	for _, entry := range index.Entries[name] {
+		versions = append(versions, entry.Version)
+	}
+
+	return versions, nil
+}
+
+// getOCIVersions returns all versions of the chart from OCI registry,
+// anonymous bearer token will be requested if the registry requires
+func (c *checker) getOCIVersions(ctx context.Context, repository, name string) ([]string, error) {
+	repository = strings.TrimSuffix(repository, "/")
+	paths := strings.SplitN(repository, "/", 2)
+	chartPath := name
+	if len(paths) > 1 && paths[1] != "" {
+		chartPath = paths[1] + "/" + name
+	}
+	reqURL := fmt.Sprintf("https://%s/v2/%s/tags/list", paths[0], chartPath)
+
+	respHeader := nethttp.Header{}
+	opts := append(c.getHTTPOpts(ctx), http.WithResponseHeader(&respHeader))
+	statusCode, data, err := http.Get(reqURL, opts...)
+	if statusCode == nethttp.StatusUnauthorized {
+		token, tokenErr := c.getOCIToken(ctx, respHeader.Get("WWW-Authenticate"))
+		if tokenErr != nil {
+			logger.Error(tokenErr, "cannot get token of OCI registry", "url", reqURL)
+			return nil, tokenErr
+		}
+
+		opts = append(c.getHTTPOpts(ctx), http.WithHeader("Authorization", "Bearer "+token))
+		_, data, err = http.Get(reqURL, opts...)
+	}
+	if err != nil {
+		logger.Error(err, "GET request failed", "url", reqURL)
+		return nil, err
+	}
+
+	tagList := ociTagList{}
+	if err := json.Unmarshal(data, &tagList); err != nil {
+		logger.Error(err, "cannot unmarshal json response", "url", reqURL)
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(tagList.Tags))
+	for _, tag := range tagList.Tags {
+		// helm replaces `+` of chart version by `_` since `+` is not allowed in OCI tag
+		versions = append(versions, strings.ReplaceAll(tag, "_", "+"))
+	}
+
+	return versions, nil
+}
+
+// getOCIToken requests anonymous token from the realm of `WWW-Authenticate` bearer challenge
+func (c *checker) getOCIToken(ctx context.Context, challenge string) (string, error) {
+	params := parseBearerChallenge(challenge)
+	realm := params["realm"]
+	if realm == "" {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	query := url.Values{}
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+
+	reqURL := realm
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	_, data, err := http.Get(reqURL, c.getHTTPOpts(ctx)...)
+	if err != nil {
+		return "", err
+	}
+
+	token := ociToken{}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return "", err
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
+
+func (c *checker) getHTTPOpts(ctx context.Context) []http.Option {
+	opts := []http.Option{
+		http.WithTimeout(MaxOneRequestTimeout),
+		http.WithContext(ctx),
+	}
+	if len(c.httpOpts) > 0 {
+		opts = append(opts, c.httpOpts...)
+	}
+
+	return opts
+}
+
+// parseBearerChallenge parses parameters of challenge e.g.
+// `Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:org/chart:pull"`
+func parseBearerChallenge(challenge string) map[string]string {
+	params := map[string]string{}
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return params
+	}
+
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+
+	return params
+}
@@ -0,0 +1,110 @@
+package helmchart
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	s2hhttp "github.com/agoda-com/samsahai/internal/util/http"
+	"github.com/agoda-com/samsahai/internal/util/unittest"
+)
+
+func TestHelmChartChecker(t *testing.T) {
+	unittest.InitGinkgo(t, "Helm Chart Checker")
+}
+
+var _ = Describe("Helm Chart Checker", func() {
+	g := NewWithT(GinkgoT())
+
+	var checker internal.DesiredComponentChecker
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		checker = New(s2hhttp.WithSkipTLSVerify())
+	})
+
+	It("should returns 'helm-chart' as name", func() {
+		Expect(checker.GetName()).To(Equal("helm-chart"))
+	})
+
+	It("should successfully get new version from chart repository index", func(done Done) {
+		defer close(done)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			g.Expect(r.URL.Path).To(Equal("/stable/index.yaml"))
+
+			_, err := w.Write([]byte(`
+apiVersion: v1
+entries:
+  redis:
+  - name: redis
+    version: 10.6.1
+  - name: redis
+    version: 10.10.0
+  - name: redis
+    version: 11.0.0-rc.1
+  mariadb:
+  - name: mariadb
+    version: 10.20.0
+`))
+			g.Expect(err).NotTo(HaveOccurred())
+		}))
+		defer server.Close()
+
+		version, err := checker.GetVersion(server.URL+"/stable/", "redis", `10\..+`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("10.10.0"))
+
+		err = checker.EnsureVersion(server.URL+"/stable", "redis", "10.6.1")
+		g.Expect(err).NotTo(HaveOccurred())
+
+		err = checker.EnsureVersion(server.URL+"/stable", "redis", "10.6")
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+	})
+
+	It("should successfully get new version from OCI registry with anonymous token", func(done Done) {
+		defer close(done)
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			switch r.URL.Path {
+			case "/token":
+				g.Expect(r.URL.Query().Get("scope")).To(Equal("repository:charts/redis:pull"))
+				_, _ = w.Write([]byte(`{"token": "anonymous"}`))
+			case "/v2/charts/redis/tags/list":
+				if r.Header.Get("Authorization") != "Bearer anonymous" {
+					w.Header().Set("WWW-Authenticate",
+						`Bearer realm="https://`+r.Host+`/token",service="registry",scope="repository:charts/redis:pull"`)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"name": "charts/redis", "tags": ["10.6.1", "10.10.0_build.1", "latest"]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		repo := "oci://" + strings.Replace(server.URL, "https://", "", 1) + "/charts"
+		version, err := checker.GetVersion(repo, "redis", `^10\.`)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(version).To(Equal("10.10.0+build.1"))
+	})
+
+	It("should return error when chart does not exist", func(done Done) {
+		defer close(done)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`entries: {}`))
+		}))
+		defer server.Close()
+
+		_, err := checker.GetVersion(server.URL, "redis", "")
+		g.Expect(err).To(Equal(s2herrors.ErrImageVersionNotFound))
+	})
+})
@@ -3,11 +3,14 @@ package samsahai
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/imdario/mergo"
@@ -42,7 +45,9 @@ import (
 	"github.com/agoda-com/samsahai/internal/reporter/rest"
 	"github.com/agoda-com/samsahai/internal/reporter/shell"
 	"github.com/agoda-com/samsahai/internal/reporter/slack"
+	"github.com/agoda-com/samsahai/internal/samsahai/checker/git"
 	"github.com/agoda-com/samsahai/internal/samsahai/checker/harbor"
+	"github.com/agoda-com/samsahai/internal/samsahai/checker/helmchart"
 	"github.com/agoda-com/samsahai/internal/samsahai/checker/publicregistry"
 	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
 	"github.com/agoda-com/samsahai/internal/samsahai/k8sobject"
@@ -93,6 +98,8 @@ type controller struct {
 	// pluginsDisabled represents should controller load plugins or not.
 	pluginsDisabled bool
 	plugins         map[string]internal.Plugin
+	// checkersMu protects checkers and plugins which can be registered at runtime
+	checkersMu sync.RWMutex
 
 	// reportersDisabled represents should controller load reporter or not.
 	reportersDisabled bool
@@ -230,9 +237,17 @@ func (c *controller) loadReporters() {
 
 func (c *controller) loadCheckers() {
 	// init checkers
+	cred := c.configs.SamsahaiCredential
 	checkers := []internal.DesiredComponentChecker{
 		publicregistry.New(),
 		harbor.New(),
+		helmchart.New(),
+		git.New(
+			c.getComponentChecker,
+			git.WithGithubURL(c.configs.GithubURL),
+			git.WithGithubToken(cred.GithubToken),
+			git.WithGitlabToken(cred.GitlabToken),
+		),
 	}
 	for _, checker := range checkers {
 		if checker == nil {
@@ -284,7 +299,63 @@ func (c *controller) GetConfigController() internal.ConfigController {
 }
 
 func (c *controller) GetPlugins() map[string]internal.Plugin {
-	return c.plugins
+	c.checkersMu.RLock()
+	defer c.checkersMu.RUnlock()
+
+	plugins := make(map[string]internal.Plugin, len(c.plugins))
+	for name, p := range c.plugins {
+		plugins[name] = p
+	}
+
+	return plugins
+}
+
+func (c *controller) RegisterPlugin(p internal.Plugin) error {
+	c.checkersMu.Lock()
+	defer c.checkersMu.Unlock()
+
+	name := p.GetName()
+	if _, ok := c.checkers[name]; ok {
+		oldPlugin, ok := c.plugins[name]
+		if !ok {
+			return fmt.Errorf("checker %s already exists", name)
+		}
+		closePlugin(oldPlugin)
+	}
+
+	c.plugins[name] = p
+	c.checkers[name] = p
+	logger.Info("plugin has been registered", "name", name)
+
+	return nil
+}
+
+func (c *controller) UnregisterPlugin(name string) {
+	c.checkersMu.Lock()
+	defer c.checkersMu.Unlock()
+
+	p, ok := c.plugins[name]
+	if !ok {
+		return
+	}
+
+	closePlugin(p)
+	delete(c.plugins, name)
+	delete(c.checkers, name)
+	logger.Info("plugin has been unregistered", "name", name)
+}
+
+// closePlugin closes connection of out-of-process plugin
+func closePlugin(p internal.Plugin) {
+	if closer, ok := p.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logger.Warn("cannot close plugin", "name", p.GetName(), "error", err.Error())
+		}
+	}
+}
+
+func (c *controller) GetRegistryWebhookSecret() string {
+	return c.configs.SamsahaiCredential.RegistryWebhookSecret
 }
 
 type TeamNamespaceStatusOption func(teamComp *s2hv1.Team) (string, corev1.ResourceList, s2hv1.TeamConditionType)
@@ -371,6 +442,68 @@ func withTeamPullRequestNamespaceStatus(namespace string, resources corev1.Resou
 	}
 }
 
+func withTeamStagingSlotNamespaceStatus(namespace string, resources corev1.ResourceList, isDelete ...bool) TeamNamespaceStatusOption {
+	return func(teamComp *s2hv1.Team) (string, corev1.ResourceList, s2hv1.TeamConditionType) {
+		slotNamespaces := make([]string, 0)
+		for _, slotNamespace := range teamComp.Status.Namespace.StagingSlots {
+			if slotNamespace != namespace {
+				slotNamespaces = append(slotNamespaces, slotNamespace)
+			}
+		}
+
+		if len(isDelete) == 0 || !isDelete[0] {
+			slotNamespaces = append(slotNamespaces, namespace)
+			sort.Strings(slotNamespaces)
+		}
+
+		teamComp.Status.Namespace.StagingSlots = slotNamespaces
+
+		return namespace, resources, getStagingSlotNamespaceCreatedConditionType(namespace)
+	}
+}
+
+func withTeamRetainedActiveNamespaceStatus(namespace string, isDelete ...bool) TeamNamespaceStatusOption {
+	return func(teamComp *s2hv1.Team) (string, corev1.ResourceList, s2hv1.TeamConditionType) {
+		retainedNamespaces := make([]string, 0)
+		if len(isDelete) == 0 || !isDelete[0] {
+			// the latest retained namespace comes first
+			retainedNamespaces = append(retainedNamespaces, namespace)
+		}
+
+		for _, retainedNamespace := range teamComp.Status.Namespace.RetainedActives {
+			if retainedNamespace != namespace {
+				retainedNamespaces = append(retainedNamespaces, retainedNamespace)
+			}
+		}
+
+		teamComp.Status.Namespace.RetainedActives = retainedNamespaces
+
+		return namespace, nil, getRetainedActiveNamespaceCreatedConditionType(namespace)
+	}
+}
+
+func getRetainedActiveNamespaceCreatedConditionType(namespace string) s2hv1.TeamConditionType {
+	return s2hv1.TeamNamespaceRetainedActiveCreated + s2hv1.TeamConditionType("-"+namespace)
+}
+
+func getStagingSlotNamespaceCreatedConditionType(namespace string) s2hv1.TeamConditionType {
+	return s2hv1.TeamNamespaceStagingSlotCreated + s2hv1.TeamConditionType("-"+namespace)
+}
+
+func getPostStagingSlotNamespaceRunConditionType(namespace string) s2hv1.TeamConditionType {
+	return s2hv1.TeamPostStagingSlotNamespaceCreationRun + s2hv1.TeamConditionType("-"+namespace)
+}
+
+func isStagingSlotNamespace(teamComp *s2hv1.Team, namespace string) bool {
+	for _, slotNamespace := range teamComp.Status.Namespace.StagingSlots {
+		if slotNamespace == namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getPullRequestNamespaceCreatedConditionType(namespace string) s2hv1.TeamConditionType {
 	return s2hv1.TeamNamespacePullRequestCreated + s2hv1.TeamConditionType("-"+namespace)
 }
@@ -388,6 +521,42 @@ func (c *controller) CreateStagingEnvironment(teamName, namespace string) error
 	return c.createNamespace(teamName, withTeamStagingNamespaceStatus(namespace, resources))
 }
 
+// EnsureStagingSlotEnvironments creates or destroys additional staging namespaces
+// following number of staging slots of the team
+func (c *controller) EnsureStagingSlotEnvironments(teamName string) error {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	slotNamespaces := make(map[string]struct{})
+	for slot := 1; slot < teamComp.Status.Used.StagingSlots; slot++ {
+		namespace := internal.GenStagingSlotNamespace(teamName, slot)
+		slotNamespaces[namespace] = struct{}{}
+
+		resources, err := c.EnsureStagingResourcesQuota(teamName, namespace, true)
+		if err != nil {
+			return errors.Wrapf(err, "cannot ensure staging resources quota")
+		}
+
+		if err := c.createNamespace(teamName, withTeamStagingSlotNamespaceStatus(namespace, resources)); err != nil {
+			return err
+		}
+	}
+
+	for _, namespace := range teamComp.Status.Namespace.StagingSlots {
+		if _, ok := slotNamespaces[namespace]; ok {
+			continue
+		}
+
+		if err := c.destroyNamespace(teamName, withTeamStagingSlotNamespaceStatus(namespace, nil, true)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *controller) CreatePreActiveEnvironment(teamName, namespace string) error {
 	return c.createNamespace(teamName, withTeamPreActiveNamespaceStatus(namespace))
 }
@@ -434,6 +603,19 @@ func (c *controller) PromoteActiveEnvironment(
 	}
 
 	if namespace == activeNamespace {
+		if len(comps) > 0 {
+			// active namespace has been upgraded in place
+			if err := c.storeActiveComponentsToTeam(teamComp, comps); err != nil {
+				return errors.Wrapf(err, "cannot store active components of %s into team %s",
+					namespace, teamComp.Name)
+			}
+
+			if err := c.updateTeamNamespacesStatus(teamComp,
+				withTeamActiveNamespaceStatus(activeNamespace, promotedBy)); err != nil {
+				return errors.Wrap(err, "cannot update team active components when promote active")
+			}
+		}
+
 		logger.Debug(fmt.Sprintf("%s namespace is switched to active namespace successfully", namespace))
 		return nil
 	}
@@ -496,6 +678,12 @@ func (c *controller) createNamespaceByTeam(teamComp *s2hv1.Team, teamNsOpt TeamN
 	if err := c.client.Get(ctx, types.NamespacedName{Name: namespace}, &namespaceObj); err != nil {
 		if k8serrors.IsNotFound(err) {
 			logger.Debug("start creating namespace", "team", teamComp.Name, "namespace", namespace)
+			if nsConditionType == getStagingSlotNamespaceCreatedConditionType(namespace) {
+				if err := controllerutil.SetControllerReference(teamComp, &namespaceObj, c.scheme); err != nil {
+					return err
+				}
+			}
+
 			if nsConditionType == s2hv1.TeamNamespaceStagingCreated {
 				if err := controllerutil.SetControllerReference(teamComp, &namespaceObj, c.scheme); err != nil {
 					return err
@@ -545,8 +733,10 @@ func (c *controller) createNamespaceByTeam(teamComp *s2hv1.Team, teamNsOpt TeamN
 				!teamComp.Status.IsConditionTrue(s2hv1.TeamPostPreActiveNamespaceCreationRun)
 			postPullRequestNsNotRun := nsConditionType == getPullRequestNamespaceCreatedConditionType(namespace) &&
 				!teamComp.Status.IsConditionTrue(getPostPullRequestNamespaceRunConditionType(namespace))
+			postStagingSlotNsNotRun := nsConditionType == getStagingSlotNamespaceCreatedConditionType(namespace) &&
+				!teamComp.Status.IsConditionTrue(getPostStagingSlotNamespaceRunConditionType(namespace))
 
-			if postStagingNsNotRun || postPreActiveNsNotRun || postPullRequestNsNotRun {
+			if postStagingNsNotRun || postPreActiveNsNotRun || postPullRequestNsNotRun || postStagingSlotNsNotRun {
 				logger.Debug("start executing command after creating namespace",
 					"team", teamComp.Name, "namespace", namespace)
 				if err := c.runPostNamespaceCreation(namespace, teamComp); err != nil {
@@ -619,6 +809,10 @@ func (c *controller) runPostNamespaceCreation(ns string, team *s2hv1.Team) error
 }
 
 func (c *controller) createEnvironmentObjects(teamComp *s2hv1.Team, namespace string, resources corev1.ResourceList) error {
+	if isStagingSlotNamespace(teamComp, namespace) {
+		return c.createStagingSlotEnvironmentObjects(teamComp, namespace, resources)
+	}
+
 	secretKVs := []k8sobject.KeyValue{
 		{
 			Key:   internal.VKS2HAuthToken,
@@ -683,6 +877,29 @@ func (c *controller) createEnvironmentObjects(teamComp *s2hv1.Team, namespace st
 	return nil
 }
 
+// createStagingSlotEnvironmentObjects creates objects of additional staging namespace,
+// components are deployed by the staging controller of staging namespace
+func (c *controller) createStagingSlotEnvironmentObjects(teamComp *s2hv1.Team, namespace string,
+	resources corev1.ResourceList) error {
+
+	k8sObjects := []runtime.Object{
+		k8sobject.GetRole(teamComp, namespace),
+		k8sobject.GetStagingSlotRoleBinding(teamComp, namespace, teamComp.Status.Namespace.Staging),
+	}
+
+	if len(resources) > 0 || len(teamComp.Status.Used.Resources) > 0 {
+		k8sObjects = append(k8sObjects, k8sobject.GetResourceQuota(teamComp, namespace, resources))
+	}
+
+	for _, k8sObject := range k8sObjects {
+		if err := deployStagingCtrl(c.client, k8sObject); err != nil {
+			return errors.Wrap(err, "cannot deploy staging slot objects")
+		}
+	}
+
+	return nil
+}
+
 func (c *controller) sendActiveEnvironmentDeleted(teamName, activeNs, deletedBy string) error {
 	configCtrl := c.GetConfigController()
 	deletedAt := metav1.Now().UTC().Format("2006-01-02T15:04:05")
@@ -715,6 +932,11 @@ func setPostNamespaceCreationCondition(teamComp *s2hv1.Team, nsConditionType s2h
 			getPostPullRequestNamespaceRunConditionType(namespace),
 			cond,
 			message)
+	case getStagingSlotNamespaceCreatedConditionType(namespace):
+		teamComp.Status.SetCondition(
+			getPostStagingSlotNamespaceRunConditionType(namespace),
+			cond,
+			message)
 	}
 }
 
@@ -774,6 +996,14 @@ func getAllTeamNamespaces(teamComp *s2hv1.Team, isDelete bool) []TeamNamespaceSt
 		}
 	}
 
+	for _, ns := range teamComp.Status.Namespace.StagingSlots {
+		teamNsOpts = append(teamNsOpts, withTeamStagingSlotNamespaceStatus(ns, nil, isDelete))
+	}
+
+	for _, ns := range teamComp.Status.Namespace.RetainedActives {
+		teamNsOpts = append(teamNsOpts, withTeamRetainedActiveNamespaceStatus(ns, isDelete))
+	}
+
 	return teamNsOpts
 }
 
@@ -789,6 +1019,10 @@ func (c *controller) DestroyPreviousActiveEnvironment(teamName, namespace string
 	return c.destroyNamespace(teamName, withTeamPreviousActiveNamespaceStatus(namespace, true))
 }
 
+func (c *controller) DestroyRetainedActiveEnvironment(teamName, namespace string) error {
+	return c.destroyNamespace(teamName, withTeamRetainedActiveNamespaceStatus(namespace, true))
+}
+
 func (c *controller) destroyNamespace(teamName string, teamNsOpt TeamNamespaceStatusOption) error {
 	teamComp := &s2hv1.Team{}
 	if err := c.getTeam(teamName, teamComp); err != nil {
@@ -952,6 +1186,12 @@ func (c *controller) LoadTeamSecret(teamComp *s2hv1.Team) error {
 		teamComp.Status.Used.Credential.Github.Token = string(s2hSecret.Data[gitToken.Key])
 	}
 
+	for i, approverCred := range teamComp.Status.Used.Credential.Approvers {
+		if approverCred.TokenRef != nil {
+			teamComp.Status.Used.Credential.Approvers[i].Token = string(s2hSecret.Data[approverCred.TokenRef.Key])
+		}
+	}
+
 	return nil
 }
 
@@ -1161,6 +1401,9 @@ func (c *controller) GetActivePromotionDeployEngine(teamName, ns string) interna
 }
 
 func (c *controller) getComponentChecker(source string) (internal.DesiredComponentChecker, error) {
+	c.checkersMu.RLock()
+	defer c.checkersMu.RUnlock()
+
 	checker, ok := c.checkers[source]
 	if !ok {
 		return nil, fmt.Errorf("component checker source %s not found", source)
@@ -1283,6 +1526,44 @@ func (c *controller) destroyClusterRoleBinding(namespace string) error {
 	return err
 }
 
+// SetTeamQueuePaused pauses or resumes the team queues
+func (c *controller) SetTeamQueuePaused(teamName string, paused bool, actionBy string) error {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	if teamComp.Spec.Queue == nil {
+		teamComp.Spec.Queue = &s2hv1.TeamQueue{}
+	}
+	teamComp.Spec.Queue.Paused = paused
+
+	// used specification is also updated to take effect immediately
+	if teamComp.Status.Used.Queue == nil {
+		teamComp.Status.Used.Queue = &s2hv1.TeamQueue{}
+	}
+	teamComp.Status.Used.Queue.Paused = paused
+
+	if err := c.updateTeam(teamComp); err != nil {
+		return err
+	}
+
+	teamList, err := c.GetTeams()
+	if err != nil {
+		return err
+	}
+	exporter.SetTeamQueuePausedMetric(teamList, time.Now())
+
+	action := internal.QueueActionResume
+	if paused {
+		action = internal.QueueActionPause
+	}
+	actionAt := time.Now().UTC().Format("2006-01-02T15:04:05")
+	c.sendQueueActionReport(internal.NewTeamQueueActionReporter(teamName, action, actionBy, actionAt))
+
+	return nil
+}
+
 func (c *controller) DeleteTeamActiveEnvironment(teamName, namespace, deletedBy string) error {
 	teamComp := &s2hv1.Team{}
 	if err := c.getTeam(teamName, teamComp); err != nil {
@@ -1793,6 +2074,17 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 
+	if err := c.EnsureStagingSlotEnvironments(teamName); err != nil {
+		if errors.IsNamespaceStillCreating(err) || errors.IsNamespaceStillExists(err) {
+			return reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: 2 * time.Second,
+			}, nil
+		}
+
+		return reconcile.Result{}, err
+	}
+
 	if err := c.LoadTeamSecret(teamComp); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -1803,6 +2095,7 @@ func (c *controller) Reconcile(req reconcile.Request) (reconcile.Result, error)
 		return reconcile.Result{}, err
 	}
 	exporter.SetTeamNameMetric(teamList)
+	exporter.SetTeamQueuePausedMetric(teamList, time.Now())
 
 	// Our finalizer has finished, so the reconciler can do nothing.
 	return reconcile.Result{}, nil
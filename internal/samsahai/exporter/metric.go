@@ -9,6 +9,7 @@ import (
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/queue"
 )
 
 type ActivePromotionMetricState string
@@ -44,15 +45,39 @@ var QueueMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Help: "Show components in queue",
 }, []string{"teamName", "queueName", "component", "version", "state", "order", "no_of_processed"})
 
+var TeamQueuePausedMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "samsahai_team_queue_paused",
+	Help: "Show whether team queues are paused manually or by maintenance windows",
+}, []string{"teamName", "reason"})
+
+var QueueWaitTimeMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "samsahai_queue_wait_seconds",
+	Help: "Show waiting time of queues in seconds",
+}, []string{"teamName", "queueName"})
+
+var QueueWaitSLOBreachedMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "samsahai_queue_wait_slo_breached_total",
+	Help: "Count queues which exceed the maximum waiting time",
+}, []string{"teamName"})
+
 var ActivePromotionMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Name: "samsahai_active_promotion",
 	Help: "Get values from samsahai active promotion",
 }, []string{"teamName", "state"})
 
+var OutdatedComponentMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "samsahai_outdated_component_seconds",
+	Help: "Show outdated duration of active components in seconds",
+}, []string{"teamName", "component"})
+
 func RegisterMetrics() {
 	metrics.Registry.MustRegister(TeamMetric)
 	metrics.Registry.MustRegister(QueueMetric)
+	metrics.Registry.MustRegister(TeamQueuePausedMetric)
+	metrics.Registry.MustRegister(QueueWaitTimeMetric)
+	metrics.Registry.MustRegister(QueueWaitSLOBreachedMetric)
 	metrics.Registry.MustRegister(ActivePromotionMetric)
+	metrics.Registry.MustRegister(OutdatedComponentMetric)
 	metrics.Registry.MustRegister(HealthStatusMetric)
 }
 
@@ -62,6 +87,19 @@ func SetTeamNameMetric(teamList *s2hv1.TeamList) {
 	}
 }
 
+func SetTeamQueuePausedMetric(teamList *s2hv1.TeamList, now time.Time) {
+	for _, teamComp := range teamList.Items {
+		reasons := map[string]float64{queue.PausedReasonManual: 0, queue.PausedReasonMaintenance: 0}
+		if paused, reason := queue.IsTeamQueuePaused(teamComp.Status.Used.Queue, now); paused {
+			reasons[reason] = 1
+		}
+
+		for reason, val := range reasons {
+			TeamQueuePausedMetric.WithLabelValues(teamComp.Name, reason).Set(val)
+		}
+	}
+}
+
 func SetHealthStatusMetric(version, gitCommit string, ts float64) {
 	HealthStatusMetric.WithLabelValues(
 		version,
@@ -100,6 +138,27 @@ func SetQueueMetric(queue *s2hv1.Queue) {
 	}
 }
 
+// SetQueueWaitTimeMetric sets waiting time of the waiting queues,
+// the queues which are not waiting anymore are removed from the metric
+func SetQueueWaitTimeMetric(queues []s2hv1.Queue, now time.Time) {
+	QueueWaitTimeMetric.Reset()
+	for i := range queues {
+		QueueWaitTimeMetric.WithLabelValues(queues[i].Spec.TeamName, queues[i].Name).
+			Set(queue.GetQueueWaitTime(&queues[i], now).Seconds())
+	}
+}
+
+func AddQueueWaitSLOBreachedMetric(teamName string) {
+	QueueWaitSLOBreachedMetric.WithLabelValues(teamName).Inc()
+}
+
+// SetOutdatedComponentMetric sets outdated durations of active components of the team
+func SetOutdatedComponentMetric(teamName string, outdatedDurations map[string]time.Duration) {
+	for compName, outdatedDuration := range outdatedDurations {
+		OutdatedComponentMetric.WithLabelValues(teamName, compName).Set(outdatedDuration.Seconds())
+	}
+}
+
 func SetActivePromotionMetric(atpComp *s2hv1.ActivePromotion) {
 	atpStateList := map[ActivePromotionMetricState]float64{stateWaiting: 0, stateDeploying: 0, stateTesting: 0, statePromoting: 0, stateDestroying: 0}
 	atpState := atpComp.Status.State
@@ -126,7 +185,8 @@ func SetActivePromotionMetric(atpComp *s2hv1.ActivePromotion) {
 					atpComp.Name,
 					string(state)).Set(val)
 			}
-		case s2hv1.ActivePromotionActiveEnvironment, s2hv1.ActivePromotionDemoting:
+		case s2hv1.ActivePromotionActiveEnvironment, s2hv1.ActivePromotionDemoting,
+			s2hv1.ActivePromotionVerifyingActive:
 			atpStateList[statePromoting] = float64(time.Now().Unix())
 			for state, val := range atpStateList {
 				ActivePromotionMetric.WithLabelValues(
@@ -124,8 +124,14 @@ var _ = Describe("Samsahai Exporter", func() {
 		}
 
 		SetTeamNameMetric(teamList)
+		SetTeamQueuePausedMetric(teamList, time.Now())
 		SetQueueMetric(queue)
+		waitingQueue := queue.DeepCopy()
+		waitingQueue.Status.CreatedAt = &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+		SetQueueWaitTimeMetric([]s2hv1.Queue{*waitingQueue}, waitingQueue.Status.CreatedAt.Add(90*time.Second))
+		AddQueueWaitSLOBreachedMetric("testQTeamName1")
 		SetActivePromotionMetric(activePromotion)
+		SetOutdatedComponentMetric("testQTeamName1", map[string]time.Duration{"qName1": 2 * time.Hour})
 		SetHealthStatusMetric("9.9.9.8", "777888999", 234000)
 
 		chStop = make(chan struct{})
@@ -162,6 +168,24 @@ var _ = Describe("Samsahai Exporter", func() {
 		g.Expect(expectedData).To(BeFalse())
 	}, timeout)
 
+	It("should show team queue paused metric correctly", func(done Done) {
+		defer close(done)
+		_, data, err := http.Get("http://localhost:8008/metrics")
+		g.Expect(err).NotTo(HaveOccurred())
+		expectedData := strings.Contains(string(data), `samsahai_team_queue_paused{reason="paused",teamName="testQTeamName1"} 0`)
+		g.Expect(expectedData).To(BeTrue())
+	}, timeout)
+
+	It("should show queue wait-time metric correctly", func(done Done) {
+		defer close(done)
+		_, data, err := http.Get("http://localhost:8008/metrics")
+		g.Expect(err).NotTo(HaveOccurred())
+		expectedData := strings.Contains(string(data), `samsahai_queue_wait_seconds{queueName="group",teamName="testQTeamName1"} 90`)
+		g.Expect(expectedData).To(BeTrue())
+		expectedData = strings.Contains(string(data), `samsahai_queue_wait_slo_breached_total{teamName="testQTeamName1"}`)
+		g.Expect(expectedData).To(BeTrue())
+	}, timeout)
+
 	It("should show active promotion correctly", func(done Done) {
 		defer close(done)
 		_, data, err := http.Get("http://localhost:8008/metrics")
@@ -170,6 +194,14 @@ var _ = Describe("Samsahai Exporter", func() {
 		g.Expect(expectedData).To(BeTrue())
 	}, timeout)
 
+	It("should show outdated component metric correctly", func(done Done) {
+		defer close(done)
+		_, data, err := http.Get("http://localhost:8008/metrics")
+		g.Expect(err).NotTo(HaveOccurred())
+		expectedData := strings.Contains(string(data), `samsahai_outdated_component_seconds{component="qName1",teamName="testQTeamName1"} 7200`)
+		g.Expect(expectedData).To(BeTrue())
+	}, timeout)
+
 	It("should show health metric correctly", func(done Done) {
 		defer close(done)
 		_, data, err := http.Get("http://localhost:8008/metrics")
@@ -37,6 +37,7 @@ type updateTeamDesiredComponent struct {
 	ComponentName   string
 	ComponentSource string
 	ComponentImage  s2hv1.ComponentImage
+	ComponentChart  s2hv1.ComponentChart
 	ComponentBundle string
 }
 
@@ -55,6 +56,8 @@ func (c *controller) Start(stop <-chan struct{}) {
 
 	c.queue.Add(updateHealth{})
 	c.queue.AddAfter(exportMetric{}, 30*time.Second)
+	c.queue.Add(pollVersion{})
+	c.queue.Add(scheduleActivePromotion{})
 
 	<-stop
 
@@ -93,6 +96,10 @@ func (c *controller) process() bool {
 		err = c.updateHealthMetric()
 	case exportMetric:
 		err = c.exportTeamMetric()
+	case pollVersion:
+		err = c.pollScheduledComponentVersions()
+	case scheduleActivePromotion:
+		err = c.scheduleActivePromotions()
 	default:
 		c.queue.Forget(obj)
 		return true
@@ -144,17 +151,12 @@ func (c *controller) checkTeamComponentChanged(compName, repository, teamName st
 
 	comps, _ := configCtrl.GetComponents(teamName)
 	for _, comp := range comps {
-		if compName != comp.Name || comp.Source == nil {
-			// ignored mismatch or missing source
+		if compName != comp.Name || !c.hasComponentChecker(comp) {
+			// ignored mismatch, missing or non-existing source
 			continue
 		}
 
-		if _, err := c.getComponentChecker(string(*comp.Source)); err != nil {
-			// ignore non-existing source
-			continue
-		}
-
-		if repository != "" && repository != comp.Image.Repository {
+		if repository != "" && repository != comp.Image.Repository && repository != comp.Chart.Repository {
 			// ignore mismatch repository
 			continue
 		}
@@ -162,36 +164,57 @@ func (c *controller) checkTeamComponentChanged(compName, repository, teamName st
 		logger.Debug("component has been notified", "team", teamName, "component", comp.Name)
 
 		// add to queue for processing
-		bundleName := c.getBundleName(comp.Name, teamName)
-		c.queue.Add(updateTeamDesiredComponent{
-			TeamName:        teamName,
-			ComponentName:   comp.Name,
-			ComponentSource: string(*comp.Source),
-			ComponentImage:  comp.Image,
-			ComponentBundle: bundleName,
-		})
+		c.queue.Add(c.newUpdateTeamDesiredComponent(teamName, comp))
 	}
 
 	return nil
 }
 
-// updateTeamDesiredComponent gets new version from checker and checks with DesiredComponent of team.
+// hasComponentChecker checks that the component has image or chart source
+// and every defined source has an existing checker
+func (c *controller) hasComponentChecker(comp *s2hv1.Component) bool {
+	if comp.Source == nil && comp.Chart.Source == nil {
+		return false
+	}
+
+	for _, source := range []*s2hv1.UpdatingSource{comp.Source, comp.Chart.Source} {
+		if source == nil {
+			continue
+		}
+
+		if _, err := c.getComponentChecker(string(*source)); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *controller) newUpdateTeamDesiredComponent(teamName string, comp *s2hv1.Component) updateTeamDesiredComponent {
+	updateInfo := updateTeamDesiredComponent{
+		TeamName:        teamName,
+		ComponentName:   comp.Name,
+		ComponentImage:  comp.Image,
+		ComponentChart:  comp.Chart,
+		ComponentBundle: c.getBundleName(comp.Name, teamName),
+	}
+	if comp.Source != nil {
+		updateInfo.ComponentSource = string(*comp.Source)
+	}
+
+	return updateInfo
+}
+
+// updateTeamDesiredComponent gets new image and chart versions from checkers
+// and checks with DesiredComponent of team.
 //
-// updateInfo will always has valid checker (from checkComponentChanged)
+// updateInfo will always has valid checkers (from checkComponentChanged),
+// image tag in config will be used if image source is not defined.
 //
 // Update to the desired version if mismatch.
 func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComponent) error {
 	var err error
 
-	// run checker to get desired version
-	checker, err := c.getComponentChecker(updateInfo.ComponentSource)
-	if err != nil {
-		logger.Error(err, "cannot get component checker",
-			"team", updateInfo.TeamName, "source", updateInfo.ComponentSource)
-		return err
-	}
-	checkPattern := updateInfo.ComponentImage.Pattern
-
 	team := &s2hv1.Team{}
 	if err := c.getTeam(updateInfo.TeamName, team); err != nil {
 		logger.Error(err, "cannot get team", "team", updateInfo.TeamName)
@@ -203,48 +226,28 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	compRepository := updateInfo.ComponentImage.Repository
 	compBundle := updateInfo.ComponentBundle
 
-	// TODO: do caching for better performance
-	version, vErr := checker.GetVersion(compRepository, compName, checkPattern)
-	switch {
-	case vErr == nil:
-	case errors.IsImageNotFound(vErr) || errors.IsErrRequestTimeout(vErr):
-	case errors.IsInternalCheckerError(vErr):
-		c.sendImageMissingReport(updateInfo.TeamName, updateInfo.ComponentName, compRepository, version, vErr.Error())
-		return nil
-	default:
-		logger.Error(vErr, "error while run checker.getversion",
-			"team", updateInfo.TeamName, "name", compName, "repository", compRepository,
-			"version pattern", checkPattern)
-		return vErr
+	version := updateInfo.ComponentImage.Tag
+	if updateInfo.ComponentSource != "" {
+		var isFound bool
+		version, isFound, err = c.getDesiredImageVersion(team, updateInfo)
+		if err != nil || !isFound {
+			return err
+		}
 	}
 
-	ctx := context.Background()
-	now := metav1.Now()
-	desiredImage := stringutils.ConcatImageString(compRepository, version)
-	desiredImageTime := s2hv1.DesiredImageTime{
-		Image: &s2hv1.Image{
-			Repository: compRepository,
-			Tag:        version,
-		},
-		CreatedTime:    now,
-		IsImageMissing: true,
-	}
+	chartVersion, err := c.getDesiredChartVersion(updateInfo)
+	if err != nil {
+		if errors.IsImageNotFound(err) || errors.IsErrRequestTimeout(err) {
+			logger.Warn("cannot get desired chart version", "team", updateInfo.TeamName,
+				"name", compName, "chart", updateInfo.ComponentChart.Name, "error", err.Error())
+			return nil
+		}
 
-	if vErr == nil {
-		desiredImageTime.IsImageMissing = false
-	}
-	//update desired component version created time mapping
-	team.Status.UpdateDesiredComponentImageCreatedTime(updateInfo.ComponentName, desiredImage, desiredImageTime)
-	deleteDesiredMappingOutOfRange(team, maxDesiredMappingPerComp)
-	if err := c.updateTeam(team); err != nil {
 		return err
 	}
 
-	if vErr != nil && (errors.IsImageNotFound(vErr) || errors.IsErrRequestTimeout(vErr)) {
-		c.sendImageMissingReport(updateInfo.TeamName, updateInfo.ComponentName, compRepository, version, "")
-		return nil
-	}
-
+	ctx := context.Background()
+	now := metav1.Now()
 	desiredComp := &s2hv1.DesiredComponent{}
 	err = c.client.Get(ctx, types.NamespacedName{Name: compName, Namespace: compNs}, desiredComp)
 	if err != nil {
@@ -259,10 +262,11 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 					Labels:    desiredLabels,
 				},
 				Spec: s2hv1.DesiredComponentSpec{
-					Version:    version,
-					Name:       compName,
-					Repository: compRepository,
-					Bundle:     compBundle,
+					Version:      version,
+					Name:         compName,
+					Repository:   compRepository,
+					ChartVersion: chartVersion,
+					Bundle:       compBundle,
 				},
 				Status: s2hv1.DesiredComponentStatus{
 					CreatedAt: &now,
@@ -285,10 +289,11 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	// DesiredComponent found, check the version
 	sameComp := desiredComp.IsSame(&s2hv1.DesiredComponent{
 		Spec: s2hv1.DesiredComponentSpec{
-			Name:       compName,
-			Version:    version,
-			Repository: compRepository,
-			Bundle:     compBundle,
+			Name:         compName,
+			Version:      version,
+			Repository:   compRepository,
+			ChartVersion: chartVersion,
+			Bundle:       compBundle,
 		},
 	})
 	if sameComp {
@@ -298,6 +303,7 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	// Update when version or repository changed
 	desiredComp.Spec.Version = version
 	desiredComp.Spec.Repository = compRepository
+	desiredComp.Spec.ChartVersion = chartVersion
 	desiredComp.Spec.Bundle = compBundle
 	desiredComp.Status.UpdatedAt = &now
 
@@ -309,6 +315,98 @@ func (c *controller) updateTeamDesiredComponent(updateInfo updateTeamDesiredComp
 	return nil
 }
 
+// getDesiredImageVersion gets new image version from checker and updates desired image created time of team.
+//
+// isFound will be false if the image version is missing, the image missing report will be sent instead
+func (c *controller) getDesiredImageVersion(team *s2hv1.Team, updateInfo updateTeamDesiredComponent) (
+	version string, isFound bool, err error) {
+
+	// run checker to get desired version
+	checker, err := c.getComponentChecker(updateInfo.ComponentSource)
+	if err != nil {
+		logger.Error(err, "cannot get component checker",
+			"team", updateInfo.TeamName, "source", updateInfo.ComponentSource)
+		return "", false, err
+	}
+	checkPattern := updateInfo.ComponentImage.Pattern
+	compName := updateInfo.ComponentName
+	compRepository := updateInfo.ComponentImage.Repository
+
+	// TODO: do caching for better performance
+	var vErr error
+	if gitChecker, ok := checker.(internal.DesiredComponentGitChecker); ok && updateInfo.ComponentImage.GitRef != nil {
+		version, vErr = gitChecker.GetGitRefVersion(compRepository, compName, *updateInfo.ComponentImage.GitRef)
+	} else {
+		version, vErr = checker.GetVersion(compRepository, compName, checkPattern)
+	}
+	switch {
+	case vErr == nil:
+	case errors.IsImageNotFound(vErr) || errors.IsErrRequestTimeout(vErr):
+	case errors.IsInternalCheckerError(vErr):
+		c.sendImageMissingReport(updateInfo.TeamName, updateInfo.ComponentName, compRepository, version, vErr.Error())
+		return "", false, nil
+	default:
+		logger.Error(vErr, "error while run checker.getversion",
+			"team", updateInfo.TeamName, "name", compName, "repository", compRepository,
+			"version pattern", checkPattern)
+		return "", false, vErr
+	}
+
+	now := metav1.Now()
+	desiredImage := stringutils.ConcatImageString(compRepository, version)
+	desiredImageTime := s2hv1.DesiredImageTime{
+		Image: &s2hv1.Image{
+			Repository: compRepository,
+			Tag:        version,
+		},
+		CreatedTime:    now,
+		IsImageMissing: true,
+	}
+
+	if vErr == nil {
+		desiredImageTime.IsImageMissing = false
+	}
+	//update desired component version created time mapping
+	team.Status.UpdateDesiredComponentImageCreatedTime(updateInfo.ComponentName, desiredImage, desiredImageTime)
+	deleteDesiredMappingOutOfRange(team, maxDesiredMappingPerComp)
+	if err := c.updateTeam(team); err != nil {
+		return "", false, err
+	}
+
+	if vErr != nil && (errors.IsImageNotFound(vErr) || errors.IsErrRequestTimeout(vErr)) {
+		c.sendImageMissingReport(updateInfo.TeamName, updateInfo.ComponentName, compRepository, version, "")
+		return "", false, nil
+	}
+
+	return version, true, nil
+}
+
+// getDesiredChartVersion gets new chart version from checker,
+// empty version will be returned if chart source is not defined
+func (c *controller) getDesiredChartVersion(updateInfo updateTeamDesiredComponent) (string, error) {
+	chart := updateInfo.ComponentChart
+	if chart.Source == nil {
+		return "", nil
+	}
+
+	checker, err := c.getComponentChecker(string(*chart.Source))
+	if err != nil {
+		logger.Error(err, "cannot get chart checker",
+			"team", updateInfo.TeamName, "source", *chart.Source)
+		return "", err
+	}
+
+	version, err := checker.GetVersion(chart.Repository, chart.Name, chart.Pattern)
+	if err != nil {
+		logger.Error(err, "error while run checker.getversion",
+			"team", updateInfo.TeamName, "name", updateInfo.ComponentName, "chart", chart.Name,
+			"repository", chart.Repository, "version pattern", chart.Pattern)
+		return "", err
+	}
+
+	return version, nil
+}
+
 func (c *controller) sendImageMissingReport(teamName, compName, repo, version, reason string) {
 	configCtrl := c.GetConfigController()
 	for _, reporter := range c.reporters {
@@ -383,6 +481,11 @@ func (c *controller) exportTeamMetric() error {
 		return err
 	}
 	exporter.SetTeamNameMetric(teamList)
+	exporter.SetTeamQueuePausedMetric(teamList, time.Now())
+	c.exportQueueWaitMetric(teamList, time.Now())
+
+	// maintenance windows and waiting time of queues are changed over time
+	c.queue.AddAfter(exportMetric{}, time.Minute)
 	return nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -109,6 +110,32 @@ func GetDeployment(scheme *runtime.Scheme, teamComp *s2hv1.Team, namespaceName s
 		},
 	}
 
+	// only the staging controller of staging namespace verifies queues in additional staging slots
+	if stagingSlots := teamComp.Status.Used.StagingSlots; stagingSlots > 1 &&
+		namespaceName == teamComp.Status.Namespace.Staging {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "STAGING_SLOTS",
+			Value: strconv.Itoa(stagingSlots),
+		})
+	}
+
+	// replicas of the staging controller elect a leader to process queues
+	replicas := getStagingCtrlReplicas(teamComp)
+	if replicas > 1 {
+		envVars = append(envVars,
+			corev1.EnvVar{
+				Name:  "LEADER_ELECTION",
+				Value: "true",
+			},
+			corev1.EnvVar{
+				Name: "POD_NAME",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "metadata.name"},
+				},
+			},
+		)
+	}
+
 	for key, value := range configs.StagingEnvs {
 		envVars = append(envVars, corev1.EnvVar{
 			Name:  key,
@@ -123,6 +150,7 @@ func GetDeployment(scheme *runtime.Scheme, teamComp *s2hv1.Team, namespaceName s
 			Labels:    defaultLabelsWithVersion,
 		},
 		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: defaultLabels,
 			},
@@ -217,9 +245,25 @@ func GetDeployment(scheme *runtime.Scheme, teamComp *s2hv1.Team, namespaceName s
 	return &deployment
 }
 
+// getStagingCtrlReplicas returns number of the staging controller replicas, default is 1
+func getStagingCtrlReplicas(teamComp *s2hv1.Team) int32 {
+	if teamComp.Status.Used.StagingCtrl == nil || teamComp.Status.Used.StagingCtrl.Replicas < 1 {
+		return 1
+	}
+
+	return teamComp.Status.Used.StagingCtrl.Replicas
+}
+
 func GetService(scheme *runtime.Scheme, teamComp *s2hv1.Team, namespaceName string) runtime.Object {
 	teamName := teamComp.GetName()
 	defaultLabelsWithVersion := getDefaultLabelsWithVersion(teamName)
+
+	// only the elected replica serves requests of the current queue
+	selector := getDefaultLabelsWithVersion(teamName)
+	if getStagingCtrlReplicas(teamComp) > 1 {
+		selector[internal.StagingCtrlLeaderLabel] = "true"
+	}
+
 	service := corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      internal.StagingCtrlName,
@@ -235,7 +279,7 @@ func GetService(scheme *runtime.Scheme, teamComp *s2hv1.Team, namespaceName stri
 					TargetPort: intstr.FromInt(internal.StagingDefaultPort),
 				},
 			},
-			Selector: defaultLabelsWithVersion,
+			Selector: selector,
 			Type:     corev1.ServiceTypeClusterIP,
 		},
 	}
@@ -385,6 +429,16 @@ func GetRole(teamComp *s2hv1.Team, namespaceName string) runtime.Object {
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
+			// leader election
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"events",
+				},
+				Verbs: []string{"create", "patch"},
+			},
 			{
 				APIGroups: []string{
 					"env.samsahai.io",
@@ -426,6 +480,15 @@ func GetRoleBinding(teamComp *s2hv1.Team, namespaceName string) runtime.Object {
 	return &roleBinding
 }
 
+// GetStagingSlotRoleBinding returns RoleBinding which allows the staging controller of staging namespace
+// to deploy components into the staging slot namespace
+func GetStagingSlotRoleBinding(teamComp *s2hv1.Team, namespaceName, stagingNamespace string) runtime.Object {
+	roleBinding := GetRoleBinding(teamComp, namespaceName).(*rbacv1.RoleBinding)
+	roleBinding.Subjects[0].Namespace = stagingNamespace
+
+	return roleBinding
+}
+
 func GetClusterRole(teamComp *s2hv1.Team, namespace string) runtime.Object {
 	teamName := teamComp.GetName()
 	defaultLabelsWithVersion := getDefaultLabelsWithVersion(teamName)
@@ -443,6 +506,7 @@ func GetClusterRole(teamComp *s2hv1.Team, namespace string) runtime.Object {
 				Resources: []string{
 					"configs",
 					"stablecomponents",
+					"teams",
 				},
 				Verbs: []string{"get", "list", "watch"},
 			},
@@ -591,6 +655,15 @@ func isDeploymentChanged(found, target interface{}) bool {
 		found.(*appsv1.Deployment).Spec.Template.Labels = targetSpecTmplLabels
 	}
 
+	foundReplicas := found.(*appsv1.Deployment).Spec.Replicas
+	targetReplicas := target.(*appsv1.Deployment).Spec.Replicas
+	if !deepEqual(foundReplicas, targetReplicas) {
+		logger.Debug("found deployment replicas changed",
+			"foundReplicas", foundReplicas, "targetReplicas", targetReplicas)
+		isObjChanged = true
+		found.(*appsv1.Deployment).Spec.Replicas = targetReplicas
+	}
+
 	containersLen := len(found.(*appsv1.Deployment).Spec.Template.Spec.Containers)
 	for i := 0; i < containersLen; i++ {
 		foundTmplContainer := found.(*appsv1.Deployment).Spec.Template.Spec.Containers[i]
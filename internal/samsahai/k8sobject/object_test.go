@@ -5,8 +5,13 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
 	"github.com/agoda-com/samsahai/internal/util/unittest"
 )
 
@@ -34,4 +39,61 @@ var _ = Describe("K8s Object", func() {
 		result := areContainersEqual(firstContainers, secondContainers)
 		Expect(result).To(BeTrue())
 	})
+
+	Describe("Staging controller replicas", func() {
+		scheme := runtime.NewScheme()
+		_ = s2hv1.AddToScheme(scheme)
+		configs := &internal.SamsahaiConfig{}
+
+		newTeam := func(replicas int32) *s2hv1.Team {
+			return &s2hv1.Team{
+				ObjectMeta: metav1.ObjectMeta{Name: "teamtest"},
+				Status: s2hv1.TeamStatus{
+					Used: s2hv1.TeamSpec{
+						StagingCtrl: &s2hv1.StagingCtrl{IsDeploy: true, Replicas: replicas},
+					},
+				},
+			}
+		}
+
+		getEnvNames := func(deployment *appsv1.Deployment) []string {
+			names := make([]string, 0)
+			for _, env := range deployment.Spec.Template.Spec.Containers[0].Env {
+				names = append(names, env.Name)
+			}
+			return names
+		}
+
+		It("should run single replica without leader election by default", func() {
+			teamComp := newTeam(0)
+
+			deployment := GetDeployment(scheme, teamComp, "s2h-teamtest", configs).(*appsv1.Deployment)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+			Expect(getEnvNames(deployment)).NotTo(ContainElement("LEADER_ELECTION"))
+
+			service := GetService(scheme, teamComp, "s2h-teamtest").(*corev1.Service)
+			Expect(service.Spec.Selector).NotTo(HaveKey(internal.StagingCtrlLeaderLabel))
+		})
+
+		It("should enable leader election for multiple replicas", func() {
+			teamComp := newTeam(2)
+
+			deployment := GetDeployment(scheme, teamComp, "s2h-teamtest", configs).(*appsv1.Deployment)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(2)))
+			Expect(getEnvNames(deployment)).To(ContainElement("LEADER_ELECTION"))
+			Expect(getEnvNames(deployment)).To(ContainElement("POD_NAME"))
+			Expect(deployment.Spec.Selector.MatchLabels).NotTo(HaveKey(internal.StagingCtrlLeaderLabel))
+
+			service := GetService(scheme, teamComp, "s2h-teamtest").(*corev1.Service)
+			Expect(service.Spec.Selector).To(HaveKeyWithValue(internal.StagingCtrlLeaderLabel, "true"))
+		})
+
+		It("should detect changed replicas", func() {
+			found := GetDeployment(scheme, newTeam(1), "s2h-teamtest", configs)
+			target := GetDeployment(scheme, newTeam(3), "s2h-teamtest", configs)
+
+			Expect(IsK8sObjectChanged(found, target)).To(BeTrue())
+			Expect(*found.(*appsv1.Deployment).Spec.Replicas).To(Equal(int32(3)))
+		})
+	})
 })
@@ -0,0 +1,138 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/queue"
+)
+
+// PinComponent adds a pin queue of the component version to the top of queues,
+// the StableComponent will be pinned after the queue has been verified.
+//
+// Empty repository means using image repository in config.
+// If the version is the same as stable version, the StableComponent will be pinned immediately,
+// waiting queues of the component are removed and nil queue is returned.
+func (c *controller) PinComponent(teamName, compName, repository, version string, skipTestRunner bool,
+	actionBy string) (*s2hv1.Queue, error) {
+
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	comps, err := c.GetConfigController().GetComponents(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	comp, ok := comps[compName]
+	if !ok {
+		return nil, errors.Wrapf(s2herrors.ErrComponentNotFound, "component %s", compName)
+	}
+
+	if repository == "" {
+		repository = comp.Image.Repository
+	}
+
+	namespace := teamComp.Status.Namespace.Staging
+	stableComp := &s2hv1.StableComponent{}
+	err = c.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: comp.Name}, stableComp)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "cannot get stable component %s", comp.Name)
+	} else if err == nil && stableComp.Spec.Repository == repository && stableComp.Spec.Version == version {
+		if stableComp.Spec.Pinned {
+			return nil, errors.Wrapf(s2herrors.ErrQueueActionNotAllowed,
+				"%s is already pinned to %s:%s", comp.Name, repository, version)
+		}
+
+		// stable version has already been verified
+		stableComp.Spec.Pinned = true
+		if err := c.client.Update(context.TODO(), stableComp); err != nil {
+			return nil, errors.Wrapf(err, "cannot pin stable component %s", comp.Name)
+		}
+
+		// new versions of the component are replaced by the pinned version
+		if err := queue.RemoveComponentFromWaitingQueues(c.client, namespace, comp.Name); err != nil {
+			return nil, errors.Wrapf(err, "cannot remove %s from waiting queues", comp.Name)
+		}
+
+		c.sendQueueActionReport(newStablePinReporter(teamName, stableComp, internal.QueueActionPin, actionBy))
+
+		return nil, nil
+	}
+
+	qComps := []*s2hv1.QueueComponent{
+		{
+			Name:       comp.Name,
+			Repository: repository,
+			Version:    version,
+		},
+	}
+	// pin queue is not bundled, other components of the bundle keep their stable versions
+	q := queue.NewQueue(teamName, namespace, comp.Name, "", qComps, s2hv1.QueueTypeUpgrade)
+	q.Spec.Pin = true
+	q.Spec.SkipTestRunner = skipTestRunner
+	if err := queue.New(namespace, c.client).AddTop(q); err != nil {
+		return nil, errors.Wrapf(err, "cannot add pin queue %s", q.Name)
+	}
+
+	c.auditQueueAction(q, internal.QueueActionPin, actionBy)
+
+	return q, nil
+}
+
+// UnpinComponent unpins the StableComponent,
+// the latest desired version of the component will be added to queues again
+func (c *controller) UnpinComponent(teamName, compName, actionBy string) error {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	stableComp := &s2hv1.StableComponent{}
+	key := client.ObjectKey{Namespace: teamComp.Status.Namespace.Staging, Name: compName}
+	if err := c.client.Get(context.TODO(), key, stableComp); err != nil {
+		return err
+	}
+
+	if !stableComp.Spec.Pinned {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed, "%s is not pinned", compName)
+	}
+
+	stableComp.Spec.Pinned = false
+	if err := c.client.Update(context.TODO(), stableComp); err != nil {
+		return errors.Wrapf(err, "cannot unpin stable component %s", compName)
+	}
+
+	c.sendQueueActionReport(newStablePinReporter(teamName, stableComp, internal.QueueActionUnpin, actionBy))
+
+	return nil
+}
+
+func newStablePinReporter(teamName string, stableComp *s2hv1.StableComponent, action internal.QueueAction,
+	actionBy string) *internal.QueueActionReporter {
+
+	return &internal.QueueActionReporter{
+		TeamName:  teamName,
+		QueueName: stableComp.Name,
+		Action:    action,
+		Components: []*s2hv1.QueueComponent{
+			{
+				Name:         stableComp.Spec.Name,
+				Repository:   stableComp.Spec.Repository,
+				Version:      stableComp.Spec.Version,
+				ChartVersion: stableComp.Spec.ChartVersion,
+			},
+		},
+		ActionBy: actionBy,
+		ActionAt: time.Now().UTC().Format("2006-01-02T15:04:05"),
+	}
+}
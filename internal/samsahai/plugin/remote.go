@@ -0,0 +1,259 @@
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/errors"
+	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	pluginrpc "github.com/agoda-com/samsahai/pkg/plugin/rpc"
+)
+
+const (
+	DefaultRemoteTimeout = 60 * time.Second
+
+	authorizationHeader = "Authorization"
+)
+
+// remotePlugin is an out-of-process plugin which serves checker service over HTTP (Twirp) or gRPC
+type remotePlugin struct {
+	name      string
+	endpoint  string
+	timeout   time.Duration
+	authToken string
+	checker   pluginrpc.Checker
+	conn      *grpc.ClientConn
+	logger    s2hlog.Logger
+}
+
+// RemoteOption allows specifying various configuration of remote plugin
+type RemoteOption func(*remotePlugin)
+
+// WithRemoteTimeout specifies a timeout of each request to plugin server
+func WithRemoteTimeout(timeout time.Duration) RemoteOption {
+	return func(p *remotePlugin) {
+		if timeout > 0 {
+			p.timeout = timeout
+		}
+	}
+}
+
+// WithRemoteAuthToken specifies a bearer token for authenticating with plugin server
+func WithRemoteAuthToken(token string) RemoteOption {
+	return func(p *remotePlugin) {
+		p.authToken = token
+	}
+}
+
+// NewRemote creates a plugin which calls checker service of plugin server,
+// endpoint scheme `http` or `https` uses HTTP (Twirp JSON) and `grpc` or `grpcs` uses gRPC.
+//
+// The plugin will be verified by checking name of plugin server with the given name.
+func NewRemote(name, endpoint string, opts ...RemoteOption) (internal.Plugin, error) {
+	p := &remotePlugin{
+		name:     name,
+		endpoint: endpoint,
+		timeout:  DefaultRemoteTimeout,
+		logger:   logger.WithName(name),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid plugin endpoint %s", endpoint)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		p.checker = pluginrpc.NewCheckerJSONClient(strings.TrimSuffix(endpoint, "/"), &http.Client{})
+	case "grpc", "grpcs":
+		dialOpt := grpc.WithInsecure()
+		if u.Scheme == "grpcs" {
+			dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+		}
+
+		p.conn, err = grpc.Dial(u.Host, dialOpt)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot connect to plugin %s", endpoint)
+		}
+		p.checker = &grpcChecker{client: pluginrpc.NewCheckerClient(p.conn)}
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of plugin endpoint, expected http, https, grpc or grpcs",
+			u.Scheme)
+	}
+
+	if err := p.verify(); err != nil {
+		_ = p.Close()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *remotePlugin) GetName() string {
+	return p.name
+}
+
+func (p *remotePlugin) GetVersion(repository, name, pattern string) (string, error) {
+	ctx, cancel := p.newContext()
+	defer cancel()
+
+	version, err := p.checker.GetVersion(ctx, &pluginrpc.VersionRequest{
+		Repository: repository,
+		Name:       name,
+		Pattern:    pattern,
+	})
+	if err != nil {
+		return pattern, p.convertError(ctx, err)
+	}
+
+	return version.Version, nil
+}
+
+func (p *remotePlugin) EnsureVersion(repository, name, version string) error {
+	ctx, cancel := p.newContext()
+	defer cancel()
+
+	_, err := p.checker.EnsureVersion(ctx, &pluginrpc.EnsureVersionRequest{
+		Repository: repository,
+		Name:       name,
+		Version:    version,
+	})
+	if err != nil {
+		return p.convertError(ctx, err)
+	}
+
+	return nil
+}
+
+func (p *remotePlugin) GetComponentName(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	ctx, cancel := p.newContext()
+	defer cancel()
+
+	compName, err := p.checker.GetComponentName(ctx, &pluginrpc.Name{Name: name})
+	if err != nil {
+		p.logger.Warn(fmt.Sprintf("get-component error: %v", p.convertError(ctx, err)), "component", name)
+		return name
+	}
+
+	return compName.Name
+}
+
+// Close closes connection to plugin server
+func (p *remotePlugin) Close() error {
+	if p.conn == nil {
+		return nil
+	}
+
+	return p.conn.Close()
+}
+
+func (p *remotePlugin) verify() error {
+	ctx, cancel := p.newContext()
+	defer cancel()
+
+	name, err := p.checker.GetName(ctx, &pluginrpc.Empty{})
+	if err != nil {
+		return errors.Wrapf(p.convertError(ctx, err), "cannot get name of plugin %s", p.endpoint)
+	}
+
+	if name.Name != p.name {
+		return fmt.Errorf("plugin name mismatched, expected: %s, actual: %s", p.name, name.Name)
+	}
+
+	return nil
+}
+
+func (p *remotePlugin) newContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	if p.authToken == "" {
+		return ctx, cancel
+	}
+
+	bearer := "Bearer " + p.authToken
+	if p.conn != nil {
+		return metadata.AppendToOutgoingContext(ctx, authorizationHeader, bearer), cancel
+	}
+
+	headers := make(http.Header)
+	headers.Set(authorizationHeader, bearer)
+	ctx, err := twirp.WithHTTPRequestHeaders(ctx, headers)
+	if err != nil {
+		p.logger.Error(err, "cannot set request header")
+	}
+
+	return ctx, cancel
+}
+
+// convertError converts status code of Twirp or gRPC into typed error
+func (p *remotePlugin) convertError(ctx context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return errors.ErrRequestTimeout
+	}
+
+	var code codes.Code
+	if twerr, ok := err.(twirp.Error); ok {
+		switch twerr.Code() {
+		case twirp.NotFound:
+			code = codes.NotFound
+		case twirp.DeadlineExceeded:
+			code = codes.DeadlineExceeded
+		case twirp.Unauthenticated, twirp.PermissionDenied:
+			code = codes.Unauthenticated
+		}
+	} else if s, ok := status.FromError(err); ok {
+		code = s.Code()
+	}
+
+	switch code {
+	case codes.NotFound:
+		return errors.ErrImageVersionNotFound
+	case codes.DeadlineExceeded:
+		return errors.ErrRequestTimeout
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return errors.ErrUnauthorized
+	default:
+		return err
+	}
+}
+
+// grpcChecker adapts gRPC client to checker service
+type grpcChecker struct {
+	client pluginrpc.CheckerClient
+}
+
+func (c *grpcChecker) GetName(ctx context.Context, in *pluginrpc.Empty) (*pluginrpc.Name, error) {
+	return c.client.GetName(ctx, in)
+}
+
+func (c *grpcChecker) GetVersion(ctx context.Context, in *pluginrpc.VersionRequest) (*pluginrpc.Version, error) {
+	return c.client.GetVersion(ctx, in)
+}
+
+func (c *grpcChecker) EnsureVersion(ctx context.Context, in *pluginrpc.EnsureVersionRequest) (*pluginrpc.Empty, error) {
+	return c.client.EnsureVersion(ctx, in)
+}
+
+func (c *grpcChecker) GetComponentName(ctx context.Context, in *pluginrpc.Name) (*pluginrpc.Name, error) {
+	return c.client.GetComponentName(ctx, in)
+}
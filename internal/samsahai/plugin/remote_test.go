@@ -0,0 +1,187 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/twitchtv/twirp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/agoda-com/samsahai/internal/errors"
+	pluginrpc "github.com/agoda-com/samsahai/pkg/plugin/rpc"
+)
+
+var _ = Describe("Remote Plugin", func() {
+	g := NewWithT(GinkgoT())
+
+	pluginName := "remote-example"
+
+	Describe("HTTP", func() {
+		var server *httptest.Server
+		var authHeader string
+
+		BeforeEach(func() {
+			authHeader = ""
+			handler := pluginrpc.NewCheckerServer(&mockCheckerServer{name: pluginName, useTwirp: true})
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authHeader = r.Header.Get("Authorization")
+				handler.ServeHTTP(w, r)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should successfully get version with auth token", func() {
+			plugin, err := NewRemote(pluginName, server.URL, WithRemoteAuthToken("secret"))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(plugin.GetName()).To(Equal(pluginName))
+
+			version, err := plugin.GetVersion("repo", "example", "0\\.1\\..*")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(version).To(Equal("0.1.1"))
+			g.Expect(authHeader).To(Equal("Bearer secret"))
+
+			g.Expect(plugin.EnsureVersion("repo", "example", "0.1.1")).To(Succeed())
+			g.Expect(plugin.GetComponentName("example-app")).To(Equal("example"))
+		})
+
+		It("should return typed errors", func() {
+			plugin, err := NewRemote(pluginName, server.URL, WithRemoteTimeout(1*time.Second))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			_, err = plugin.GetVersion("repo", "missing", "")
+			g.Expect(err).To(Equal(errors.ErrImageVersionNotFound))
+
+			err = plugin.EnsureVersion("repo", "timeout", "0.1.1")
+			g.Expect(err).To(Equal(errors.ErrRequestTimeout))
+		})
+
+		It("should fail to register plugin with mismatched name", func() {
+			_, err := NewRemote("other", server.URL)
+			g.Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("gRPC", func() {
+		var server *grpc.Server
+		var endpoint string
+
+		BeforeEach(func() {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			g.Expect(err).NotTo(HaveOccurred())
+
+			server = grpc.NewServer()
+			pluginrpc.RegisterCheckerServer(server, &mockCheckerServer{name: pluginName, token: "secret"})
+			go func() {
+				_ = server.Serve(lis)
+			}()
+
+			endpoint = "grpc://" + lis.Addr().String()
+		})
+
+		AfterEach(func() {
+			server.Stop()
+		})
+
+		It("should successfully get version with auth token", func() {
+			plugin, err := NewRemote(pluginName, endpoint, WithRemoteAuthToken("secret"))
+			g.Expect(err).NotTo(HaveOccurred())
+			defer plugin.(*remotePlugin).Close()
+
+			version, err := plugin.GetVersion("repo", "example", "")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(version).To(Equal("0.3.0"))
+
+			_, err = plugin.GetVersion("repo", "missing", "")
+			g.Expect(err).To(Equal(errors.ErrImageVersionNotFound))
+		})
+
+		It("should fail to register plugin without valid auth token", func() {
+			_, err := NewRemote(pluginName, endpoint, WithRemoteAuthToken("invalid"))
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(errors.ErrUnauthorized.Error()))
+		})
+	})
+
+	It("should fail with unsupported endpoint scheme", func() {
+		_, err := NewRemote(pluginName, "tcp://127.0.0.1:8080")
+		g.Expect(err).To(HaveOccurred())
+	})
+})
+
+// mockCheckerServer serves checker service for both Twirp and gRPC
+type mockCheckerServer struct {
+	name     string
+	token    string
+	useTwirp bool
+}
+
+func (s *mockCheckerServer) GetName(ctx context.Context, _ *pluginrpc.Empty) (*pluginrpc.Name, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	return &pluginrpc.Name{Name: s.name}, nil
+}
+
+func (s *mockCheckerServer) GetVersion(ctx context.Context, req *pluginrpc.VersionRequest) (*pluginrpc.Version, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if req.Name == "missing" {
+		return nil, s.notFound()
+	}
+
+	switch req.Pattern {
+	case "0\\.1\\..*":
+		return &pluginrpc.Version{Version: "0.1.1"}, nil
+	default:
+		return &pluginrpc.Version{Version: "0.3.0"}, nil
+	}
+}
+
+func (s *mockCheckerServer) EnsureVersion(ctx context.Context, req *pluginrpc.EnsureVersionRequest) (*pluginrpc.Empty, error) {
+	if req.Name == "timeout" {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	return &pluginrpc.Empty{}, nil
+}
+
+func (s *mockCheckerServer) GetComponentName(_ context.Context, req *pluginrpc.Name) (*pluginrpc.Name, error) {
+	return &pluginrpc.Name{Name: strings.TrimSuffix(req.Name, "-app")}, nil
+}
+
+func (s *mockCheckerServer) authorize(ctx context.Context) error {
+	if s.token == "" {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get("authorization"); len(tokens) == 0 || tokens[0] != "Bearer "+s.token {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return nil
+}
+
+func (s *mockCheckerServer) notFound() error {
+	if s.useTwirp {
+		return twirp.NotFoundError("version not found")
+	}
+
+	return status.Error(codes.NotFound, "version not found")
+}
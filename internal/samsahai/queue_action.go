@@ -0,0 +1,253 @@
+package samsahai
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/queue"
+)
+
+// VerifyAuthToken verifies the given token with samsahai internal auth token
+func (c *controller) VerifyAuthToken(token string) bool {
+	authToken := c.configs.SamsahaiCredential.InternalAuthToken
+	if authToken == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(authToken)) == 1
+}
+
+// MoveQueueToTop moves the waiting queue to the top of queues
+func (c *controller) MoveQueueToTop(teamName, queueName, actionBy string) error {
+	q, err := c.getTeamQueue(teamName, queueName)
+	if err != nil {
+		return err
+	}
+
+	if !isQueueManageable(q) || q.Status.State != s2hv1.Waiting {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed, "queue %s is %s", q.Name, q.Status.State)
+	}
+
+	queueList, err := c.GetQueues(q.Namespace)
+	if err != nil {
+		return err
+	}
+
+	q.Spec.NoOfOrder = queueList.TopQueueOrder()
+	if err := c.client.Update(context.TODO(), q); err != nil {
+		return errors.Wrapf(err, "cannot update queue %s", q.Name)
+	}
+
+	c.auditQueueAction(q, internal.QueueActionMoveTop, actionBy)
+
+	return nil
+}
+
+// MoveQueueToBottom moves the waiting queue to the bottom of queues
+func (c *controller) MoveQueueToBottom(teamName, queueName, actionBy string) error {
+	q, err := c.getTeamQueue(teamName, queueName)
+	if err != nil {
+		return err
+	}
+
+	if !isQueueManageable(q) || q.Status.State != s2hv1.Waiting {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed, "queue %s is %s", q.Name, q.Status.State)
+	}
+
+	if err := queue.New(q.Namespace, c.client).SetLastOrder(q); err != nil {
+		return errors.Wrapf(err, "cannot set last order of queue %s", q.Name)
+	}
+
+	c.auditQueueAction(q, internal.QueueActionMoveBottom, actionBy)
+
+	return nil
+}
+
+// CancelQueue cancels the waiting or running queue,
+// the running queue will be stopped by the staging controller
+func (c *controller) CancelQueue(teamName, queueName, actionBy string) error {
+	q, err := c.getTeamQueue(teamName, queueName)
+	if err != nil {
+		return err
+	}
+
+	if !isQueueManageable(q) || q.Status.State == s2hv1.Finished {
+		return errors.Wrapf(s2herrors.ErrQueueActionNotAllowed, "queue %s is %s", q.Name, q.Status.State)
+	}
+
+	if err := queue.New(q.Namespace, c.client).Remove(q); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "cannot remove queue %s", q.Name)
+	}
+
+	c.auditQueueAction(q, internal.QueueActionCancel, actionBy)
+
+	return nil
+}
+
+// RetryQueue adds the latest failed component upgrade queue back to the bottom of queues
+func (c *controller) RetryQueue(teamName, actionBy string) (*s2hv1.Queue, error) {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	namespace := teamComp.Status.Namespace.Staging
+	histories, err := c.GetQueueHistories(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	histories.SortDESC()
+
+	var failed *s2hv1.Queue
+	for _, history := range histories.Items {
+		hq := history.Spec.Queue
+		if hq == nil || !hq.IsComponentUpgradeQueue() ||
+			(history.Spec.IsDeploySuccess && history.Spec.IsTestSuccess) {
+			continue
+		}
+
+		failed = hq
+		break
+	}
+
+	if failed == nil {
+		return nil, s2herrors.ErrNoFailedQueueHistory
+	}
+
+	now := metav1.Now()
+	q := queue.NewQueue(teamName, namespace, failed.Spec.Name, failed.Spec.Bundle, failed.Spec.Components,
+		s2hv1.QueueTypeUpgrade)
+	q.Status = s2hv1.QueueStatus{
+		CreatedAt: &now,
+		UpdatedAt: &now,
+		State:     s2hv1.Waiting,
+	}
+	if err := c.client.Create(context.TODO(), q); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return nil, errors.Wrapf(s2herrors.ErrQueueActionNotAllowed, "queue %s already exists", q.Name)
+		}
+		return nil, errors.Wrapf(err, "cannot create queue %s", q.Name)
+	}
+
+	if err := queue.New(namespace, c.client).SetRetryQueue(q, 0, now.Time, nil, nil, nil); err != nil {
+		return nil, errors.Wrapf(err, "cannot set retry queue %s", q.Name)
+	}
+
+	c.auditQueueAction(q, internal.QueueActionRetry, actionBy)
+
+	return q, nil
+}
+
+// EnqueueComponent adds a specific component version to the top of queues
+func (c *controller) EnqueueComponent(teamName, compName, version string, skipTestRunner bool, actionBy string) (
+	*s2hv1.Queue, error) {
+
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	comps, err := c.GetConfigController().GetComponents(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	comp, ok := comps[compName]
+	if !ok {
+		return nil, errors.Wrapf(s2herrors.ErrComponentNotFound, "component %s", compName)
+	}
+
+	namespace := teamComp.Status.Namespace.Staging
+	stableComp := &s2hv1.StableComponent{}
+	err = c.client.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: comp.Name}, stableComp)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "cannot get stable component %s", comp.Name)
+	} else if err == nil && stableComp.Spec.Repository == comp.Image.Repository && stableComp.Spec.Version == version {
+		return nil, errors.Wrapf(s2herrors.ErrQueueActionNotAllowed,
+			"%s:%s is the same as stable version", comp.Image.Repository, version)
+	}
+
+	qComps := []*s2hv1.QueueComponent{
+		{
+			Name:       comp.Name,
+			Repository: comp.Image.Repository,
+			Version:    version,
+		},
+	}
+	q := queue.NewQueue(teamName, namespace, comp.Name, c.getBundleName(comp.Name, teamName), qComps,
+		s2hv1.QueueTypeUpgrade)
+	q.Spec.SkipTestRunner = skipTestRunner
+	if err := queue.New(namespace, c.client).AddTop(q); err != nil {
+		return nil, errors.Wrapf(err, "cannot add queue %s", q.Name)
+	}
+
+	// component might be added into the existing queue
+	fetched, err := c.getTeamQueue(teamName, q.Name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		fetched = q
+	}
+
+	if skipTestRunner && !fetched.Spec.SkipTestRunner {
+		fetched.Spec.SkipTestRunner = true
+		if err := c.client.Update(context.TODO(), fetched); err != nil {
+			return nil, errors.Wrapf(err, "cannot update queue %s", fetched.Name)
+		}
+	}
+
+	c.auditQueueAction(fetched, internal.QueueActionEnqueue, actionBy)
+
+	return fetched, nil
+}
+
+func (c *controller) getTeamQueue(teamName, queueName string) (*s2hv1.Queue, error) {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	q := &s2hv1.Queue{}
+	key := client.ObjectKey{Namespace: teamComp.Status.Namespace.Staging, Name: queueName}
+	if err := c.client.Get(context.TODO(), key, q); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// auditQueueAction logs and reports the action which is applied to the queue manually
+func (c *controller) auditQueueAction(q *s2hv1.Queue, action internal.QueueAction, actionBy string) {
+	actionAt := time.Now().UTC().Format("2006-01-02T15:04:05")
+	c.sendQueueActionReport(internal.NewQueueActionReporter(q, action, actionBy, actionAt))
+}
+
+func (c *controller) sendQueueActionReport(queueActionRpt *internal.QueueActionReporter) {
+	logger.Info("queue action has been applied", "team", queueActionRpt.TeamName,
+		"queue", queueActionRpt.QueueName, "action", queueActionRpt.Action, "by", queueActionRpt.ActionBy)
+
+	configCtrl := c.GetConfigController()
+	for _, reporter := range c.reporters {
+		if err := reporter.SendQueueAction(configCtrl, queueActionRpt); err != nil {
+			logger.Error(err, "cannot send queue action report", "team", queueActionRpt.TeamName,
+				"queue", queueActionRpt.QueueName, "reporter", reporter.GetName())
+		}
+	}
+}
+
+// isQueueManageable returns true if the queue can be managed manually,
+// queues of active promotion are managed by the active promotion
+func isQueueManageable(q *s2hv1.Queue) bool {
+	return q.IsComponentUpgradeQueue() || q.IsReverify()
+}
@@ -0,0 +1,88 @@
+package samsahai
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/queue"
+	"github.com/agoda-com/samsahai/internal/samsahai/exporter"
+)
+
+// exportQueueWaitMetric exports waiting time of team queues and
+// reports the queues which have just exceeded the maximum waiting time of the team
+func (c *controller) exportQueueWaitMetric(teamList *s2hv1.TeamList, now time.Time) {
+	waitingQueues := make([]s2hv1.Queue, 0)
+	for _, teamComp := range teamList.Items {
+		namespace := teamComp.Status.Namespace.Staging
+		if namespace == "" {
+			continue
+		}
+
+		queueList, err := c.GetQueues(namespace)
+		if err != nil {
+			logger.Error(err, "cannot list queues", "team", teamComp.Name)
+			continue
+		}
+
+		ageing := c.getQueueAgeing(teamComp.Name)
+		for i := range queueList.Items {
+			q := &queueList.Items[i]
+			if q.Status.State != s2hv1.Waiting || !isQueueManageable(q) {
+				continue
+			}
+
+			waitingQueues = append(waitingQueues, *q)
+
+			if q.Status.WaitSLOBreachedAt != nil || !queue.IsQueueWaitSLOBreached(q, ageing, now) {
+				continue
+			}
+
+			q.Status.WaitSLOBreachedAt = &metav1.Time{Time: now}
+			if err := c.client.Update(context.TODO(), q); err != nil {
+				logger.Error(err, "cannot update queue", "team", teamComp.Name, "queue", q.Name)
+				continue
+			}
+
+			exporter.AddQueueWaitSLOBreachedMetric(teamComp.Name)
+			if ageing.Report {
+				breachedAt := now.UTC().Format("2006-01-02T15:04:05")
+				c.sendQueueWaitSLOReport(internal.NewQueueWaitSLOReporter(q, queue.GetQueueWaitTime(q, now),
+					ageing.MaxWaitTime.Duration, breachedAt))
+			}
+		}
+	}
+
+	exporter.SetQueueWaitTimeMetric(waitingQueues, now)
+}
+
+func (c *controller) sendQueueWaitSLOReport(queueWaitSLORpt *internal.QueueWaitSLOReporter) {
+	logger.Info("queue has exceeded the maximum waiting time", "team", queueWaitSLORpt.TeamName,
+		"queue", queueWaitSLORpt.QueueName, "waitTime", queueWaitSLORpt.WaitTime)
+
+	configCtrl := c.GetConfigController()
+	for _, reporter := range c.reporters {
+		if err := reporter.SendQueueWaitSLOBreached(configCtrl, queueWaitSLORpt); err != nil {
+			logger.Error(err, "cannot send queue wait-time SLO report", "team", queueWaitSLORpt.TeamName,
+				"queue", queueWaitSLORpt.QueueName, "reporter", reporter.GetName())
+		}
+	}
+}
+
+// getQueueAgeing returns configuration about promoting waiting queues of the team
+func (c *controller) getQueueAgeing(teamName string) *s2hv1.ConfigQueueAgeing {
+	config, err := c.GetConfigController().Get(teamName)
+	if err != nil {
+		logger.Error(err, "cannot get configuration", "team", teamName)
+		return nil
+	}
+
+	if config.Status.Used.Staging == nil {
+		return nil
+	}
+
+	return config.Status.Used.Staging.QueueAgeing
+}
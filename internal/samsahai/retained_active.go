@@ -0,0 +1,297 @@
+package samsahai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+	"github.com/agoda-com/samsahai/internal/queue"
+)
+
+const retainedReplicasAnnotation = "samsahai.io/retained-replicas"
+
+// RetainPreviousActiveEnvironment scales down all workloads of the previous active namespace
+// and moves the namespace from previous active to retained actives of team status
+func (c *controller) RetainPreviousActiveEnvironment(teamName, namespace string) error {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return err
+	}
+
+	if err := c.scaleDownEnvironment(namespace); err != nil {
+		return errors.Wrapf(err, "cannot scale down previous active namespace %s", namespace)
+	}
+
+	teamNsOpts := []TeamNamespaceStatusOption{withTeamRetainedActiveNamespaceStatus(namespace)}
+	if teamComp.Status.Namespace.PreviousActive == namespace {
+		teamNsOpts = append(teamNsOpts, withTeamPreviousActiveNamespaceStatus(namespace, true))
+		teamComp.Status.SetCondition(
+			s2hv1.TeamNamespacePreviousActiveCreated,
+			corev1.ConditionFalse,
+			"previous active namespace is reset")
+	}
+
+	teamComp.Status.SetCondition(
+		getRetainedActiveNamespaceCreatedConditionType(namespace),
+		corev1.ConditionTrue,
+		fmt.Sprintf("%s namespace is scaled down and retained for rollback", namespace))
+
+	if err := c.updateTeamNamespacesStatus(teamComp, teamNsOpts...); err != nil {
+		return errors.Wrap(err, "cannot update team conditions when retain previous active")
+	}
+
+	logger.Info("previous active namespace has been retained", "team", teamName, "namespace", namespace)
+
+	return nil
+}
+
+// RollbackActiveEnvironment scales up the retained previous active namespace at the given position,
+// promotes it back to active and switches it to active, the current active namespace is scaled down and retained instead.
+// The retained namespace has been demoted from active, ErrActiveEnvironmentRollbackInProgress will be returned
+// until its components have been deployed with active configuration again, so the rollback should be called again
+func (c *controller) RollbackActiveEnvironment(teamName string, position int, rolledBackBy string) (*s2hv1.Team, error) {
+	teamComp := &s2hv1.Team{}
+	if err := c.getTeam(teamName, teamComp); err != nil {
+		return nil, err
+	}
+
+	if atp, err := c.GetActivePromotion(teamName); err == nil {
+		if atp.Status.State != s2hv1.ActivePromotionWaiting {
+			return nil, errors.Wrapf(s2herrors.ErrActiveEnvironmentRollbackNotAllowed,
+				"active promotion of team %s is %s", teamName, atp.Status.State)
+		}
+	} else if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	retainedNamespaces := teamComp.Status.Namespace.RetainedActives
+	if position < 1 || position > len(retainedNamespaces) {
+		return nil, errors.Wrapf(s2herrors.ErrRetainedActiveNamespaceNotFound,
+			"team %s has %d retained previous active namespaces", teamName, len(retainedNamespaces))
+	}
+
+	targetNs := retainedNamespaces[position-1]
+	currentNs := teamComp.Status.Namespace.Active
+
+	if err := c.scaleUpEnvironment(targetNs); err != nil {
+		return nil, errors.Wrapf(err, "cannot scale up retained namespace %s", targetNs)
+	}
+
+	if err := c.ensureRetainedActivePromoted(teamName, targetNs); err != nil {
+		return nil, err
+	}
+
+	comps, err := c.getStableComponentsMap(targetNs)
+	if err != nil {
+		return nil, err
+	}
+
+	teamNsOpts := []TeamNamespaceStatusOption{
+		withTeamRetainedActiveNamespaceStatus(targetNs, true),
+		withTeamActiveNamespaceStatus(targetNs, rolledBackBy),
+	}
+	teamComp.Status.SetCondition(
+		getRetainedActiveNamespaceCreatedConditionType(targetNs),
+		corev1.ConditionFalse,
+		fmt.Sprintf("%s namespace is switched to active", targetNs))
+	teamComp.Status.SetCondition(
+		s2hv1.TeamNamespaceActiveCreated,
+		corev1.ConditionTrue,
+		fmt.Sprintf("%s namespace is rolled back to active", targetNs))
+
+	if currentNs != "" && currentNs != targetNs {
+		if err := c.scaleDownEnvironment(currentNs); err != nil {
+			return nil, errors.Wrapf(err, "cannot scale down active namespace %s", currentNs)
+		}
+
+		teamNsOpts = append(teamNsOpts, withTeamRetainedActiveNamespaceStatus(currentNs))
+		teamComp.Status.SetCondition(
+			getRetainedActiveNamespaceCreatedConditionType(currentNs),
+			corev1.ConditionTrue,
+			fmt.Sprintf("%s namespace is scaled down and retained for rollback", currentNs))
+	}
+
+	teamComp.Status.SetActiveComponents(comps)
+	if err := c.updateTeamNamespacesStatus(teamComp, teamNsOpts...); err != nil {
+		return nil, errors.Wrap(err, "cannot update team conditions when rollback active")
+	}
+
+	if err := c.SwitchActiveGateway(teamName, targetNs); err != nil {
+		return nil, errors.Wrapf(err, "cannot switch active gateway to namespace %s", targetNs)
+	}
+
+	logger.Info("active namespace has been rolled back",
+		"team", teamName, "namespace", targetNs, "previousNamespace", currentNs, "rolledBackBy", rolledBackBy)
+
+	return teamComp, nil
+}
+
+// ensureRetainedActivePromoted deploys components of the retained namespace with active configuration again
+// as they have been demoted from active before being retained
+func (c *controller) ensureRetainedActivePromoted(teamName, namespace string) error {
+	if err := queue.DeleteDemoteFromActiveQueue(c.client, namespace); err != nil {
+		return err
+	}
+
+	q, err := queue.EnsurePromoteToActiveComponents(c.client, teamName, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "cannot ensure retained namespace %s promoted to active", namespace)
+	}
+
+	if q.Status.State != s2hv1.Finished {
+		return errors.Wrapf(s2herrors.ErrActiveEnvironmentRollbackInProgress, "namespace %s", namespace)
+	}
+
+	// the queue is removed so that the namespace can be promoted again on the next rollback
+	if err := queue.DeletePromoteToActiveQueue(c.client, namespace); err != nil {
+		return err
+	}
+
+	if !q.IsDeploySuccess() {
+		return errors.Wrapf(s2herrors.ErrReleaseFailed, "cannot promote retained namespace %s to active", namespace)
+	}
+
+	return nil
+}
+
+func (c *controller) getStableComponentsMap(namespace string) (map[string]s2hv1.StableComponent, error) {
+	stableList := &s2hv1.StableComponentList{}
+	if err := c.client.List(context.TODO(), stableList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, errors.Wrapf(err, "cannot list stable components of namespace %s", namespace)
+	}
+
+	comps := make(map[string]s2hv1.StableComponent)
+	for _, comp := range stableList.Items {
+		comps[comp.Name] = comp
+	}
+
+	return comps, nil
+}
+
+// scaleDownEnvironment scales all deployments and statefulsets of the namespace to zero,
+// the original replicas are kept in the annotation for scaling up
+func (c *controller) scaleDownEnvironment(namespace string) error {
+	ctx := context.TODO()
+	listOpt := &client.ListOptions{Namespace: namespace}
+
+	deployList := &appsv1.DeploymentList{}
+	if err := c.client.List(ctx, deployList, listOpt); err != nil {
+		return err
+	}
+	for i := range deployList.Items {
+		deploy := &deployList.Items[i]
+		if !retainReplicas(&deploy.ObjectMeta, &deploy.Spec.Replicas) {
+			continue
+		}
+		if err := c.client.Update(ctx, deploy); err != nil {
+			return errors.Wrapf(err, "cannot scale down deployment %s", deploy.Name)
+		}
+	}
+
+	stsList := &appsv1.StatefulSetList{}
+	if err := c.client.List(ctx, stsList, listOpt); err != nil {
+		return err
+	}
+	for i := range stsList.Items {
+		sts := &stsList.Items[i]
+		if !retainReplicas(&sts.ObjectMeta, &sts.Spec.Replicas) {
+			continue
+		}
+		if err := c.client.Update(ctx, sts); err != nil {
+			return errors.Wrapf(err, "cannot scale down statefulset %s", sts.Name)
+		}
+	}
+
+	return nil
+}
+
+// scaleUpEnvironment scales all deployments and statefulsets of the namespace back to their retained replicas
+func (c *controller) scaleUpEnvironment(namespace string) error {
+	ctx := context.TODO()
+	listOpt := &client.ListOptions{Namespace: namespace}
+
+	deployList := &appsv1.DeploymentList{}
+	if err := c.client.List(ctx, deployList, listOpt); err != nil {
+		return err
+	}
+	for i := range deployList.Items {
+		deploy := &deployList.Items[i]
+		if !restoreReplicas(&deploy.ObjectMeta, &deploy.Spec.Replicas) {
+			continue
+		}
+		if err := c.client.Update(ctx, deploy); err != nil {
+			return errors.Wrapf(err, "cannot scale up deployment %s", deploy.Name)
+		}
+	}
+
+	stsList := &appsv1.StatefulSetList{}
+	if err := c.client.List(ctx, stsList, listOpt); err != nil {
+		return err
+	}
+	for i := range stsList.Items {
+		sts := &stsList.Items[i]
+		if !restoreReplicas(&sts.ObjectMeta, &sts.Spec.Replicas) {
+			continue
+		}
+		if err := c.client.Update(ctx, sts); err != nil {
+			return errors.Wrapf(err, "cannot scale up statefulset %s", sts.Name)
+		}
+	}
+
+	return nil
+}
+
+// retainReplicas keeps the current replicas in the annotation and sets replicas to zero,
+// returns false if the workload has been already scaled down
+func retainReplicas(meta *metav1.ObjectMeta, replicas **int32) bool {
+	if _, ok := meta.Annotations[retainedReplicasAnnotation]; ok {
+		return false
+	}
+
+	current := int32(1)
+	if *replicas != nil {
+		current = **replicas
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[retainedReplicasAnnotation] = strconv.Itoa(int(current))
+
+	zero := int32(0)
+	*replicas = &zero
+
+	return true
+}
+
+// restoreReplicas sets replicas back from the annotation and removes the annotation,
+// returns false if the workload has not been scaled down
+func restoreReplicas(meta *metav1.ObjectMeta, replicas **int32) bool {
+	retained, ok := meta.Annotations[retainedReplicasAnnotation]
+	if !ok {
+		return false
+	}
+
+	delete(meta.Annotations, retainedReplicasAnnotation)
+
+	current, err := strconv.Atoi(retained)
+	if err != nil {
+		logger.Warn("invalid retained replicas, restore to 1 replica",
+			"namespace", meta.Namespace, "name", meta.Name, "replicas", retained)
+		current = 1
+	}
+
+	restored := int32(current)
+	*replicas = &restored
+
+	return true
+}
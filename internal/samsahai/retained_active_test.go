@@ -0,0 +1,95 @@
+package samsahai
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+var _ = Describe("S2H retained active environment", func() {
+	g := NewWithT(GinkgoT())
+
+	It("should scale workload down and restore its replicas", func() {
+		meta := metav1.ObjectMeta{Name: "wordpress", Namespace: "s2h-teamtest-abc123"}
+		three := int32(3)
+		replicas := &three
+
+		g.Expect(retainReplicas(&meta, &replicas)).To(BeTrue())
+		g.Expect(*replicas).To(Equal(int32(0)))
+		g.Expect(meta.Annotations).To(HaveKeyWithValue(retainedReplicasAnnotation, "3"))
+
+		By("scaling down again should keep the original replicas")
+		g.Expect(retainReplicas(&meta, &replicas)).To(BeFalse())
+		g.Expect(meta.Annotations).To(HaveKeyWithValue(retainedReplicasAnnotation, "3"))
+
+		g.Expect(restoreReplicas(&meta, &replicas)).To(BeTrue())
+		g.Expect(*replicas).To(Equal(int32(3)))
+		g.Expect(meta.Annotations).NotTo(HaveKey(retainedReplicasAnnotation))
+
+		By("scaling up workload which has not been scaled down")
+		g.Expect(restoreReplicas(&meta, &replicas)).To(BeFalse())
+		g.Expect(*replicas).To(Equal(int32(3)))
+	})
+
+	It("should retain default replicas if replicas is not set", func() {
+		meta := metav1.ObjectMeta{Name: "redis"}
+		var replicas *int32
+
+		g.Expect(retainReplicas(&meta, &replicas)).To(BeTrue())
+		g.Expect(meta.Annotations).To(HaveKeyWithValue(retainedReplicasAnnotation, "1"))
+	})
+
+	It("should keep the latest retained active namespace first", func() {
+		teamComp := &s2hv1.Team{}
+		teamComp.Status.Namespace.RetainedActives = []string{"s2h-teamtest-b", "s2h-teamtest-a"}
+
+		withTeamRetainedActiveNamespaceStatus("s2h-teamtest-c")(teamComp)
+		g.Expect(teamComp.Status.Namespace.RetainedActives).To(
+			Equal([]string{"s2h-teamtest-c", "s2h-teamtest-b", "s2h-teamtest-a"}))
+
+		withTeamRetainedActiveNamespaceStatus("s2h-teamtest-a")(teamComp)
+		g.Expect(teamComp.Status.Namespace.RetainedActives).To(
+			Equal([]string{"s2h-teamtest-a", "s2h-teamtest-c", "s2h-teamtest-b"}))
+
+		_, _, condType := withTeamRetainedActiveNamespaceStatus("s2h-teamtest-c", true)(teamComp)
+		g.Expect(teamComp.Status.Namespace.RetainedActives).To(Equal([]string{"s2h-teamtest-a", "s2h-teamtest-b"}))
+		g.Expect(condType).To(Equal(s2hv1.TeamNamespaceRetainedActiveCreated + "-s2h-teamtest-c"))
+	})
+
+	It("should promote retained namespace to active again before rolling back", func() {
+		ns := "s2h-teamtest-abc123"
+		scheme := runtime.NewScheme()
+		g.Expect(s2hv1.AddToScheme(scheme)).To(Succeed())
+		demoteQueue := &s2hv1.Queue{ObjectMeta: metav1.ObjectMeta{Name: string(s2hv1.EnvDeActive), Namespace: ns}}
+		c := &controller{client: fake.NewFakeClientWithScheme(scheme, demoteQueue)}
+
+		err := c.ensureRetainedActivePromoted("teamtest", ns)
+		g.Expect(s2herrors.Is(err, s2herrors.ErrActiveEnvironmentRollbackInProgress)).To(BeTrue())
+
+		key := types.NamespacedName{Name: string(s2hv1.EnvActive), Namespace: ns}
+		q := &s2hv1.Queue{}
+		g.Expect(c.client.Get(context.TODO(), key, q)).To(Succeed())
+		g.Expect(q.Spec.Type).To(Equal(s2hv1.QueueTypePromoteToActive))
+
+		err = c.client.Get(context.TODO(), types.NamespacedName{Name: demoteQueue.Name, Namespace: ns}, &s2hv1.Queue{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue(), "demote queue should be deleted")
+
+		q.Status.State = s2hv1.Finished
+		q.Status.SetCondition(s2hv1.QueueDeployed, corev1.ConditionTrue, "deployed")
+		g.Expect(c.client.Update(context.TODO(), q)).To(Succeed())
+
+		g.Expect(c.ensureRetainedActivePromoted("teamtest", ns)).To(Succeed())
+		err = c.client.Get(context.TODO(), key, &s2hv1.Queue{})
+		g.Expect(k8serrors.IsNotFound(err)).To(BeTrue(), "promote queue should be deleted after promoted")
+	})
+})
@@ -689,7 +689,7 @@ func (c *controller) getImageSource(comps map[string]*s2hv1.Component, name stri
 	if source == nil {
 		return nil, false
 	}
-	if _, ok := c.checkers[string(*source)]; !ok {
+	if _, err := c.getComponentChecker(string(*source)); err != nil {
 		// ignore non-existing source
 		return nil, false
 	}
@@ -713,8 +713,12 @@ func (c *controller) sendDeploymentQueueReport(queueHistName string, queue *s2hv
 
 	for _, reporter := range c.reporters {
 		testRunner := s2hv1.TestRunner{}
+		isPinned := false
+		var testStages []s2hv1.QueueTestStage
 		if queue != nil {
 			testRunner = queue.Status.TestRunner
+			isPinned = queue.Spec.Pin
+			testStages = queue.Status.TestStages
 		}
 
 		upgradeComp := s2h.NewComponentUpgradeReporter(
@@ -724,6 +728,8 @@ func (c *controller) sendDeploymentQueueReport(queueHistName string, queue *s2hv
 			s2h.WithQueueHistoryName(queueHistName),
 			s2h.WithNamespace(comp.PullRequestNamespace),
 			s2h.WithComponentUpgradeOptCredential(teamComp.Status.Used.Credential),
+			s2h.WithPinned(isPinned),
+			s2h.WithTestStages(testStages),
 		)
 
 		if comp.PullRequestComponent != nil && comp.PullRequestComponent.PRNumber != "" {
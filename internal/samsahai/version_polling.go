@@ -0,0 +1,184 @@
+package samsahai
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal/errors"
+)
+
+const (
+	defaultVersionPollingInterval     = 30 * time.Second
+	defaultVersionPollingConcurrences = 5
+)
+
+// pollVersion triggers evaluating `Component.Schedules` of every team
+type pollVersion struct {
+}
+
+// pollScheduledComponentVersions checks scheduled components of every team
+// and adds the due components to queue for checking new version.
+//
+// No. of polled components per round is limited by `VersionPolling.Concurrences`,
+// the rest will be polled in the next round.
+func (c *controller) pollScheduledComponentVersions() error {
+	defer c.queue.AddAfter(pollVersion{}, c.getVersionPollingInterval())
+
+	teamList, err := c.GetTeams()
+	if err != nil {
+		logger.Error(err, "cannot list teams for polling component versions")
+		return nil
+	}
+
+	now := time.Now().UTC()
+	quota := c.getVersionPollingConcurrences()
+	for _, team := range teamList.Items {
+		polled, err := c.pollTeamComponentVersions(team.Name, now, quota)
+		if err != nil {
+			logger.Error(err, "cannot poll component versions", "team", team.Name)
+			continue
+		}
+		quota -= polled
+	}
+
+	return nil
+}
+
+// pollTeamComponentVersions adds due components of the team to queue,
+// updates last and next poll times in team status and returns no. of polled components
+func (c *controller) pollTeamComponentVersions(teamName string, now time.Time, quota int) (int, error) {
+	team := &s2hv1.Team{}
+	if err := c.getTeam(teamName, team); err != nil {
+		return 0, err
+	}
+
+	comps, err := c.GetConfigController().GetComponents(teamName)
+	if err != nil {
+		return 0, err
+	}
+
+	polled := 0
+	isChanged := false
+	for compName := range team.Status.ComponentVersionPolling {
+		if comp, ok := comps[compName]; !ok || !c.isVersionPollingEnabled(comp) {
+			team.Status.RemoveComponentVersionPolling(compName)
+			isChanged = true
+		}
+	}
+
+	for _, comp := range comps {
+		if !c.isVersionPollingEnabled(comp) {
+			continue
+		}
+
+		polling := team.Status.ComponentVersionPolling[comp.Name]
+		nextPollAt, err := getNextPollTime(comp.Schedules, polling, now)
+		if err != nil {
+			logger.Error(err, "invalid component schedules",
+				"team", teamName, "component", comp.Name, "schedules", comp.Schedules)
+			continue
+		}
+
+		if !now.Before(nextPollAt) && polled < quota {
+			c.queue.AddAfter(c.newUpdateTeamDesiredComponent(teamName, comp), c.getVersionPollingJitter())
+			polled++
+
+			// schedules have already been parsed successfully
+			nextPollAt, _ = getNextScheduledTime(comp.Schedules, now)
+			polling.LastPolledAt = &metav1.Time{Time: now}
+			polling.NextPollAt = nil
+		}
+
+		if polling.NextPollAt == nil || !polling.NextPollAt.Time.Equal(nextPollAt) {
+			polling.NextPollAt = &metav1.Time{Time: nextPollAt}
+			team.Status.SetComponentVersionPolling(comp.Name, polling)
+			isChanged = true
+		}
+	}
+
+	if isChanged {
+		if err := c.updateTeam(team); err != nil {
+			return polled, err
+		}
+	}
+
+	return polled, nil
+}
+
+func (c *controller) isVersionPollingEnabled(comp *s2hv1.Component) bool {
+	return len(comp.Schedules) > 0 && c.hasComponentChecker(comp)
+}
+
+func (c *controller) getVersionPollingInterval() time.Duration {
+	if c.configs.VersionPolling.Interval.Duration <= 0 {
+		return defaultVersionPollingInterval
+	}
+
+	return c.configs.VersionPolling.Interval.Duration
+}
+
+func (c *controller) getVersionPollingConcurrences() int {
+	if c.configs.VersionPolling.Concurrences <= 0 {
+		return defaultVersionPollingConcurrences
+	}
+
+	return c.configs.VersionPolling.Concurrences
+}
+
+// getVersionPollingJitter returns random delay for spreading checker calls of the same schedule
+func (c *controller) getVersionPollingJitter() time.Duration {
+	maxJitter := c.configs.VersionPolling.MaxJitter.Duration
+	if maxJitter <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(maxJitter)))
+}
+
+// getNextPollTime returns the time that component should be polled.
+//
+// The next poll time is calculated from the last poll time, so missed schedules will be polled only once.
+// If the component has never been polled, the earlier of stored and newly calculated time will be used.
+func getNextPollTime(schedules []string, polling s2hv1.ComponentVersionPolling, now time.Time) (time.Time, error) {
+	from := now
+	if polling.LastPolledAt != nil {
+		from = polling.LastPolledAt.Time.UTC()
+	}
+
+	next, err := getNextScheduledTime(schedules, from)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if polling.LastPolledAt == nil && polling.NextPollAt != nil && polling.NextPollAt.Time.Before(next) {
+		return polling.NextPollAt.Time.UTC(), nil
+	}
+
+	return next, nil
+}
+
+// getNextScheduledTime returns the earliest activation time of cron schedules after the given time
+func getNextScheduledTime(schedules []string, from time.Time) (time.Time, error) {
+	var next time.Time
+	for _, schedule := range schedules {
+		sched, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "cannot parse schedule %q", schedule)
+		}
+
+		t := sched.Next(from)
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+
+	if next.IsZero() {
+		return time.Time{}, errors.New("no valid schedule")
+	}
+
+	return next, nil
+}
@@ -0,0 +1,60 @@
+package samsahai
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("S2H version polling", func() {
+	g := NewWithT(GinkgoT())
+	now := time.Date(2020, 10, 10, 4, 30, 0, 0, time.UTC)
+
+	It("should correctly get the earliest scheduled time", func() {
+		next, err := getNextScheduledTime([]string{"0 5 * * *", "*/20 * * * *"}, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 4, 40, 0, 0, time.UTC)))
+
+		next, err = getNextScheduledTime([]string{"0 4 * * *"}, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 11, 4, 0, 0, 0, time.UTC)))
+	})
+
+	It("should fail to get scheduled time of invalid schedules", func() {
+		_, err := getNextScheduledTime([]string{"0 5 * * *", "invalid"}, now)
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = getNextScheduledTime([]string{}, now)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	It("should calculate next poll time from the last poll time", func() {
+		polling := s2hv1.ComponentVersionPolling{
+			LastPolledAt: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+		}
+		next, err := getNextPollTime([]string{"0 * * * *"}, polling, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 3, 0, 0, 0, time.UTC)),
+			"missed schedules should be due only once")
+	})
+
+	It("should keep the earlier next poll time of never polled component", func() {
+		storedNext := time.Date(2020, 10, 10, 4, 35, 0, 0, time.UTC)
+		polling := s2hv1.ComponentVersionPolling{
+			NextPollAt: &metav1.Time{Time: storedNext},
+		}
+		next, err := getNextPollTime([]string{"0 * * * *"}, polling, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(storedNext))
+
+		polling.NextPollAt = &metav1.Time{Time: now.Add(24 * time.Hour)}
+		next, err = getNextPollTime([]string{"0 * * * *"}, polling, now)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(next).To(Equal(time.Date(2020, 10, 10, 5, 0, 0, 0, time.UTC)),
+			"changed schedule should be applied")
+	})
+})
@@ -2,14 +2,18 @@ package webhook
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 )
 
 type activePromotion struct {
@@ -111,6 +115,188 @@ func (h *handler) getTeamActivePromotions(w http.ResponseWriter, r *http.Request
 	h.JSON(w, http.StatusOK, data)
 }
 
+// approveTeamActivePromotion godoc
+// @Summary Approve Active Promotion
+// @Description Approves the active promotion which is waiting for approvals by the team owner.
+// @Description The pre-active environment will be switched to be active once required approvals are given.
+// @Description The approver is identified by the approver token of the team owner
+// @Description which is configured in the team credential, the shared samsahai auth token cannot approve.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Approver token of the team owner"
+// @Success 200 {object} v1.ActivePromotion
+// @Failure 400 {object} errResp "Approver is not a team owner or active promotion is not waiting for approvals"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team or active promotion not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/approve [post]
+func (h *handler) approveTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	token := getAuthToken(r)
+	if token == "" {
+		h.error(w, http.StatusUnauthorized, s2herrors.ErrUnauthorized)
+		return
+	}
+
+	atp, err := h.samsahai.ApproveActivePromotion(params.ByName("team"), token)
+	if err != nil {
+		switch {
+		case k8serrors.IsNotFound(err):
+			h.error(w, http.StatusNotFound, err)
+		case s2herrors.Is(err, s2herrors.ErrActivePromotionApproverUnauthorized):
+			h.error(w, http.StatusUnauthorized, err)
+		case s2herrors.Is(err, s2herrors.ErrActivePromotionApproverNotOwner),
+			s2herrors.Is(err, s2herrors.ErrActivePromotionNotWaitingForApproval):
+			h.error(w, http.StatusBadRequest, err)
+		default:
+			logger.Error(err, "cannot approve active promotion")
+			h.error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	h.JSON(w, http.StatusOK, atp)
+}
+
+type createActivePromotionJSON struct {
+	// SkipTest represents a flag for skipping running pre-active test
+	SkipTest bool `json:"skipTest"`
+	// TearDownDuration represents duration before tear down the previous active namespace
+	TearDownDuration string `json:"tearDownDuration" example:"30m"`
+	// PromotedBy represents a person who promotes the active environment
+	PromotedBy string `json:"promotedBy"`
+	// Components represents names of components to be promoted, all components are promoted if not defined
+	Components []string `json:"components"`
+}
+
+// createTeamActivePromotion godoc
+// @Summary Create Active Promotion
+// @Description Creates an active promotion of the team, it will be waiting in queue
+// @Description if there are other running active promotions.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param createActivePromotionJSON body webhook.createActivePromotionJSON false "Active promotion options"
+// @Success 201 {object} v1.ActivePromotion
+// @Failure 400 {object} errResp "Invalid JSON, invalid tear down duration, active promotion already exists or in code-freeze window"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions [post]
+func (h *handler) createTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData createActivePromotionJSON
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &jsonData); err != nil {
+			h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+			return
+		}
+	}
+
+	spec := v1.ActivePromotionSpec{
+		SkipTestRunner: jsonData.SkipTest,
+		PromotedBy:     jsonData.PromotedBy,
+		Components:     jsonData.Components,
+	}
+
+	if jsonData.TearDownDuration != "" {
+		d, err := time.ParseDuration(jsonData.TearDownDuration)
+		if err != nil {
+			h.error(w, http.StatusBadRequest, fmt.Errorf("invalid tear down duration: %+v", err))
+			return
+		}
+		spec.SetTearDownDuration(metav1.Duration{Duration: d})
+	}
+
+	atp, err := h.samsahai.CreateActivePromotion(params.ByName("team"), spec)
+	if err != nil {
+		h.activePromotionActionError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusCreated, atp)
+}
+
+// cancelTeamActivePromotion godoc
+// @Summary Cancel Active Promotion
+// @Description Cancels the current active promotion of the team.
+// @Description The active environment will be rolled back if it has been touched.
+// @Description Active promotion which is destroying environments or rolling back cannot be canceled.
+// @Tags DELETE
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Canceled by"
+// @Success 200 {object} v1.ActivePromotion
+// @Failure 400 {object} errResp "Active promotion cannot be canceled in current state"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Active promotion not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/current [delete]
+func (h *handler) cancelTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	atp, err := h.samsahai.CancelActivePromotion(params.ByName("team"), r.URL.Query().Get("by"))
+	if err != nil {
+		h.activePromotionActionError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusOK, atp)
+}
+
+// retryTeamActivePromotion godoc
+// @Summary Retry Active Promotion
+// @Description Creates an active promotion of the team with options of the latest active promotion history
+// @Description if it was not successful.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Retried by"
+// @Success 201 {object} v1.ActivePromotion
+// @Failure 400 {object} errResp "No failed active promotion history, active promotion already exists or in code-freeze window"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/retry [post]
+func (h *handler) retryTeamActivePromotion(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	atp, err := h.samsahai.RetryActivePromotion(params.ByName("team"), r.URL.Query().Get("by"))
+	if err != nil {
+		h.activePromotionActionError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusCreated, atp)
+}
+
+func (h *handler) activePromotionActionError(w http.ResponseWriter, err error) {
+	switch {
+	case k8serrors.IsNotFound(err):
+		h.error(w, http.StatusNotFound, err)
+	case k8serrors.IsAlreadyExists(err),
+		s2herrors.Is(err, s2herrors.ErrActivePromotionCannotBeCanceled),
+		s2herrors.Is(err, s2herrors.ErrActivePromotionInFreezeWindow),
+		s2herrors.Is(err, s2herrors.ErrNoFailedActivePromotionHistory):
+		h.error(w, http.StatusBadRequest, err)
+	default:
+		logger.Error(err, "cannot manage active promotion")
+		h.error(w, http.StatusInternalServerError, err)
+	}
+}
+
 type activePromotionHistories []v1.ActivePromotionHistory
 
 // getTeamActivePromotion godoc
@@ -250,3 +436,165 @@ func (h *handler) getActivePromotionHistoryListByDESC(teamName string) (*v1.Acti
 
 	return atpHistList, nil
 }
+
+const (
+	activePromotionHistoriesPath = "histories"
+	activePromotionDiffPath      = "diff"
+	activePromotionLogPath       = "log"
+	activePromotionCurrentName   = "current"
+)
+
+// getTeamActivePromotionRoute dispatches GET routes under active promotions of the team
+//
+// - /teams/:team/activepromotions/diff
+// - /teams/:team/activepromotions/histories
+// - /teams/:team/activepromotions/histories/:history
+// - /teams/:team/activepromotions/histories/:history/log
+// - /teams/:team/activepromotions/histories/:history/diff
+// - /teams/:team/activepromotions/:name/diff
+func (h *handler) getTeamActivePromotionRoute(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	name, sub, action := params.ByName("name"), params.ByName("sub"), params.ByName("action")
+
+	if name == activePromotionHistoriesPath {
+		histParams := append(params, httprouter.Param{Key: "history", Value: sub})
+		switch {
+		case sub == "":
+			h.getTeamActivePromotionHistories(w, r, params)
+		case action == "":
+			h.getTeamActivePromotionHistory(w, r, histParams)
+		case action == activePromotionLogPath:
+			h.getTeamActivePromotionHistoryLog(w, r, histParams)
+		case action == activePromotionDiffPath:
+			h.getTeamActivePromotionHistoryDiff(w, r, histParams)
+		default:
+			http.NotFound(w, r)
+		}
+		return
+	}
+
+	switch {
+	case name == activePromotionDiffPath && sub == "":
+		h.getTeamActivePromotionDiff(w, r, params)
+	case sub == activePromotionDiffPath && action == "":
+		h.getTeamActivePromotionDiffByName(w, r, params)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// getTeamActivePromotionDiffByName godoc
+// @Summary Get active promotion diff by name
+// @Description Returns differences between the active and pre-active environments of the active promotion.
+// @Description The name can be `current`, name of the current active promotion or name of an active promotion history.
+// @Tags GET
+// @Produce  json
+// @Param team path string true "Team name"
+// @Param name path string true "Active promotion or active promotion history name"
+// @Success 200 {object} v1.ActivePromotionDiff
+// @Failure 400 {object} errResp "Pre-active environment has not been created"
+// @Failure 404 {object} errResp "Active promotion, active promotion history or its diff not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/{name}/diff [get]
+func (h *handler) getTeamActivePromotionDiffByName(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	name := params.ByName("name")
+
+	// name of active promotion is the same as team name
+	if name == activePromotionCurrentName || name == params.ByName("team") {
+		h.getTeamActivePromotionDiff(w, r, params)
+		return
+	}
+
+	h.getTeamActivePromotionHistoryDiff(w, r, append(params, httprouter.Param{Key: "history", Value: name}))
+}
+
+// getTeamActivePromotionDiff godoc
+// @Summary Get active promotion diff
+// @Description Returns differences of component versions, chart versions and rendered values
+// @Description between the active and pre-active environments of the current active promotion.
+// @Description The diff is created before promoting, it is calculated on demand if it has not been created yet.
+// @Tags GET
+// @Produce  json
+// @Param team path string true "Team name"
+// @Success 200 {object} v1.ActivePromotionDiff
+// @Failure 400 {object} errResp "Pre-active environment has not been created"
+// @Failure 404 {object} errResp "Active promotion not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/diff [get]
+func (h *handler) getTeamActivePromotionDiff(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	teamName := params.ByName("team")
+
+	atp, err := h.samsahai.GetActivePromotion(teamName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			h.error(w, http.StatusNotFound, fmt.Errorf("activepromotion of team %s not found", teamName))
+			return
+		}
+		h.error(w, http.StatusInternalServerError,
+			fmt.Errorf("cannot get activepromotion of team %s: %+v", teamName, err))
+		return
+	}
+
+	if atp.Status.Diff != nil {
+		h.JSON(w, http.StatusOK, atp.Status.Diff)
+		return
+	}
+
+	if atp.Status.TargetNamespace == "" {
+		h.error(w, http.StatusBadRequest,
+			fmt.Errorf("pre-active environment of team %s has not been created", teamName))
+		return
+	}
+
+	diff, err := h.samsahai.GetActivePromotionDiff(atp)
+	if err != nil {
+		h.error(w, http.StatusInternalServerError,
+			fmt.Errorf("cannot get activepromotion diff of team %s: %+v", teamName, err))
+		return
+	}
+
+	h.JSON(w, http.StatusOK, diff)
+}
+
+// getTeamActivePromotionHistoryDiff godoc
+// @Summary Get active promotion history diff
+// @Description Returns differences between the active and pre-active environments before promoting
+// @Description of the active promotion history.
+// @Tags GET
+// @Produce  json
+// @Param team path string true "Team name"
+// @Param history path string true "Active promotion history name"
+// @Success 200 {object} v1.ActivePromotionDiff
+// @Failure 404 {object} errResp "Active promotion history or its diff not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/activepromotions/histories/{history}/diff [get]
+func (h *handler) getTeamActivePromotionHistoryDiff(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	teamName := params.ByName("team")
+	atpHistName := params.ByName("history")
+
+	atpHist, err := h.samsahai.GetActivePromotionHistory(atpHistName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			h.error(w, http.StatusNotFound,
+				fmt.Errorf("activepromotion history %s of team %s not found", atpHistName, teamName))
+			return
+		}
+		h.error(w, http.StatusInternalServerError,
+			fmt.Errorf("cannot get activepromotion history %s of team %s: %+v", atpHistName, teamName, err))
+		return
+	}
+
+	teamKey := internal.GetTeamLabelKey()
+	if atpHist.Labels[teamKey] != teamName {
+		h.error(w, http.StatusNotFound,
+			fmt.Errorf("activepromotion history %s of team %s not found", atpHistName, teamName))
+		return
+	}
+
+	if atpHist.Spec.ActivePromotion == nil || atpHist.Spec.ActivePromotion.Status.Diff == nil {
+		h.error(w, http.StatusNotFound,
+			fmt.Errorf("diff of activepromotion history %s of team %s not found", atpHistName, teamName))
+		return
+	}
+
+	h.JSON(w, http.StatusOK, atpHist.Spec.ActivePromotion.Status.Diff)
+}
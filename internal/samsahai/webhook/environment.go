@@ -2,8 +2,10 @@ package webhook
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/julienschmidt/httprouter"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/agoda-com/samsahai/internal/errors"
 )
@@ -45,3 +47,55 @@ func (h *handler) deleteTeamActiveEnvironment(w http.ResponseWriter, r *http.Req
 
 	w.WriteHeader(http.StatusOK)
 }
+
+// rollbackTeamActiveEnvironment godoc
+// @Summary Rollback Active Environment
+// @Description Switches the active namespace back to the retained previous active namespace.
+// @Description The retained namespace is scaled up and promoted to active again before switching,
+// @Description 202 is returned while it is being promoted and the request should be sent again until 200 is returned.
+// @Description The current active namespace is scaled down and retained instead.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param to query int false "Position of retained previous active namespace, 1 is the latest one (default 1)"
+// @Param by query string false "Rolled back by"
+// @Success 200 {object} v1.TeamNamespace
+// @Success 202 {object} errResp "Retained namespace is being promoted to active"
+// @Failure 400 {object} errResp "Retained namespace not found or active promotion is running"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/environment/active/rollback [post]
+func (h *handler) rollbackTeamActiveEnvironment(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	position := 1
+	if to := r.URL.Query().Get("to"); to != "" {
+		var err error
+		if position, err = strconv.Atoi(to); err != nil {
+			h.errorf(w, http.StatusBadRequest, "invalid position %q", to)
+			return
+		}
+	}
+
+	team, err := h.samsahai.RollbackActiveEnvironment(params.ByName("team"), position, r.URL.Query().Get("by"))
+	if err != nil {
+		switch {
+		case k8serrors.IsNotFound(err):
+			h.error(w, http.StatusNotFound, err)
+		case errors.Is(err, errors.ErrActiveEnvironmentRollbackInProgress):
+			h.error(w, http.StatusAccepted, err)
+		case errors.Is(err, errors.ErrRetainedActiveNamespaceNotFound),
+			errors.Is(err, errors.ErrActiveEnvironmentRollbackNotAllowed):
+			h.error(w, http.StatusBadRequest, err)
+		default:
+			logger.Error(err, "cannot rollback active environment", "team", params.ByName("team"))
+			h.error(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	h.JSON(w, http.StatusOK, team.Status.Namespace)
+}
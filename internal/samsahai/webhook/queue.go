@@ -0,0 +1,325 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	s2h "github.com/agoda-com/samsahai/internal"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+type queueActionJSON struct {
+	// Queue represents a name of queue
+	Queue string `json:"queue"`
+
+	// By represents a person who applies the action
+	// +optional
+	By string `json:"by,omitempty"`
+}
+
+type enqueueComponentJSON struct {
+	Component string `json:"component"`
+	Version   string `json:"version"`
+
+	// SkipTestRunner represents a flag for skipping running test
+	// +optional
+	SkipTestRunner bool `json:"skipTestRunner,omitempty"`
+
+	// By represents a person who applies the action
+	// +optional
+	By string `json:"by,omitempty"`
+}
+
+type pinComponentJSON struct {
+	// Repository represents Docker image repository, empty means using repository in config
+	// +optional
+	Repository string `json:"repository,omitempty"`
+	Version    string `json:"version"`
+
+	// SkipTestRunner represents a flag for skipping running test
+	// +optional
+	SkipTestRunner bool `json:"skipTestRunner,omitempty"`
+
+	// By represents a person who applies the action
+	// +optional
+	By string `json:"by,omitempty"`
+}
+
+// moveTeamQueueToTop godoc
+// @Summary Move Queue To Top
+// @Description Moves the waiting queue to the top of team queues.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param queueActionJSON body webhook.queueActionJSON true "Queue"
+// @Success 200 {string} string
+// @Failure 400 {object} errResp "Invalid JSON or queue is not waiting"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/top [post]
+func (h *handler) moveTeamQueueToTop(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.applyQueueAction(w, r, params, h.samsahai.MoveQueueToTop)
+}
+
+// moveTeamQueueToBottom godoc
+// @Summary Move Queue To Bottom
+// @Description Moves the waiting queue to the bottom of team queues.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param queueActionJSON body webhook.queueActionJSON true "Queue"
+// @Success 200 {string} string
+// @Failure 400 {object} errResp "Invalid JSON or queue is not waiting"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/bottom [post]
+func (h *handler) moveTeamQueueToBottom(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.applyQueueAction(w, r, params, h.samsahai.MoveQueueToBottom)
+}
+
+// cancelTeamQueue godoc
+// @Summary Cancel Queue
+// @Description Cancels the waiting or running queue, the running queue will be stopped by the staging controller.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param queueActionJSON body webhook.queueActionJSON true "Queue"
+// @Success 200 {string} string
+// @Failure 400 {object} errResp "Invalid JSON or queue cannot be cancelled"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team or queue not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/cancel [post]
+func (h *handler) cancelTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.applyQueueAction(w, r, params, h.samsahai.CancelQueue)
+}
+
+// retryTeamQueue godoc
+// @Summary Retry Failed Queue
+// @Description Adds the latest failed component upgrade queue back to team queues.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Retried by"
+// @Success 201 {object} v1.Queue
+// @Failure 400 {object} errResp "There is no failed queue or the queue already exists"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/retry [post]
+func (h *handler) retryTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	q, err := h.samsahai.RetryQueue(params.ByName("team"), r.URL.Query().Get("by"))
+	if err != nil {
+		h.queueActionError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusCreated, q)
+}
+
+// enqueueTeamComponent godoc
+// @Summary Enqueue Component Version
+// @Description Adds a specific component version to the top of team queues, test runner can be skipped.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param enqueueComponentJSON body webhook.enqueueComponentJSON true "Component version"
+// @Success 201 {object} v1.Queue
+// @Failure 400 {object} errResp "Invalid JSON or component not found"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/enqueue [post]
+func (h *handler) enqueueTeamComponent(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData enqueueComponentJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+		return
+	}
+
+	if jsonData.Component == "" || jsonData.Version == "" {
+		h.error(w, http.StatusBadRequest, fmt.Errorf("must define component and version"))
+		return
+	}
+
+	q, err := h.samsahai.EnqueueComponent(params.ByName("team"), jsonData.Component, jsonData.Version,
+		jsonData.SkipTestRunner, jsonData.By)
+	if err != nil {
+		h.queueActionError(w, err)
+		return
+	}
+
+	h.JSON(w, http.StatusCreated, q)
+}
+
+// pinTeamComponent godoc
+// @Summary Pin Component Version
+// @Description Adds a pin queue of the component version to the top of team queues, test runner can be skipped.
+// @Description The stable component will be pinned after the queue has been verified,
+// @Description new versions of the pinned component will not be verified until it is unpinned.
+// @Description The stable component is pinned immediately if the version is the same as the stable version.
+// @Tags POST
+// @Accept  json
+// @Param team path string true "Team name"
+// @Param component path string true "Component name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param pinComponentJSON body webhook.pinComponentJSON true "Component version"
+// @Success 200 {string} string "Stable component is pinned"
+// @Success 201 {object} v1.Queue "Pin queue is added"
+// @Failure 400 {object} errResp "Invalid JSON, component not found or already pinned"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/components/{component}/pin [post]
+func (h *handler) pinTeamComponent(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData pinComponentJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+		return
+	}
+
+	if jsonData.Version == "" {
+		h.error(w, http.StatusBadRequest, fmt.Errorf("must define version"))
+		return
+	}
+
+	q, err := h.samsahai.PinComponent(params.ByName("team"), params.ByName("component"), jsonData.Repository,
+		jsonData.Version, jsonData.SkipTestRunner, jsonData.By)
+	if err != nil {
+		h.queueActionError(w, err)
+		return
+	}
+
+	if q == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	h.JSON(w, http.StatusCreated, q)
+}
+
+// unpinTeamComponent godoc
+// @Summary Unpin Component
+// @Description Unpins the stable component, the latest desired version will be added to team queues again.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param component path string true "Component name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Unpinned by"
+// @Success 200 {string} string
+// @Failure 400 {object} errResp "Component is not pinned"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team or stable component not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/components/{component}/unpin [post]
+func (h *handler) unpinTeamComponent(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	err := h.samsahai.UnpinComponent(params.ByName("team"), params.ByName("component"), r.URL.Query().Get("by"))
+	if err != nil {
+		h.queueActionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *handler) applyQueueAction(w http.ResponseWriter, r *http.Request, params httprouter.Params,
+	action func(teamName, queueName, actionBy string) error) {
+
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	var jsonData queueActionJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		h.error(w, http.StatusBadRequest, s2herrors.ErrInvalidJSONData)
+		return
+	}
+
+	if jsonData.Queue == "" {
+		h.error(w, http.StatusBadRequest, fmt.Errorf("must define queue"))
+		return
+	}
+
+	if err := action(params.ByName("team"), jsonData.Queue, jsonData.By); err != nil {
+		h.queueActionError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *handler) queueActionError(w http.ResponseWriter, err error) {
+	switch {
+	case k8serrors.IsNotFound(err):
+		h.error(w, http.StatusNotFound, err)
+	case s2herrors.Is(err, s2herrors.ErrQueueActionNotAllowed),
+		s2herrors.Is(err, s2herrors.ErrNoFailedQueueHistory),
+		s2herrors.Is(err, s2herrors.ErrComponentNotFound):
+		h.error(w, http.StatusBadRequest, err)
+	default:
+		logger.Error(err, "cannot apply queue action")
+		h.error(w, http.StatusInternalServerError, err)
+	}
+}
+
+// authenticate verifies samsahai auth token from `x-samsahai-auth` or `Authorization` header
+func (h *handler) authenticate(w http.ResponseWriter, r *http.Request) bool {
+	if !h.samsahai.VerifyAuthToken(getAuthToken(r)) {
+		h.error(w, http.StatusUnauthorized, s2herrors.ErrUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+// getAuthToken returns the token from samsahai auth header or bearer authorization header
+func getAuthToken(r *http.Request) string {
+	token := r.Header.Get(s2h.SamsahaiAuthHeader)
+	if token == "" {
+		token = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+
+	return token
+}
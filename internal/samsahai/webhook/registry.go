@@ -0,0 +1,383 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/julienschmidt/httprouter"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+const (
+	harborEventPushArtifact = "PUSH_ARTIFACT"
+	gitlabEventActionPush   = "push"
+	ghcrRegistry            = "ghcr.io"
+	ghcrSignatureHeader     = "X-Hub-Signature-256"
+	ghcrSignaturePrefix     = "sha256="
+)
+
+// registryPushEvent represents a pushed image tag which is extracted from registry webhook payload
+type registryPushEvent struct {
+	Repository string
+	Tag        string
+}
+
+type harborWebhookEventJSON struct {
+	Type      string `json:"type"`
+	EventData struct {
+		Resources []struct {
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+		Repository struct {
+			RepoFullName string `json:"repo_full_name"`
+		} `json:"repository"`
+	} `json:"event_data"`
+}
+
+type dockerHubWebhookEventJSON struct {
+	PushData struct {
+		Tag string `json:"tag"`
+	} `json:"push_data"`
+	Repository struct {
+		RepoName string `json:"repo_name"`
+	} `json:"repository"`
+}
+
+type gitlabRegistryWebhookEventJSON struct {
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+type ghcrPackageJSON struct {
+	Namespace      string `json:"namespace"`
+	Name           string `json:"name"`
+	PackageType    string `json:"package_type"`
+	PackageVersion struct {
+		ContainerMetadata struct {
+			Tag struct {
+				Name string `json:"name"`
+			} `json:"tag"`
+		} `json:"container_metadata"`
+	} `json:"package_version"`
+}
+
+type ghcrWebhookEventJSON struct {
+	Package         *ghcrPackageJSON `json:"package"`
+	RegistryPackage *ghcrPackageJSON `json:"registry_package"`
+}
+
+func parseHarborPushEvents(data []byte) ([]registryPushEvent, error) {
+	var jsonData harborWebhookEventJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, s2herrors.ErrInvalidJSONData
+	}
+
+	events := make([]registryPushEvent, 0)
+	if jsonData.Type != harborEventPushArtifact {
+		return events, nil
+	}
+
+	for _, res := range jsonData.EventData.Resources {
+		repository := res.ResourceURL
+		if repository == "" {
+			repository = jsonData.EventData.Repository.RepoFullName
+		}
+		if res.Tag == "" || repository == "" {
+			continue
+		}
+
+		events = append(events, registryPushEvent{Repository: repository, Tag: res.Tag})
+	}
+
+	return events, nil
+}
+
+func parseDockerHubPushEvents(data []byte) ([]registryPushEvent, error) {
+	var jsonData dockerHubWebhookEventJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, s2herrors.ErrInvalidJSONData
+	}
+
+	events := make([]registryPushEvent, 0)
+	if jsonData.PushData.Tag == "" || jsonData.Repository.RepoName == "" {
+		return events, nil
+	}
+
+	events = append(events, registryPushEvent{
+		Repository: jsonData.Repository.RepoName,
+		Tag:        jsonData.PushData.Tag,
+	})
+
+	return events, nil
+}
+
+func parseGitlabRegistryPushEvents(data []byte) ([]registryPushEvent, error) {
+	var jsonData gitlabRegistryWebhookEventJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, s2herrors.ErrInvalidJSONData
+	}
+
+	events := make([]registryPushEvent, 0)
+	for _, e := range jsonData.Events {
+		// manifest pushes carry a tag, blob pushes do not
+		if e.Action != gitlabEventActionPush || e.Target.Tag == "" || e.Target.Repository == "" {
+			continue
+		}
+
+		repository := e.Target.Repository
+		if e.Request.Host != "" {
+			repository = e.Request.Host + "/" + repository
+		}
+
+		events = append(events, registryPushEvent{Repository: repository, Tag: e.Target.Tag})
+	}
+
+	return events, nil
+}
+
+func parseGHCRPushEvents(data []byte) ([]registryPushEvent, error) {
+	var jsonData ghcrWebhookEventJSON
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, s2herrors.ErrInvalidJSONData
+	}
+
+	events := make([]registryPushEvent, 0)
+	pkg := jsonData.Package
+	if pkg == nil {
+		pkg = jsonData.RegistryPackage
+	}
+	if pkg == nil || !strings.EqualFold(pkg.PackageType, "container") {
+		return events, nil
+	}
+
+	tag := pkg.PackageVersion.ContainerMetadata.Tag.Name
+	if tag == "" || pkg.Namespace == "" || pkg.Name == "" {
+		return events, nil
+	}
+
+	repository := strings.ToLower(fmt.Sprintf("%s/%s/%s", ghcrRegistry, pkg.Namespace, pkg.Name))
+	events = append(events, registryPushEvent{Repository: repository, Tag: tag})
+
+	return events, nil
+}
+
+// normalizeRepository returns fully qualified repository name without tag or digest,
+// e.g. `bitnami/redis` and `docker.io/bitnami/redis:5.0` are both `docker.io/bitnami/redis`
+func normalizeRepository(repository string) string {
+	named, err := reference.ParseNormalizedNamed(repository)
+	if err != nil {
+		return repository
+	}
+
+	return named.Name()
+}
+
+// isComponentMatched checks whether the pushed image matches repository and version pattern of the component
+func isComponentMatched(comp *s2hv1.Component, repository, tag string) bool {
+	if comp.Source == nil || comp.Image.Repository == "" {
+		return false
+	}
+
+	if normalizeRepository(comp.Image.Repository) != normalizeRepository(repository) {
+		return false
+	}
+
+	pattern := comp.Image.Pattern
+	if pattern == "" {
+		pattern = ".*"
+	}
+
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		logger.Error(err, "invalid pattern", "component", comp.Name, "pattern", pattern)
+		return false
+	}
+
+	return matcher.MatchString(tag)
+}
+
+// notifyRegistryPushEvents notifies every matched component of every team, returns no. of notified components
+func (h *handler) notifyRegistryPushEvents(events []registryPushEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	teamList, err := h.samsahai.GetTeams()
+	if err != nil {
+		return 0, err
+	}
+
+	configCtrl := h.samsahai.GetConfigController()
+	notified := 0
+	for _, team := range teamList.Items {
+		comps, err := configCtrl.GetComponents(team.Name)
+		if err != nil {
+			logger.Error(err, "cannot get components", "team", team.Name)
+			continue
+		}
+
+		for _, comp := range comps {
+			for _, e := range events {
+				if !isComponentMatched(comp, e.Repository, e.Tag) {
+					continue
+				}
+
+				logger.Debug("registry push event matched component",
+					"team", team.Name, "component", comp.Name, "repository", e.Repository, "tag", e.Tag)
+				h.samsahai.NotifyComponentChanged(comp.Name, comp.Image.Repository, team.Name)
+				notified++
+				break
+			}
+		}
+	}
+
+	return notified, nil
+}
+
+// verifyRegistryWebhookToken verifies shared secret from `Authorization` header or `token` query parameter
+func (h *handler) verifyRegistryWebhookToken(r *http.Request, _ []byte) bool {
+	secret := h.samsahai.GetRegistryWebhookSecret()
+	if secret == "" {
+		return false
+	}
+
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	token = strings.TrimPrefix(token, "Bearer ")
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// verifyRegistryWebhookSignature verifies HMAC-SHA256 signature of request body which is signed by shared secret
+func (h *handler) verifyRegistryWebhookSignature(r *http.Request, data []byte) bool {
+	secret := h.samsahai.GetRegistryWebhookSecret()
+	signature := r.Header.Get(ghcrSignatureHeader)
+	if secret == "" || !strings.HasPrefix(signature, ghcrSignaturePrefix) {
+		return false
+	}
+
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, ghcrSignaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(data)
+
+	return hmac.Equal(actual, mac.Sum(nil))
+}
+
+func (h *handler) registryWebhook(
+	w http.ResponseWriter,
+	r *http.Request,
+	parse func(data []byte) ([]registryPushEvent, error),
+	verify func(r *http.Request, data []byte) bool,
+) {
+	data, err := h.readRequestBody(w, r)
+	if err != nil {
+		return
+	}
+
+	if !verify(r, data) {
+		h.error(w, http.StatusUnauthorized, s2herrors.ErrUnauthorized)
+		return
+	}
+
+	events, err := parse(data)
+	if err != nil {
+		h.error(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err := h.notifyRegistryPushEvents(events); err != nil {
+		h.errorf(w, http.StatusInternalServerError, "cannot notify component changed: %+v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// harborRegistryWebhook godoc
+// @Summary Webhook Harbor Push Artifact
+// @Description Endpoint for receiving `PUSH_ARTIFACT` event from Harbor.
+// @Description Secret should be set in `Authorization` header of Harbor webhook policy.
+// @Tags POST
+// @Accept  json
+// @Param Authorization header string true "Registry webhook secret"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 500 {object} errResp "Internal Server Errors"
+// @Router /webhook/registry/harbor [post]
+func (h *handler) harborRegistryWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.registryWebhook(w, r, parseHarborPushEvents, h.verifyRegistryWebhookToken)
+}
+
+// dockerHubRegistryWebhook godoc
+// @Summary Webhook Docker Hub Push
+// @Description Endpoint for receiving push event from Docker Hub.
+// @Description Docker Hub does not support custom headers, secret should be set in `token` query parameter.
+// @Tags POST
+// @Accept  json
+// @Param token query string true "Registry webhook secret"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 500 {object} errResp "Internal Server Errors"
+// @Router /webhook/registry/dockerhub [post]
+func (h *handler) dockerHubRegistryWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.registryWebhook(w, r, parseDockerHubPushEvents, h.verifyRegistryWebhookToken)
+}
+
+// gitlabRegistryWebhook godoc
+// @Summary Webhook GitLab Container Registry Push
+// @Description Endpoint for receiving push notification from GitLab container registry (docker distribution).
+// @Description Secret should be set in `Authorization` header of registry notification endpoint.
+// @Tags POST
+// @Accept  json
+// @Param Authorization header string true "Registry webhook secret"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 500 {object} errResp "Internal Server Errors"
+// @Router /webhook/registry/gitlab [post]
+func (h *handler) gitlabRegistryWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.registryWebhook(w, r, parseGitlabRegistryPushEvents, h.verifyRegistryWebhookToken)
+}
+
+// ghcrRegistryWebhook godoc
+// @Summary Webhook GitHub Container Registry Publish
+// @Description Endpoint for receiving `package` or `registry_package` event from GitHub.
+// @Description Request body is verified by `X-Hub-Signature-256` header which is signed by the webhook secret.
+// @Tags POST
+// @Accept  json
+// @Param X-Hub-Signature-256 header string true "HMAC-SHA256 signature of request body"
+// @Success 204 {string} string
+// @Failure 400 {object} errResp "Invalid JSON"
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 500 {object} errResp "Internal Server Errors"
+// @Router /webhook/registry/ghcr [post]
+func (h *handler) ghcrRegistryWebhook(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.registryWebhook(w, r, parseGHCRPushEvents, h.verifyRegistryWebhookSignature)
+}
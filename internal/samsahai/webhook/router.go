@@ -35,6 +35,12 @@ func (h *handler) bind(r *httprouter.Router) {
 
 	r.POST("/webhook/component", h.newComponentWebhook)
 
+	// route from container registries
+	r.POST("/webhook/registry/harbor", h.harborRegistryWebhook)
+	r.POST("/webhook/registry/dockerhub", h.dockerHubRegistryWebhook)
+	r.POST("/webhook/registry/gitlab", h.gitlabRegistryWebhook)
+	r.POST("/webhook/registry/ghcr", h.ghcrRegistryWebhook)
+
 	// route from plugins
 	plugins := h.samsahai.GetPlugins()
 	for k := range plugins {
@@ -47,17 +53,33 @@ func (h *handler) bind(r *httprouter.Router) {
 	r.GET("/teams/:team/config", h.getTeamConfig)
 	r.GET("/teams/:team/components", h.getTeamComponent)
 	r.GET("/teams/:team/queue", h.getTeamQueue)
+	r.POST("/teams/:team/queue/pause", h.pauseTeamQueue)
+	r.POST("/teams/:team/queue/resume", h.resumeTeamQueue)
+	r.POST("/teams/:team/queue/top", h.moveTeamQueueToTop)
+	r.POST("/teams/:team/queue/bottom", h.moveTeamQueueToBottom)
+	r.POST("/teams/:team/queue/cancel", h.cancelTeamQueue)
+	r.POST("/teams/:team/queue/retry", h.retryTeamQueue)
+	r.POST("/teams/:team/queue/enqueue", h.enqueueTeamComponent)
 	r.GET("/teams/:team/queue/histories/:queue", h.getTeamQueueHistory)
 	r.GET("/teams/:team/queue/histories/:queue/log", h.getTeamQueueHistoryLog)
 
 	r.GET("/teams/:team/components/:component/values", h.getTeamComponentStableValues)
+	r.POST("/teams/:team/components/:component/pin", h.pinTeamComponent)
+	r.POST("/teams/:team/components/:component/unpin", h.unpinTeamComponent)
 
 	r.DELETE("/teams/:team/environment/active/delete", h.deleteTeamActiveEnvironment)
+	r.POST("/teams/:team/environment/active/rollback", h.rollbackTeamActiveEnvironment)
 
 	r.GET("/teams/:team/activepromotions", h.getTeamActivePromotions)
-	r.GET("/teams/:team/activepromotions/histories", h.getTeamActivePromotionHistories)
-	r.GET("/teams/:team/activepromotions/histories/:history", h.getTeamActivePromotionHistory)
-	r.GET("/teams/:team/activepromotions/histories/:history/log", h.getTeamActivePromotionHistoryLog)
+	r.POST("/teams/:team/activepromotions", h.createTeamActivePromotion)
+	r.DELETE("/teams/:team/activepromotions/current", h.cancelTeamActivePromotion)
+	r.POST("/teams/:team/activepromotions/retry", h.retryTeamActivePromotion)
+	r.POST("/teams/:team/activepromotions/approve", h.approveTeamActivePromotion)
+	// httprouter does not allow static and wildcard segments at the same position,
+	// GET routes under active promotions are dispatched by getTeamActivePromotionRoute
+	r.GET("/teams/:team/activepromotions/:name", h.getTeamActivePromotionRoute)
+	r.GET("/teams/:team/activepromotions/:name/:sub", h.getTeamActivePromotionRoute)
+	r.GET("/teams/:team/activepromotions/:name/:sub/:action", h.getTeamActivePromotionRoute)
 
 	r.POST("/teams/:team/pullrequest/trigger", h.pullRequestWebhook)
 	r.GET("/teams/:team/pullrequest/queue", h.getTeamPullRequestQueue)
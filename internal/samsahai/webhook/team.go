@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"time"
 
 	"github.com/julienschmidt/httprouter"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	v1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/queue"
 )
 
 type teamsJSON struct {
@@ -62,6 +64,13 @@ type teamQueueJSON struct {
 	Queues []v1.Queue `json:"queues"`
 
 	Histories []string `json:"historyNames"`
+
+	// Paused represents whether new queues are not started due to pausing or maintenance windows
+	Paused bool `json:"paused"`
+
+	// PausedReason represents why the queues are paused e.g. paused, maintenance
+	// +optional
+	PausedReason string `json:"pausedReason,omitempty"`
 }
 
 type teamEnvConnections struct {
@@ -203,6 +212,7 @@ func (h *handler) getTeamQueue(w http.ResponseWriter, r *http.Request, params ht
 		NoOfQueue: len(queues.Items),
 		Queues:    queues.Items,
 	}
+	data.Paused, data.PausedReason = queue.IsTeamQueuePaused(team.Status.Used.Queue, time.Now())
 
 	if len(histories.Items) > 0 {
 		for _, history := range histories.Items {
@@ -218,6 +228,58 @@ func (h *handler) getTeamQueue(w http.ResponseWriter, r *http.Request, params ht
 	h.JSON(w, http.StatusOK, &data)
 }
 
+// pauseTeamQueue godoc
+// @Summary Pause Team's Queues
+// @Description Pauses team queues, new queues are not started and active promotions are not started.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Paused by"
+// @Success 200 {string} string
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/pause [post]
+func (h *handler) pauseTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.setTeamQueuePaused(w, r, params, true)
+}
+
+// resumeTeamQueue godoc
+// @Summary Resume Team's Queues
+// @Description Resumes paused team queues, maintenance windows are still applied.
+// @Tags POST
+// @Param team path string true "Team name"
+// @Param x-samsahai-auth header string true "Samsahai auth token"
+// @Param by query string false "Resumed by"
+// @Success 200 {string} string
+// @Failure 401 {object} errResp "Unauthorized"
+// @Failure 404 {object} errResp "Team not found"
+// @Failure 500 {object} errResp
+// @Router /teams/{team}/queue/resume [post]
+func (h *handler) resumeTeamQueue(w http.ResponseWriter, r *http.Request, params httprouter.Params) {
+	h.setTeamQueuePaused(w, r, params, false)
+}
+
+func (h *handler) setTeamQueuePaused(w http.ResponseWriter, r *http.Request, params httprouter.Params, paused bool) {
+	if !h.authenticate(w, r) {
+		return
+	}
+
+	team, err := h.loadTeam(w, params)
+	if err != nil {
+		return
+	}
+
+	actionBy := r.URL.Query().Get("by")
+	if err := h.samsahai.SetTeamQueuePaused(team.Name, paused, actionBy); err != nil {
+		logger.Error(err, "cannot set team queue paused", "team", team.Name, "paused", paused)
+		h.errorf(w, http.StatusInternalServerError, "cannot update team queue: %+v", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 // getTeamQueueHistoryLog godoc
 // @Summary Get Team Queue History Log
 // @Description Returns zip log file of the queue history
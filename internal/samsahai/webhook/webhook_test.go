@@ -2,6 +2,9 @@ package webhook
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
 	"log"
@@ -70,6 +73,7 @@ var _ = Describe("Samsahai Webhook", func() {
 	qhName := "test-history"
 	prQueueHistName := "pr-history"
 	namespace := "default"
+	registrySecret := "registry-secret"
 	g := NewWithT(GinkgoT())
 
 	BeforeEach(func(done Done) {
@@ -79,7 +83,8 @@ var _ = Describe("Samsahai Webhook", func() {
 		s2hConfig := s2h.SamsahaiConfig{
 			PluginsDir: path.Join("..", "plugin"),
 			SamsahaiCredential: s2h.SamsahaiCredential{
-				InternalAuthToken: "123456",
+				InternalAuthToken:     "123456",
+				RegistryWebhookSecret: registrySecret,
 			},
 		}
 		s2hCtrl = samsahai.New(nil, namespace, s2hConfig,
@@ -197,6 +202,105 @@ var _ = Describe("Samsahai Webhook", func() {
 		}, timeout)
 	})
 
+	Describe("Registry", func() {
+		It("should successfully receive push event from docker hub", func(done Done) {
+			defer close(done)
+
+			reqData := map[string]interface{}{
+				"push_data":  map[string]interface{}{"tag": "5.0.7-debian-9-r10"},
+				"repository": map[string]interface{}{"repo_name": "bitnami/redis"},
+			}
+			b, err := json.Marshal(reqData)
+			g.Expect(err).NotTo(HaveOccurred())
+			_, _, err = http.Post(server.URL+"/webhook/registry/dockerhub?token="+registrySecret, b)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s2hCtrl.QueueLen()).To(Equal(1))
+		}, timeout)
+
+		It("should successfully receive push artifact event from harbor", func(done Done) {
+			defer close(done)
+
+			b := []byte(`{"type":"PUSH_ARTIFACT","event_data":{"resources":[` +
+				`{"tag":"5.2.4-debian-9-r0","resource_url":"docker.io/bitnami/wordpress:5.2.4-debian-9-r0"}]}}`)
+			_, _, err := http.Post(server.URL+"/webhook/registry/harbor", b,
+				http.WithHeader("Authorization", registrySecret))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s2hCtrl.QueueLen()).To(Equal(1))
+		}, timeout)
+
+		It("should successfully receive signed package event from github", func(done Done) {
+			defer close(done)
+
+			b := []byte(`{"action":"published","package":{"namespace":"Bitnami","name":"redis",` +
+				`"package_type":"CONTAINER","package_version":{"container_metadata":{"tag":{"name":"5.0"}}}}}`)
+			mac := hmac.New(sha256.New, []byte(registrySecret))
+			_, _ = mac.Write(b)
+			signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+			_, _, err := http.Post(server.URL+"/webhook/registry/ghcr", b,
+				http.WithHeader("X-Hub-Signature-256", signature))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s2hCtrl.QueueLen()).To(Equal(0), "ghcr.io/bitnami/redis does not match any component")
+		}, timeout)
+
+		It("should not notify mismatched version pattern", func(done Done) {
+			defer close(done)
+
+			b := []byte(`{"events":[{"action":"push","target":{"repository":"bitnami/redis","tag":"6.0"},` +
+				`"request":{"host":"docker.io"}}]}`)
+			_, _, err := http.Post(server.URL+"/webhook/registry/gitlab", b,
+				http.WithHeader("Authorization", "Bearer "+registrySecret))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s2hCtrl.QueueLen()).To(Equal(0))
+		}, timeout)
+
+		Specify("Invalid registry webhook secret", func(done Done) {
+			defer close(done)
+
+			b := []byte(`{"push_data":{"tag":"5.0"},"repository":{"repo_name":"bitnami/redis"}}`)
+			_, _, err := http.Post(server.URL+"/webhook/registry/dockerhub?token=invalid", b)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(s2hCtrl.QueueLen()).To(Equal(0))
+		}, timeout)
+
+		It("should correctly parse push events from registry payloads", func() {
+			events, err := parseGitlabRegistryPushEvents([]byte(`{"events":[` +
+				`{"action":"push","target":{"repository":"group/app","tag":"1.0.0"},"request":{"host":"registry.gitlab.com"}},` +
+				`{"action":"push","target":{"repository":"group/app"},"request":{"host":"registry.gitlab.com"}},` +
+				`{"action":"pull","target":{"repository":"group/app","tag":"1.0.0"}}]}`))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(Equal([]registryPushEvent{{Repository: "registry.gitlab.com/group/app", Tag: "1.0.0"}}))
+
+			events, err = parseGHCRPushEvents([]byte(`{"registry_package":{"namespace":"Agoda-Com","name":"Samsahai",` +
+				`"package_type":"CONTAINER","package_version":{"container_metadata":{"tag":{"name":"v1"}}}}}`))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(Equal([]registryPushEvent{{Repository: "ghcr.io/agoda-com/samsahai", Tag: "v1"}}))
+
+			events, err = parseHarborPushEvents([]byte(`{"type":"DELETE_ARTIFACT","event_data":{"resources":[` +
+				`{"tag":"v1","resource_url":"harbor.local/library/app:v1"}]}}`))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(events).To(BeEmpty())
+
+			_, err = parseDockerHubPushEvents([]byte(`invalid`))
+			g.Expect(err).To(HaveOccurred())
+		})
+
+		It("should correctly match component by normalized repository and pattern", func() {
+			source := s2hv1.UpdatingSource("public-registry")
+			comp := &s2hv1.Component{
+				Name:   "redis",
+				Source: &source,
+				Image:  s2hv1.ComponentImage{Repository: "bitnami/redis", Pattern: "5.*"},
+			}
+			g.Expect(isComponentMatched(comp, "docker.io/bitnami/redis:5.0.7", "5.0.7")).To(BeTrue())
+			g.Expect(isComponentMatched(comp, "index.docker.io/bitnami/redis", "5.0.7")).To(BeTrue())
+			g.Expect(isComponentMatched(comp, "bitnami/redis", "6.0.0")).To(BeFalse())
+			g.Expect(isComponentMatched(comp, "quay.io/bitnami/redis", "5.0.7")).To(BeFalse())
+
+			comp.Source = nil
+			g.Expect(isComponentMatched(comp, "bitnami/redis", "5.0.7")).To(BeFalse())
+		})
+	})
+
 	Describe("Team", func() {
 		It("should successfully list teams", func(done Done) {
 			defer close(done)
@@ -259,6 +363,47 @@ var _ = Describe("Samsahai Webhook", func() {
 			_, _, err := http.Delete(server.URL + "/teams/" + teamName + "/environment/active/delete")
 			g.Expect(err).NotTo(HaveOccurred())
 		}, timeout)
+
+		Specify("Rollback active environment without retained namespace", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/environment/active/rollback?to=1", nil,
+				http.WithHeader(s2h.SamsahaiAuthHeader, "123456"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+	})
+
+	Describe("Queue", func() {
+		It("should not allow to manage queue without auth token", func(done Done) {
+			defer close(done)
+
+			b := []byte(`{"component":"redis","version":"5.0.7","skipTestRunner":true}`)
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/queue/enqueue", b)
+			g.Expect(err).To(HaveOccurred())
+
+			_, _, err = http.Post(server.URL+"/teams/"+teamName+"/queue/enqueue", b,
+				http.WithHeader(s2h.SamsahaiAuthHeader, "invalid"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+
+		It("should not pause or resume team queues without auth token", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/queue/pause", nil)
+			g.Expect(err).To(HaveOccurred())
+
+			_, _, err = http.Post(server.URL+"/teams/"+teamName+"/queue/resume", nil,
+				http.WithHeader(s2h.SamsahaiAuthHeader, "invalid"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+
+		It("should not retry queue when there is no failed queue", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/queue/retry", nil,
+				http.WithHeader(s2h.SamsahaiAuthHeader, "123456"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
 	})
 
 	Describe("PullRequest", func() {
@@ -328,12 +473,49 @@ var _ = Describe("Samsahai Webhook", func() {
 			g.Expect(data).NotTo(BeNil())
 		}, timeout)
 
+		It("should not get diff of active promotion which has not been created", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Get(server.URL + "/teams/" + teamName + "/activepromotions/current/diff")
+			g.Expect(err).To(HaveOccurred())
+
+			_, _, err = http.Get(server.URL + "/teams/" + teamName + "/activepromotions/activepromotion-history/diff")
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+
 		Specify("Unknown active promotion", func(done Done) {
 			defer close(done)
 
 			_, _, err := http.Get(server.URL + "/teams/unknown/activepromotions")
 			g.Expect(err).To(HaveOccurred())
 		}, timeout)
+
+		It("should not allow to manage active promotion without auth token", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/activepromotions", []byte(`{"skipTest":true}`))
+			g.Expect(err).To(HaveOccurred())
+
+			_, _, err = http.Delete(server.URL+"/teams/"+teamName+"/activepromotions/current",
+				http.WithHeader(s2h.SamsahaiAuthHeader, "invalid"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+
+		Specify("Cancel unknown active promotion", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Delete(server.URL+"/teams/unknown/activepromotions/current",
+				http.WithHeader(s2h.SamsahaiAuthHeader, "123456"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
+
+		Specify("Invalid tear down duration", func(done Done) {
+			defer close(done)
+
+			_, _, err := http.Post(server.URL+"/teams/"+teamName+"/activepromotions",
+				[]byte(`{"tearDownDuration":"invalid"}`), http.WithHeader(s2h.SamsahaiAuthHeader, "123456"))
+			g.Expect(err).To(HaveOccurred())
+		}, timeout)
 	})
 })
 
@@ -190,6 +190,8 @@ func (c *controller) Reconcile(req cr.Request) (cr.Result, error) {
 		return reconcile.Result{}, nil
 	}
 
+	isUnpinned := team.Status.GetStableComponent(stableComp.Name).Spec.Pinned && !stableComp.Spec.Pinned
+
 	now := metav1.Now()
 	stableComp.Status.UpdatedAt = &now
 	if stableComp.Status.CreatedAt == nil {
@@ -215,6 +217,15 @@ func (c *controller) Reconcile(req cr.Request) (cr.Result, error) {
 		return cr.Result{}, err
 	}
 
+	// desired component was ignored during pinning, touch it for adding the desired version to queue again
+	if isUnpinned {
+		desiredComp.Status.UpdatedAt = &now
+		if err := c.client.Update(ctx, desiredComp); err != nil {
+			logger.Error(err, "cannot update DesiredComponent", "name", req.Name, "namespace", req.Namespace)
+			return cr.Result{}, err
+		}
+	}
+
 	queueList, err := c.s2hCtrl.GetQueues(req.Namespace)
 	if err != nil {
 		return cr.Result{}, err
@@ -260,7 +271,8 @@ func (c *controller) updateQueue(ctx context.Context, q s2hv1.Queue) error {
 func (c *controller) removeSameVersionQueue(queueList *s2hv1.QueueList, stableComp *s2hv1.StableComponent, desiredComp *s2hv1.DesiredComponent) (removeQueue, updateQueue s2hv1.Queue) {
 	removeQueue, updateQueue = s2hv1.Queue{}, s2hv1.Queue{}
 	if stableComp.Spec.Repository == desiredComp.Spec.Repository &&
-		stableComp.Spec.Version == desiredComp.Spec.Version {
+		stableComp.Spec.Version == desiredComp.Spec.Version &&
+		stableComp.Spec.ChartVersion == desiredComp.Spec.ChartVersion {
 		for _, queue := range queueList.Items {
 
 			var validComponents []*s2hv1.QueueComponent
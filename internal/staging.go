@@ -11,6 +11,9 @@ import (
 type StagingConfig struct {
 	// MaxHistoryDays defines maximum days of QueueHistory stored
 	MaxHistoryDays int `json:"maxHistoryDays" yaml:"maxHistoryDays"`
+
+	// Slots defines number of staging namespaces for verifying queues in parallel
+	Slots int `json:"slots" yaml:"slots"`
 }
 
 type StagingTestRunner interface {
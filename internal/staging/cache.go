@@ -0,0 +1,121 @@
+package staging
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// slotCache is a cache of all staging slot namespaces,
+// cluster-scoped objects are read from cache of the staging namespace
+// as multi-namespaced cache cannot get objects without namespace
+type slotCache struct {
+	cache.Cache
+
+	clusterScoped cache.Cache
+	scheme        *runtime.Scheme
+	mapper        meta.RESTMapper
+}
+
+// NewSlotCacheBuilder returns a cache builder which watches resources in all staging slot namespaces,
+// the first namespace has to be the staging namespace
+func NewSlotCacheBuilder(namespaces []string) cache.NewCacheFunc {
+	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
+		nsCache, err := cache.MultiNamespacedCacheBuilder(namespaces)(config, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		opts.Namespace = namespaces[0]
+		clusterScoped, err := cache.New(config, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &slotCache{
+			Cache:         nsCache,
+			clusterScoped: clusterScoped,
+			scheme:        opts.Scheme,
+			mapper:        opts.Mapper,
+		}, nil
+	}
+}
+
+func (c *slotCache) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if key.Namespace == "" {
+		return c.clusterScoped.Get(ctx, key, obj)
+	}
+
+	return c.Cache.Get(ctx, key, obj)
+}
+
+func (c *slotCache) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	if c.isClusterScoped(list) {
+		return c.clusterScoped.List(ctx, list, opts...)
+	}
+
+	return c.Cache.List(ctx, list, opts...)
+}
+
+func (c *slotCache) GetInformer(ctx context.Context, obj runtime.Object) (cache.Informer, error) {
+	if c.isClusterScoped(obj) {
+		return c.clusterScoped.GetInformer(ctx, obj)
+	}
+
+	return c.Cache.GetInformer(ctx, obj)
+}
+
+func (c *slotCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (cache.Informer, error) {
+	if c.isClusterScopedKind(gvk) {
+		return c.clusterScoped.GetInformerForKind(ctx, gvk)
+	}
+
+	return c.Cache.GetInformerForKind(ctx, gvk)
+}
+
+func (c *slotCache) Start(stopCh <-chan struct{}) error {
+	go func() {
+		if err := c.clusterScoped.Start(stopCh); err != nil {
+			logger.Error(err, "cluster-scoped cache failed to start")
+		}
+	}()
+
+	return c.Cache.Start(stopCh)
+}
+
+func (c *slotCache) WaitForCacheSync(stop <-chan struct{}) bool {
+	return c.clusterScoped.WaitForCacheSync(stop) && c.Cache.WaitForCacheSync(stop)
+}
+
+func (c *slotCache) isClusterScoped(obj runtime.Object) bool {
+	gvk, err := apiutil.GVKForObject(obj, c.scheme)
+	if err != nil {
+		return false
+	}
+
+	if meta.IsListType(obj) {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	return c.isClusterScopedKind(gvk)
+}
+
+func (c *slotCache) isClusterScopedKind(gvk schema.GroupVersionKind) bool {
+	if c.mapper == nil {
+		return false
+	}
+
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false
+	}
+
+	return mapping.Scope.Name() == meta.RESTScopeNameRoot
+}
@@ -76,6 +76,21 @@ func (c *controller) collectResult(queue *s2hv1.Queue) error {
 func (c *controller) setStableAndSendReport(queue *s2hv1.Queue) error {
 	isDeploySuccess, isTestSuccess, isReverify := queue.IsDeploySuccess(), queue.IsTestSuccess(), queue.IsReverify()
 
+	if queue.IsBatchQueue() {
+		// failure batch will be bisected, the result of each component will be reported after that,
+		// the failure is recorded in histories of all items so that it can be traced from each component
+		if !isDeploySuccess || !isTestSuccess {
+			_, err := c.createBatchItemHistories(queue)
+			return err
+		}
+
+		if err := c.setStableComponent(queue); err != nil {
+			return err
+		}
+
+		return c.sendBatchItemReports(queue)
+	}
+
 	compUpgradeStatus := rpc.ComponentUpgrade_UpgradeStatus_FAILURE
 	if isDeploySuccess && isTestSuccess && !isReverify {
 		// success deploy and test without reverify state
@@ -94,10 +109,44 @@ func (c *controller) setStableAndSendReport(queue *s2hv1.Queue) error {
 	return nil
 }
 
+// sendBatchItemReports creates queue history and sends component upgrade report of each item in batch queue
+func (c *controller) sendBatchItemReports(q *s2hv1.Queue) error {
+	itemQueues, err := c.createBatchItemHistories(q)
+	if err != nil {
+		return err
+	}
+
+	for _, itemQueue := range itemQueues {
+		if err := c.sendComponentUpgradeReport(rpc.ComponentUpgrade_UpgradeStatus_SUCCESS, itemQueue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createBatchItemHistories creates queue history containing result of the batch queue for each item,
+// queues of the items are returned
+func (c *controller) createBatchItemHistories(q *s2hv1.Queue) ([]*s2hv1.Queue, error) {
+	itemQueues := make([]*s2hv1.Queue, 0)
+	for _, item := range q.Spec.Batch {
+		itemQueue := queue.NewBatchItemQueue(q, item)
+		itemQueue.Status.QueueHistoryName = generateQueueHistoryName(item.Name)
+
+		if err := c.createQueueHistory(itemQueue); err != nil {
+			return nil, err
+		}
+
+		itemQueues = append(itemQueues, itemQueue)
+	}
+
+	return itemQueues, nil
+}
+
 func (c *controller) createQueueHistory(q *s2hv1.Queue) error {
 	ctx := context.TODO()
 
-	if err := c.deleteQueueHistoryOutOfRange(ctx, c.namespace); err != nil {
+	if err := c.deleteQueueHistoryOutOfRange(ctx, c.queueNamespace); err != nil {
 		return err
 	}
 
@@ -118,7 +167,7 @@ func (c *controller) createQueueHistory(q *s2hv1.Queue) error {
 	history := &s2hv1.QueueHistory{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      q.Status.QueueHistoryName,
-			Namespace: c.namespace,
+			Namespace: c.queueNamespace,
 			Labels:    q.Labels,
 		},
 		Spec: spec,
@@ -198,6 +247,10 @@ func (c *controller) deleteQueueHistoryOutOfRange(ctx context.Context, namespace
 func (c *controller) setStableComponent(queue *s2hv1.Queue) (err error) {
 	const updatedBy = "samsahai"
 
+	// stable components are shared among staging slots
+	c.mtStable.Lock()
+	defer c.mtStable.Unlock()
+
 	for _, qComp := range queue.Spec.Components {
 		stableComp := &s2hv1.StableComponent{}
 		err = c.client.Get(
@@ -215,10 +268,12 @@ func (c *controller) setStableComponent(queue *s2hv1.Queue) (err error) {
 					Labels:    stableLabels,
 				},
 				Spec: s2hv1.StableComponentSpec{
-					Name:       qComp.Name,
-					Version:    qComp.Version,
-					Repository: qComp.Repository,
-					UpdatedBy:  updatedBy,
+					Name:         qComp.Name,
+					Version:      qComp.Version,
+					Repository:   qComp.Repository,
+					ChartVersion: qComp.ChartVersion,
+					UpdatedBy:    updatedBy,
+					Pinned:       queue.Spec.Pin,
 				},
 				Status: s2hv1.StableComponentStatus{
 					CreatedAt: &now,
@@ -238,15 +293,27 @@ func (c *controller) setStableComponent(queue *s2hv1.Queue) (err error) {
 			return err
 		}
 
+		if stableComp.Spec.Pinned && !queue.Spec.Pin {
+			// the pinned version stays until it is unpinned manually
+			continue
+		}
+
 		if stableComp.Spec.Version == qComp.Version &&
-			stableComp.Spec.Repository == qComp.Repository {
+			stableComp.Spec.Repository == qComp.Repository &&
+			stableComp.Spec.ChartVersion == qComp.ChartVersion &&
+			(stableComp.Spec.Pinned || !queue.Spec.Pin) {
 			// no change
 			continue
 		}
 
 		stableComp.Spec.Repository = qComp.Repository
 		stableComp.Spec.Version = qComp.Version
+		stableComp.Spec.ChartVersion = qComp.ChartVersion
 		stableComp.Spec.UpdatedBy = updatedBy
+		if queue.Spec.Pin {
+			// the pinned version stays until it is unpinned manually
+			stableComp.Spec.Pinned = true
+		}
 
 		err = c.client.Update(context.TODO(), stableComp)
 		if err != nil {
@@ -398,7 +465,7 @@ func (c *controller) sendComponentUpgradeReport(status rpc.ComponentUpgrade_Upgr
 		return errors.Wrap(err, "cannot set request header")
 	}
 
-	comp := queue.GetComponentUpgradeRPCFromQueue(status, q.Status.QueueHistoryName, c.namespace, q, nil)
+	comp := queue.GetComponentUpgradeRPCFromQueue(status, q.Status.QueueHistoryName, c.queueNamespace, q, nil)
 
 	if c.s2hClient != nil {
 		_, err = c.s2hClient.RunPostComponentUpgrade(ctx, comp)
@@ -1,12 +1,18 @@
 package staging
 
 import (
+	"context"
+	"sync"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 )
@@ -377,3 +383,63 @@ var _ = Describe("Set deployment issues in Queue", func() {
 		})
 	})
 })
+
+var _ = Describe("Set stable component", func() {
+	g := NewWithT(GinkgoT())
+
+	namespace := "s2h-teamtest"
+
+	newController := func(objs ...runtime.Object) *controller {
+		scheme := runtime.NewScheme()
+		g.Expect(s2hv1.AddToScheme(scheme)).To(Succeed())
+
+		return &controller{
+			teamName: "teamtest",
+			client:   fake.NewFakeClientWithScheme(scheme, objs...),
+			mtStable: &sync.Mutex{},
+		}
+	}
+	newQueue := func(version string, pin bool) *s2hv1.Queue {
+		return &s2hv1.Queue{
+			ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: namespace},
+			Spec: s2hv1.QueueSpec{
+				Name: "redis",
+				Pin:  pin,
+				Components: s2hv1.QueueComponents{
+					{Name: "redis", Repository: "bitnami/redis", Version: version},
+				},
+			},
+		}
+	}
+	pinnedStableComp := &s2hv1.StableComponent{
+		ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: namespace},
+		Spec: s2hv1.StableComponentSpec{
+			Name:       "redis",
+			Repository: "bitnami/redis",
+			Version:    "5.0.5",
+			Pinned:     true,
+		},
+	}
+
+	It("should not overwrite pinned stable component by non-pin queue", func() {
+		c := newController(pinnedStableComp.DeepCopy())
+		g.Expect(c.setStableComponent(newQueue("5.0.7", false))).To(Succeed())
+
+		stableComp := &s2hv1.StableComponent{}
+		g.Expect(c.client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: "redis"},
+			stableComp)).To(Succeed())
+		g.Expect(stableComp.Spec.Version).To(Equal("5.0.5"))
+		g.Expect(stableComp.Spec.Pinned).To(BeTrue())
+	})
+
+	It("should update pinned stable component by pin queue", func() {
+		c := newController(pinnedStableComp.DeepCopy())
+		g.Expect(c.setStableComponent(newQueue("5.0.7", true))).To(Succeed())
+
+		stableComp := &s2hv1.StableComponent{}
+		g.Expect(c.client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: "redis"},
+			stableComp)).To(Succeed())
+		g.Expect(stableComp.Spec.Version).To(Equal("5.0.7"))
+		g.Expect(stableComp.Spec.Pinned).To(BeTrue())
+	})
+})
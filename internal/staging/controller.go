@@ -24,6 +24,7 @@ import (
 	"github.com/agoda-com/samsahai/internal"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	s2hlog "github.com/agoda-com/samsahai/internal/log"
+	"github.com/agoda-com/samsahai/internal/queue"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/helm3"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
 	"github.com/agoda-com/samsahai/internal/staging/testrunner/gitlab"
@@ -49,6 +50,10 @@ type controller struct {
 	client     client.Client
 	scheme     *apiruntime.Scheme
 
+	// queueNamespace represents a namespace of queues, queue histories and stable components,
+	// it differs from namespace which components are deployed into only in additional staging slots
+	queueNamespace string
+
 	internalStop    <-chan struct{}
 	internalStopper chan<- struct{}
 	rpcHandler      stagingrpc.TwirpServer
@@ -57,6 +62,13 @@ type controller struct {
 	mtQueue      sync.Mutex
 	s2hClient    samsahairpc.RPC
 
+	// slots represents controllers of additional staging namespaces which verify queues in parallel
+	slots []*controller
+	// mtPick serializes picking queue among staging slots
+	mtPick *sync.Mutex
+	// mtStable serializes updating stable components among staging slots
+	mtStable *sync.Mutex
+
 	lastAppliedValues       map[string]interface{}
 	lastStableComponentList s2hv1.StableComponentList
 
@@ -97,6 +109,7 @@ func NewController(
 		testRunners:             map[string]internal.StagingTestRunner{},
 		teamName:                teamName,
 		namespace:               namespace,
+		queueNamespace:          namespace,
 		authToken:               authToken,
 		s2hClient:               s2hClient,
 		queueCtrl:               queueCtrl,
@@ -113,6 +126,8 @@ func NewController(
 		gitlabBaseURL:           gitlabBaseURL,
 		gitlabToken:             gitlabToken,
 		configs:                 configs,
+		mtPick:                  &sync.Mutex{},
+		mtStable:                &sync.Mutex{},
 	}
 
 	c.rpcHandler = stagingrpc.NewRPCServer(c, nil)
@@ -120,22 +135,61 @@ func NewController(
 	c.loadDeployEngines()
 	c.loadTestRunners()
 
+	for slot := 1; slot < configs.Slots; slot++ {
+		c.slots = append(c.slots, c.newSlot(internal.GenStagingSlotNamespace(teamName, slot)))
+	}
+
 	return c
 }
 
+// newSlot creates a controller which deploys components into the given staging slot namespace,
+// queues and stable components are shared with the parent controller
+func (c *controller) newSlot(namespace string) *controller {
+	slot := &controller{
+		deployEngines:           map[string]internal.DeployEngine{},
+		testRunners:             c.testRunners,
+		teamName:                c.teamName,
+		namespace:               namespace,
+		queueNamespace:          c.queueNamespace,
+		authToken:               c.authToken,
+		s2hClient:               c.s2hClient,
+		queueCtrl:               c.queueCtrl,
+		configCtrl:              c.configCtrl,
+		client:                  c.client,
+		scheme:                  c.scheme,
+		internalStop:            c.internalStop,
+		lastAppliedValues:       nil,
+		lastStableComponentList: s2hv1.StableComponentList{},
+		teamcityBaseURL:         c.teamcityBaseURL,
+		teamcityUsername:        c.teamcityUsername,
+		teamcityPassword:        c.teamcityPassword,
+		gitlabBaseURL:           c.gitlabBaseURL,
+		gitlabToken:             c.gitlabToken,
+		configs:                 c.configs,
+		mtPick:                  c.mtPick,
+		mtStable:                c.mtStable,
+	}
+
+	slot.loadDeployEngines()
+
+	return slot
+}
+
 func (c *controller) Start(stop <-chan struct{}) {
 	defer close(c.internalStopper)
 
-	concurrentProcess := 1
+	// each staging slot has its own worker
+	workers := append([]*controller{c}, c.slots...)
 	jitterPeriod := time.Millisecond * 1000
-	for i := 0; i < concurrentProcess; i++ {
+	for i := range workers {
+		worker := workers[i]
 		go wait.Until(func() {
-			for c.process() {
+			for worker.process() {
 			}
 		}, jitterPeriod, c.internalStop)
 	}
 
-	logger.Debug(fmt.Sprintf("%s is running", internal.StagingCtrlName))
+	logger.Debug(fmt.Sprintf("%s is running", internal.StagingCtrlName), "slots", len(workers))
 
 	<-stop
 
@@ -145,11 +199,13 @@ func (c *controller) Start(stop <-chan struct{}) {
 func (c *controller) process() bool {
 	var err error
 	if c.getCurrentQueue() == nil {
+		c.mtPick.Lock()
 		c.mtQueue.Lock()
 		// pick new queue
-		obj, err := c.queueCtrl.First(c.namespace)
+		obj, err := c.queueCtrl.FirstInSlot(c.queueNamespace, c.namespace)
+		c.mtPick.Unlock()
 		if err != nil {
-			logger.Error(err, "cannot pick the first component of queue")
+			logger.Error(err, "cannot pick the first component of queue", "namespace", c.namespace)
 			c.mtQueue.Unlock()
 			return false
 		}
@@ -270,7 +326,17 @@ func (c *controller) loadTestRunners() {
 }
 
 func (c *controller) IsBusy() bool {
-	return c.getCurrentQueue() != nil
+	if c.getCurrentQueue() != nil {
+		return true
+	}
+
+	for _, slot := range c.slots {
+		if slot.getCurrentQueue() != nil {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (c *controller) LoadTestRunner(runner internal.StagingTestRunner) {
@@ -285,6 +351,10 @@ func (c *controller) LoadDeployEngine(engine internal.DeployEngine) {
 		return
 	}
 	c.deployEngines[engine.GetName()] = engine
+
+	for _, slot := range c.slots {
+		slot.deployEngines[engine.GetName()] = engine
+	}
 }
 
 // isQueueValid returns true if Queue not in Deleting and Cancelling state
@@ -323,6 +393,12 @@ func (c *controller) syncQueueWithK8s() error {
 }
 
 func (c *controller) initQueue(q *s2hv1.Queue) error {
+	q, err := c.batchQueue(q)
+	if err != nil {
+		logger.Error(err, "cannot create batch queue", "queue", q.Name, "namespace", c.namespace)
+		return err
+	}
+
 	deployConfig := c.getDeployConfiguration(q)
 	if deployConfig == nil {
 		err := fmt.Errorf("cannot get deployment configuration, namespace: %s, queue: %s", c.namespace, q.Name)
@@ -343,6 +419,38 @@ func (c *controller) initQueue(q *s2hv1.Queue) error {
 	return c.updateQueueWithState(q, s2hv1.CleaningBefore)
 }
 
+// batchQueue merges the waiting upgrade queues into a batch queue and makes it the current queue,
+// the given queue will be returned if batch verification is disabled
+func (c *controller) batchQueue(q *s2hv1.Queue) (*s2hv1.Queue, error) {
+	if !q.IsBatchable() {
+		return q, nil
+	}
+
+	cfg, err := c.getConfiguration()
+	if err != nil {
+		return q, err
+	}
+
+	if cfg.Staging == nil || cfg.Staging.Batch == nil {
+		return q, nil
+	}
+
+	// prevent other staging slots from picking the batch members
+	c.mtPick.Lock()
+	defer c.mtPick.Unlock()
+
+	batch, err := queue.CreateBatchQueue(c.client, q, cfg.Staging.Batch.MaxSize)
+	if err != nil {
+		return q, err
+	}
+
+	c.mtQueue.Lock()
+	c.currentQueue = batch
+	c.mtQueue.Unlock()
+
+	return batch, nil
+}
+
 func (c *controller) cleanBefore(queue *s2hv1.Queue) error {
 	deployEngine := c.getDeployEngine(queue)
 	parentComps, err := c.configCtrl.GetParentComponents(c.teamName)
@@ -25,6 +25,7 @@ import (
 	configctrl "github.com/agoda-com/samsahai/internal/config"
 	s2herrors "github.com/agoda-com/samsahai/internal/errors"
 	"github.com/agoda-com/samsahai/internal/third_party/k8s.io/kubernetes/deployment/util"
+	"github.com/agoda-com/samsahai/internal/util/dotaccess"
 	"github.com/agoda-com/samsahai/internal/util/valuesutil"
 	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
 )
@@ -73,8 +74,37 @@ func (c *controller) deployEnvironment(queue *s2hv1.Queue) error {
 		}
 	}
 
+	// releases of the queue might have been deployed before the controller restarted,
+	// they are checked again instead of being redeployed
+	if queue.Status.IsConditionUnknown(s2hv1.QueueDeployStarted) {
+		isInstalled, err := c.isQueueReleasesInstalled(deployEngine, queue)
+		if err != nil {
+			return err
+		}
+
+		if isInstalled {
+			logger.Info("releases have been installed, resume checking the deployment", "queue", queue.Name)
+			queue.Status.SetCondition(
+				s2hv1.QueueDeployStarted,
+				corev1.ConditionTrue,
+				"queue started to deploy")
+			if err := c.updateQueue(queue); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Deploy
 	if !queue.Status.IsConditionTrue(s2hv1.QueueDeployStarted) {
+		// mark the queue as deploying so that the deployment can be resumed after restarting
+		queue.Status.SetCondition(
+			s2hv1.QueueDeployStarted,
+			corev1.ConditionUnknown,
+			"queue is being deployed")
+		if err := c.updateQueue(queue); err != nil {
+			return err
+		}
+
 		isDeployed, err := c.deployComponents(deployEngine, queue, queueComps, queueParentComps, deployTimeout.Duration)
 		if err != nil {
 			if !isDeployed {
@@ -316,7 +346,7 @@ func (c *controller) validateStagingQueue(queue *s2hv1.Queue) (bool, error) {
 func (c *controller) getStableComponentsMapFromQueueType(q *s2hv1.Queue) (
 	stableMap map[string]s2hv1.StableComponent, err error) {
 
-	namespace := c.namespace
+	namespace := c.queueNamespace
 	if q.IsPullRequestQueue() {
 		namespace, err = c.getTeamActiveNamespace()
 		if err != nil {
@@ -376,6 +406,29 @@ func genCompValueFromQueue(compName string, qComps []*s2hv1.QueueComponent) map[
 	return map[string]interface{}{}
 }
 
+// genChartVersionFromQueue returns chart version of the component from queue components
+func genChartVersionFromQueue(compName string, qComps []*s2hv1.QueueComponent) string {
+	for _, qComp := range qComps {
+		if qComp.Name == compName {
+			return qComp.ChartVersion
+		}
+	}
+
+	return ""
+}
+
+// withChartVersion returns a copy of the component which uses the given chart version,
+// the component will be returned as is if the chart version is empty
+func withChartVersion(comp *s2hv1.Component, chartVersion string) *s2hv1.Component {
+	if chartVersion == "" || chartVersion == comp.Chart.Version {
+		return comp
+	}
+
+	chartComp := comp.DeepCopy()
+	chartComp.Chart.Version = chartVersion
+	return chartComp
+}
+
 // applyEnvBaseConfig applies input values with specific env. configuration based on Queue.Spec.Type
 func applyEnvBaseConfig(
 	cfg *s2hv1.ConfigSpec,
@@ -566,7 +619,8 @@ func (c *controller) deployComponentsExceptQueue(
 			}
 		default:
 			values = applyEnvBaseConfig(cfg, values, queue.Spec.Type, comp, c.teamName)
-			if err := deployEngine.Create(c.genReleaseName(comp), comp, comp, values, &deployTimeout); err != nil {
+			chartComp := withChartVersion(comp, stableMap[name].Spec.ChartVersion)
+			if err := deployEngine.Create(c.genReleaseName(comp), chartComp, chartComp, values, &deployTimeout); err != nil {
 				return true, err
 			}
 		}
@@ -648,8 +702,14 @@ func (c *controller) deployQueueComponent(
 				}
 			}
 
+			chartVersion := stableMap[parentName].Spec.ChartVersion
+			if v := genChartVersionFromQueue(parentName, queue.Spec.Components); v != "" {
+				chartVersion = v
+			}
+			chartComp := withChartVersion(parentComp, chartVersion)
+
 			values = applyEnvBaseConfig(cfg, values, queue.Spec.Type, parentComp, c.teamName)
-			err = deployEngine.Create(c.genReleaseName(parentComp), parentComp, parentComp, values, &deployTimeout)
+			err = deployEngine.Create(c.genReleaseName(parentComp), chartComp, chartComp, values, &deployTimeout)
 			if err != nil {
 				errCh <- err
 				return
@@ -673,6 +733,78 @@ func (c *controller) deployQueueComponent(
 	return nil
 }
 
+// isQueueReleasesInstalled returns true if releases of all components of the queue have been installed,
+// releases of promoting and demoting queues already exist before deploying so they are always redeployed
+func (c *controller) isQueueReleasesInstalled(deployEngine internal.DeployEngine, q *s2hv1.Queue) (bool, error) {
+	if q.Spec.Type == s2hv1.QueueTypePromoteToActive || q.Spec.Type == s2hv1.QueueTypeDemoteFromActive {
+		return false, nil
+	}
+
+	if deployEngine.IsMocked() {
+		return true, nil
+	}
+
+	releases, err := deployEngine.GetReleases()
+	if err != nil {
+		return false, err
+	}
+
+	installed := make(map[string]*release.Release)
+	for _, rel := range releases {
+		installed[rel.Name] = rel
+	}
+
+	parentComps, queueComps, err := c.getParentAndQueueCompsFromQueueType(q)
+	if err != nil {
+		return false, err
+	}
+
+	for _, comp := range parentComps {
+		if _, ok := installed[c.genReleaseName(comp)]; !ok {
+			return false, nil
+		}
+	}
+
+	// releases might have been left from the previous queue, they have to be deployed with versions of the queue
+	for _, qComp := range q.Spec.Components {
+		comp, ok := queueComps[qComp.Name]
+		if !ok {
+			continue
+		}
+
+		parentName, imagePath := comp.Name, "image"
+		if comp.Parent != "" {
+			parentName, imagePath = comp.Parent, comp.Name+".image"
+		}
+
+		rel := installed[internal.GenReleaseName(c.namespace, parentName)]
+		if rel == nil || !isReleaseDeployedWithVersion(rel, imagePath, qComp) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// isReleaseDeployedWithVersion returns true if the release has been deployed
+// with the image tag and chart version of the queue component
+func isReleaseDeployedWithVersion(rel *release.Release, imagePath string, qComp *s2hv1.QueueComponent) bool {
+	if qComp.Version != "" {
+		tag, err := dotaccess.Get(rel.Config, imagePath+".tag")
+		if err != nil || fmt.Sprintf("%v", tag) != qComp.Version {
+			return false
+		}
+	}
+
+	if qComp.ChartVersion != "" {
+		if rel.Chart == nil || rel.Chart.Metadata == nil || rel.Chart.Metadata.Version != qComp.ChartVersion {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (c *controller) waitForComponentsReady(deployEngine internal.DeployEngine, q *s2hv1.Queue) (bool, error) {
 	parentComps, _, err := c.getParentAndQueueCompsFromQueueType(q)
 	if err != nil {
@@ -0,0 +1,108 @@
+package staging
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
+)
+
+// releasesEngine is a deploy engine which returns the given releases
+type releasesEngine struct {
+	internal.DeployEngine
+	releases []*release.Release
+}
+
+func (e *releasesEngine) GetReleases() ([]*release.Release, error) {
+	return e.releases, nil
+}
+
+func (e *releasesEngine) IsMocked() bool {
+	return false
+}
+
+var _ = Describe("Resume deploying queue", func() {
+	g := NewWithT(GinkgoT())
+
+	namespace := "s2h-teamtest"
+	c := &controller{
+		teamName:   "teamtest",
+		namespace:  namespace,
+		configCtrl: newMockConfigCtrl(),
+	}
+
+	queue := &s2hv1.Queue{
+		ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: namespace},
+		Spec: s2hv1.QueueSpec{
+			Name: "wordpress",
+			Type: s2hv1.QueueTypeUpgrade,
+			Components: s2hv1.QueueComponents{
+				{Name: "redis", Version: "5.0.7", ChartVersion: "10.5.0"},
+				{Name: "wordpress", Version: "5.2.4"},
+				{Name: "mariadb", Version: "10.3.20"},
+			},
+		},
+	}
+
+	newRelease := func(compName, chartVersion string, values map[string]interface{}) *release.Release {
+		return &release.Release{
+			Name:   internal.GenReleaseName(namespace, compName),
+			Chart:  &chart.Chart{Metadata: &chart.Metadata{Name: compName, Version: chartVersion}},
+			Config: values,
+		}
+	}
+	imageValues := func(tag string) map[string]interface{} {
+		return map[string]interface{}{"tag": tag}
+	}
+	redisRelease := newRelease("redis", "10.5.0", map[string]interface{}{
+		"image": imageValues("5.0.7"),
+	})
+	wordpressRelease := newRelease("wordpress", "9.0.0", map[string]interface{}{
+		"image":   imageValues("5.2.4"),
+		"mariadb": map[string]interface{}{"image": imageValues("10.3.20")},
+	})
+
+	It("should redeploy if some releases of the queue have not been installed", func() {
+		engine := &releasesEngine{DeployEngine: mock.New(), releases: []*release.Release{redisRelease}}
+
+		isInstalled, err := c.isQueueReleasesInstalled(engine, queue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isInstalled).To(BeFalse())
+	})
+
+	It("should redeploy if releases have been installed with other versions", func() {
+		staleWordpressRelease := newRelease("wordpress", "9.0.0", map[string]interface{}{
+			"image":   imageValues("5.2.4"),
+			"mariadb": map[string]interface{}{"image": imageValues("10.3.19")},
+		})
+		engine := &releasesEngine{DeployEngine: mock.New(),
+			releases: []*release.Release{redisRelease, staleWordpressRelease}}
+
+		isInstalled, err := c.isQueueReleasesInstalled(engine, queue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isInstalled).To(BeFalse())
+
+		staleRedisRelease := newRelease("redis", "10.4.0", map[string]interface{}{
+			"image": imageValues("5.0.7"),
+		})
+		engine.releases = []*release.Release{staleRedisRelease, wordpressRelease}
+
+		isInstalled, err = c.isQueueReleasesInstalled(engine, queue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isInstalled).To(BeFalse())
+	})
+
+	It("should resume deploying if all releases of the queue have been installed", func() {
+		engine := &releasesEngine{DeployEngine: mock.New(),
+			releases: []*release.Release{redisRelease, wordpressRelease}}
+
+		isInstalled, err := c.isQueueReleasesInstalled(engine, queue)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(isInstalled).To(BeTrue())
+	})
+})
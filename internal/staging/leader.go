@@ -0,0 +1,38 @@
+package staging
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/agoda-com/samsahai/internal"
+)
+
+// SetLeaderLabel marks the staging controller pod whether it is the elected leader or not,
+// the staging controller service routes requests only to the pod which is marked as the leader
+func SetLeaderLabel(c client.Client, namespace, podName string, isLeader bool) error {
+	ctx := context.TODO()
+	pod := &corev1.Pod{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: podName}, pod); err != nil {
+		return errors.Wrapf(err, "cannot get pod %s", podName)
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	if isLeader {
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[internal.StagingCtrlLeaderLabel] = "true"
+	} else {
+		delete(pod.Labels, internal.StagingCtrlLeaderLabel)
+	}
+
+	if err := c.Patch(ctx, pod, patch); err != nil {
+		return errors.Wrapf(err, "cannot patch leader label of pod %s", podName)
+	}
+
+	return nil
+}
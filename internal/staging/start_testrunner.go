@@ -26,6 +26,11 @@ const (
 )
 
 func (c *controller) startTesting(queue *s2hv1.Queue) error {
+	if testConfig := c.getTestConfiguration(queue); testConfig != nil && len(testConfig.Stages) > 0 &&
+		!queue.Spec.SkipTestRunner {
+		return c.startTestStages(queue, testConfig)
+	}
+
 	testingTimeout := metav1.Duration{Duration: testTimeout}
 	if testConfig := c.getTestConfiguration(queue); testConfig != nil && testConfig.Timeout.Duration != 0 {
 		testingTimeout = testConfig.Timeout
@@ -44,8 +49,9 @@ func (c *controller) startTesting(queue *s2hv1.Queue) error {
 	}
 
 	// trigger the tests
+	testConfig := c.getTestConfiguration(queue)
 	for _, testRunner := range testRunners {
-		if err := c.triggerTest(queue, testRunner); err != nil {
+		if err := c.triggerTest(queue, testConfig, testRunner); err != nil {
 			return err
 		}
 	}
@@ -68,7 +74,7 @@ func (c *controller) startTesting(queue *s2hv1.Queue) error {
 	message := "queue testing succeeded"
 	for _, testRunner := range testRunners {
 		testRunnerName := testRunner.GetName()
-		testResult, err := c.getTestResult(queue, testRunner)
+		testResult, err := c.getTestResult(testConfig, testRunner)
 		if err != nil {
 			return err
 		}
@@ -147,16 +153,7 @@ func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
 
 	skipTest = false
 
-	if testConfig.Teamcity != nil {
-		testRunners = append(testRunners, c.testRunners[teamcity.TestRunnerName])
-	}
-	if testConfig.Gitlab != nil {
-		testRunners = append(testRunners, c.testRunners[gitlab.TestRunnerName])
-	}
-	if testConfig.TestMock != nil {
-		testRunners = append(testRunners, c.testRunners[testmock.TestRunnerName])
-	}
-
+	testRunners = c.getTestRunners(testConfig)
 	if len(testRunners) == 0 {
 		if err = c.updateTestQueueCondition(queue, v1.ConditionFalse, "test runner not found"); err != nil {
 			return
@@ -174,10 +171,34 @@ func (c *controller) checkTestConfig(queue *s2hv1.Queue) (
 	return
 }
 
-func (c *controller) triggerTest(queue *s2hv1.Queue, testRunner internal.StagingTestRunner) error {
+// getTestRunners returns test runners which are defined in test configuration
+func (c *controller) getTestRunners(testConfig *s2hv1.ConfigTestRunner) []internal.StagingTestRunner {
+	testRunners := make([]internal.StagingTestRunner, 0)
+	if testConfig.Teamcity != nil {
+		testRunners = append(testRunners, c.testRunners[teamcity.TestRunnerName])
+	}
+	if testConfig.Gitlab != nil {
+		testRunners = append(testRunners, c.testRunners[gitlab.TestRunnerName])
+	}
+	if testConfig.TestMock != nil {
+		testRunners = append(testRunners, c.testRunners[testmock.TestRunnerName])
+	}
+
+	return testRunners
+}
+
+func (c *controller) triggerTest(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner,
+	testRunner internal.StagingTestRunner) error {
+
 	if !queue.Status.IsConditionTrue(s2hv1.QueueTestTriggered) {
 		testRunnerName := testRunner.GetName()
-		testConfig := c.getTestConfiguration(queue)
+
+		// the test has been triggered before the controller restarted, resume polling the result
+		if isTestTriggered(queue, testRunnerName) {
+			logger.Info("test has been triggered, resume getting the result",
+				"queue", queue.Name, "name", testRunnerName)
+			return nil
+		}
 
 		if err := testRunner.Trigger(testConfig, c.getCurrentQueue()); err != nil {
 			logger.Error(err, "testing triggered error", "name", testRunnerName)
@@ -193,9 +214,22 @@ func (c *controller) triggerTest(queue *s2hv1.Queue, testRunner internal.Staging
 	return nil
 }
 
-func (c *controller) getTestResult(queue *s2hv1.Queue, testRunner internal.StagingTestRunner) (testResult, error) {
+// isTestTriggered returns true if the build of the test runner has been recorded in the queue
+func isTestTriggered(queue *s2hv1.Queue, testRunnerName string) bool {
+	switch testRunnerName {
+	case teamcity.TestRunnerName:
+		return queue.Status.TestRunner.Teamcity.BuildID != ""
+	case gitlab.TestRunnerName:
+		return queue.Status.TestRunner.Gitlab.PipelineID != ""
+	default:
+		return false
+	}
+}
+
+func (c *controller) getTestResult(testConfig *s2hv1.ConfigTestRunner, testRunner internal.StagingTestRunner) (
+	testResult, error) {
+
 	testRunnerName := testRunner.GetName()
-	testConfig := c.getTestConfiguration(queue)
 	isResultSuccess, isBuildFinished, err := testRunner.GetResult(testConfig, c.getCurrentQueue())
 	if err != nil {
 		logger.Error(err, "testing get result error", "name", testRunnerName)
@@ -204,8 +238,8 @@ func (c *controller) getTestResult(queue *s2hv1.Queue, testRunner internal.Stagi
 
 	if !isBuildFinished {
 		pollingTime := metav1.Duration{Duration: testPolling}
-		if c.getTestConfiguration(queue).PollingTime.Duration != 0 {
-			pollingTime = c.getTestConfiguration(queue).PollingTime
+		if testConfig.PollingTime.Duration != 0 {
+			pollingTime = testConfig.PollingTime
 		}
 		time.Sleep(pollingTime.Duration)
 		return testResultUnknown, nil
@@ -0,0 +1,191 @@
+package staging
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+	s2herrors "github.com/agoda-com/samsahai/internal/errors"
+)
+
+// startTestStages runs test stages following the order in test configuration,
+// the next stage will be started after the current stage has finished.
+//
+// If the stage fails and it does not continue on failure, the remaining stages will be skipped (fail-fast).
+func (c *controller) startTestStages(queue *s2hv1.Queue, testConfig *s2hv1.ConfigTestRunner) error {
+	stageStatus := queue.Status.GetCurrentTestStage()
+	if stageStatus == nil {
+		next := len(queue.Status.TestStages)
+		if next >= len(testConfig.Stages) || isTestStagesFailedFast(queue, testConfig.Stages) {
+			return c.finishTestStages(queue, testConfig.Stages)
+		}
+
+		now := metav1.Now()
+		if queue.Status.StartTestingTime == nil {
+			queue.Status.StartTestingTime = &now
+		}
+
+		// test runner status always belongs to the running stage
+		queue.Status.TestRunner = s2hv1.TestRunner{}
+		queue.Status.TestStages = append(queue.Status.TestStages, s2hv1.QueueTestStage{
+			Name:      testConfig.Stages[next].Name,
+			StartedAt: &now,
+		})
+		if err := c.updateQueue(queue); err != nil {
+			return err
+		}
+
+		stageStatus = queue.Status.GetCurrentTestStage()
+	}
+
+	stage, ok := getTestStage(testConfig.Stages, stageStatus.Name)
+	if !ok {
+		return c.finishTestStage(queue, stageStatus, s2hv1.TestStageSkipped,
+			"test stage not found in configuration")
+	}
+
+	stageConfig := testConfig.GetStageTestRunner(stage)
+	stageTimeout := metav1.Duration{Duration: testTimeout}
+	if stageConfig.Timeout.Duration != 0 {
+		stageTimeout = stageConfig.Timeout
+	}
+
+	now := metav1.Now()
+	if stageStatus.StartedAt != nil && now.Sub(stageStatus.StartedAt.Time) > stageTimeout.Duration {
+		logger.Error(s2herrors.ErrTestTimeout, "test stage timeout", "stage", stage.Name)
+		return c.finishTestStage(queue, stageStatus, s2hv1.TestStageTimeout,
+			fmt.Sprintf("test stage %s timeout", stage.Name))
+	}
+
+	testRunners := c.getTestRunners(stageConfig)
+	if len(testRunners) == 0 {
+		logger.Error(s2herrors.ErrTestRunnerNotFound, "test runner not found", "stage", stage.Name)
+		return c.finishTestStage(queue, stageStatus, s2hv1.TestStageFailed, "test runner not found")
+	}
+
+	if stageStatus.TriggeredAt == nil {
+		for _, testRunner := range testRunners {
+			// the test has been triggered before the controller restarted
+			if isTestTriggered(queue, testRunner.GetName()) {
+				continue
+			}
+
+			if err := testRunner.Trigger(stageConfig, c.getCurrentQueue()); err != nil {
+				logger.Error(err, "testing triggered error", "name", testRunner.GetName(), "stage", stage.Name)
+				return err
+			}
+		}
+
+		// test runners might update the queue, the current stage has to be retrieved again
+		stageStatus = queue.Status.GetCurrentTestStage()
+		stageStatus.TriggeredAt = &now
+		stageStatus.TestRunner = queue.Status.TestRunner
+		if !queue.Status.IsConditionTrue(s2hv1.QueueTestTriggered) {
+			queue.Status.SetCondition(s2hv1.QueueTestTriggered, v1.ConditionTrue, "queue testing triggered")
+		}
+
+		if err := c.updateQueue(queue); err != nil {
+			return err
+		}
+	}
+
+	// get result from tests (polling check)
+	finished := true
+	result := s2hv1.TestStagePassed
+	for _, testRunner := range testRunners {
+		testResult, err := c.getTestResult(stageConfig, testRunner)
+		if err != nil {
+			return err
+		}
+
+		switch testResult {
+		case testResultUnknown:
+			finished = false
+		case testResultFailure, testResultSuccess:
+			if testResult == testResultFailure {
+				result = s2hv1.TestStageFailed
+			}
+
+			if err := c.setTestResultCondition(queue, testRunner.GetName(), testResult); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !finished {
+		return nil
+	}
+
+	message := fmt.Sprintf("test stage %s succeeded", stage.Name)
+	if result == s2hv1.TestStageFailed {
+		message = fmt.Sprintf("test stage %s failed", stage.Name)
+	}
+
+	return c.finishTestStage(queue, queue.Status.GetCurrentTestStage(), result, message)
+}
+
+// finishTestStage sets result and condition of the test stage
+func (c *controller) finishTestStage(queue *s2hv1.Queue, stageStatus *s2hv1.QueueTestStage,
+	result s2hv1.TestStageResult, message string) error {
+
+	now := metav1.Now()
+	stageStatus.Result = result
+	stageStatus.Message = message
+	stageStatus.FinishedAt = &now
+	stageStatus.TestRunner = queue.Status.TestRunner
+
+	cond := v1.ConditionTrue
+	if !stageStatus.IsSuccess() {
+		cond = v1.ConditionFalse
+	}
+	queue.Status.SetCondition(s2hv1.GetTestStageConditionType(stageStatus.Name), cond, message)
+
+	return c.updateQueue(queue)
+}
+
+// finishTestStages marks the remaining stages as skipped and updates the testing result of queue
+func (c *controller) finishTestStages(queue *s2hv1.Queue, stages []s2hv1.ConfigTestStage) error {
+	now := metav1.Now()
+	for i := len(queue.Status.TestStages); i < len(stages); i++ {
+		queue.Status.TestStages = append(queue.Status.TestStages, s2hv1.QueueTestStage{
+			Name:       stages[i].Name,
+			Result:     s2hv1.TestStageSkipped,
+			Message:    "skipped due to failure of previous stage",
+			FinishedAt: &now,
+		})
+	}
+
+	if failedStage := queue.Status.GetFailedTestStage(); failedStage != nil {
+		return c.updateTestQueueCondition(queue, v1.ConditionFalse,
+			fmt.Sprintf("queue testing failed, %s", failedStage.Message))
+	}
+
+	return c.updateTestQueueCondition(queue, v1.ConditionTrue, "queue testing succeeded")
+}
+
+// isTestStagesFailedFast returns true if there is a failed stage which does not continue on failure
+func isTestStagesFailedFast(queue *s2hv1.Queue, stages []s2hv1.ConfigTestStage) bool {
+	for _, stageStatus := range queue.Status.TestStages {
+		if !stageStatus.IsFinished() || stageStatus.IsSuccess() || stageStatus.Result == s2hv1.TestStageSkipped {
+			continue
+		}
+
+		if stage, ok := getTestStage(stages, stageStatus.Name); !ok || !stage.ContinueOnFailure {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getTestStage(stages []s2hv1.ConfigTestStage, name string) (s2hv1.ConfigTestStage, bool) {
+	for _, stage := range stages {
+		if stage.Name == name {
+			return stage, true
+		}
+	}
+
+	return s2hv1.ConfigTestStage{}, false
+}
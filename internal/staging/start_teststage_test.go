@@ -0,0 +1,73 @@
+package staging
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	s2hv1 "github.com/agoda-com/samsahai/api/v1"
+)
+
+var _ = Describe("Test stages", func() {
+	g := NewWithT(GinkgoT())
+
+	stages := []s2hv1.ConfigTestStage{
+		{Name: "smoke"},
+		{Name: "regression", ContinueOnFailure: true},
+		{Name: "performance"},
+	}
+
+	It("should not fail fast if all finished stages passed", func() {
+		queue := &s2hv1.Queue{Status: s2hv1.QueueStatus{TestStages: []s2hv1.QueueTestStage{
+			{Name: "smoke", Result: s2hv1.TestStagePassed},
+			{Name: "regression"},
+		}}}
+
+		g.Expect(isTestStagesFailedFast(queue, stages)).To(BeFalse())
+		g.Expect(queue.Status.GetCurrentTestStage().Name).To(Equal("regression"))
+		g.Expect(queue.Status.GetFailedTestStage()).To(BeNil())
+	})
+
+	It("should fail fast if the failed stage does not continue on failure", func() {
+		queue := &s2hv1.Queue{Status: s2hv1.QueueStatus{TestStages: []s2hv1.QueueTestStage{
+			{Name: "smoke", Result: s2hv1.TestStageTimeout},
+		}}}
+
+		g.Expect(isTestStagesFailedFast(queue, stages)).To(BeTrue())
+		g.Expect(queue.Status.GetCurrentTestStage()).To(BeNil())
+		g.Expect(queue.Status.GetFailedTestStage().Name).To(Equal("smoke"))
+	})
+
+	It("should run the next stages if the failed stage continues on failure", func() {
+		queue := &s2hv1.Queue{Status: s2hv1.QueueStatus{TestStages: []s2hv1.QueueTestStage{
+			{Name: "smoke", Result: s2hv1.TestStagePassed},
+			{Name: "regression", Result: s2hv1.TestStageFailed},
+		}}}
+
+		g.Expect(isTestStagesFailedFast(queue, stages)).To(BeFalse())
+		g.Expect(queue.Status.GetFailedTestStage().Name).To(Equal("regression"))
+	})
+
+	It("should use test runners of configuration if the stage does not define its own", func() {
+		testConfig := &s2hv1.ConfigTestRunner{
+			Timeout:  metav1.Duration{Duration: 30 * time.Minute},
+			TestMock: &s2hv1.ConfigTestMock{Result: true},
+			Stages:   stages,
+		}
+
+		stageConfig := testConfig.GetStageTestRunner(stages[0])
+		g.Expect(stageConfig.Timeout.Duration).To(Equal(30 * time.Minute))
+		g.Expect(stageConfig.TestMock).NotTo(BeNil())
+
+		stageConfig = testConfig.GetStageTestRunner(s2hv1.ConfigTestStage{
+			Name:     "smoke",
+			Timeout:  metav1.Duration{Duration: 5 * time.Minute},
+			Teamcity: &s2hv1.ConfigTeamcity{BuildTypeID: "smoke"},
+		})
+		g.Expect(stageConfig.Timeout.Duration).To(Equal(5 * time.Minute))
+		g.Expect(stageConfig.TestMock).To(BeNil())
+		g.Expect(stageConfig.Teamcity.BuildTypeID).To(Equal("smoke"))
+	})
+})
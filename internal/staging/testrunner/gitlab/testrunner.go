@@ -128,7 +128,7 @@ func (t *testRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s
 			Token: pipelineTriggerToken,
 			Variables: map[string]string{
 				ParamEnvType:     currentQueue.GetEnvType(),
-				ParamNamespace:   currentQueue.Namespace,
+				ParamNamespace:   currentQueue.GetStagingNamespace(),
 				ParamVersion:     internal.Version,
 				ParamTeam:        teamName,
 				ParamGitCommit:   internal.GitCommit,
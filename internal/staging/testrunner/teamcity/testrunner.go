@@ -147,9 +147,9 @@ func (t *testRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s
 					{Name: ParamEnvType, Value: currentQueue.GetEnvType()},
 					{Name: EnvParamEnvType, Value: currentQueue.GetEnvType()},
 					{Name: ParamReverseEnvType, Value: currentQueue.GetEnvType()},
-					{Name: ParamNamespace, Value: currentQueue.Namespace},
-					{Name: EnvParamNamespace, Value: currentQueue.Namespace},
-					{Name: ParamReverseNamespace, Value: currentQueue.Namespace},
+					{Name: ParamNamespace, Value: currentQueue.GetStagingNamespace()},
+					{Name: EnvParamNamespace, Value: currentQueue.GetStagingNamespace()},
+					{Name: ParamReverseNamespace, Value: currentQueue.GetStagingNamespace()},
 					{Name: ParamVersion, Value: internal.Version},
 					{Name: EnvParamVersion, Value: internal.Version},
 					{Name: ParamReverseVersion, Value: internal.Version},
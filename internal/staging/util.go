@@ -13,6 +13,7 @@ import (
 
 	s2hv1 "github.com/agoda-com/samsahai/api/v1"
 	"github.com/agoda-com/samsahai/internal"
+	"github.com/agoda-com/samsahai/internal/queue"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
 	"github.com/agoda-com/samsahai/pkg/samsahai/rpc"
 )
@@ -114,6 +115,12 @@ func (c *controller) deleteQueue(q *s2hv1.Queue) error {
 			logger.Error(err, "deleting queue error")
 			return err
 		}
+	} else if q.IsBatchQueue() {
+		// verify each half of the batch separately for finding the failure components
+		if err := queue.BisectBatchQueue(c.client, q); err != nil {
+			logger.Error(err, "cannot bisect batch queue")
+			return err
+		}
 	} else if isReverify {
 		// reverify
 		// TODO: fix me, 24 hours hard-code
@@ -192,7 +199,7 @@ func (c *controller) updateQueueHistory(q *s2hv1.Queue) error {
 
 	qHistName := q.Status.QueueHistoryName
 	fetched := &s2hv1.QueueHistory{}
-	err := c.client.Get(ctx, types.NamespacedName{Name: qHistName, Namespace: c.namespace}, fetched)
+	err := c.client.Get(ctx, types.NamespacedName{Name: qHistName, Namespace: c.queueNamespace}, fetched)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			logger.Warnf("queuehistory %s not found, creating", qHistName)
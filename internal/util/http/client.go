@@ -20,6 +20,8 @@ type Client struct {
 	req      *http.Request
 	username string
 	password string
+
+	respHeader *http.Header
 }
 
 type Option func(client *Client)
@@ -65,6 +67,13 @@ func WithBasicAuth(username, password string) Option {
 	}
 }
 
+// WithResponseHeader stores headers of the response into the given header
+func WithResponseHeader(header *http.Header) Option {
+	return func(c *Client) {
+		c.respHeader = header
+	}
+}
+
 // NewClient creates http client
 func NewClient(baseURL string, opts ...Option) *Client {
 	var err error
@@ -225,6 +234,10 @@ func (c *Client) request(req *http.Request) (int, []byte, error) {
 	}
 	defer resp.Body.Close()
 
+	if c.respHeader != nil {
+		*c.respHeader = resp.Header
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return resp.StatusCode, nil, err
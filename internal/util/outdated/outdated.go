@@ -1,6 +1,7 @@
 package outdated
 
 import (
+	"sort"
 	"strings"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 
 var logger = s2hlog.S2HLog.WithName("Outdated-util")
 
+const holidayLayout = "2006-01-02"
+
 type Outdated struct {
 	cfg                   *s2hv1.ConfigSpec
 	desiredCompsImageTime map[string]map[string]s2hv1.DesiredImageTime
@@ -38,73 +41,179 @@ func (o Outdated) SetOutdatedDuration(atpCompStatus *s2hv1.ActivePromotionStatus
 	}
 
 	for _, activeComp := range o.currentActiveComps {
-		stableCompSpec := activeComp.Spec
-		stableName := stableCompSpec.Name
-		stableImage := stringutils.ConcatImageString(stableCompSpec.Repository, stableCompSpec.Version)
-		desiredCompImageCreatedTime := o.desiredCompsImageTime[stableName]
-		if len(desiredCompImageCreatedTime) == 0 {
-			logger.Info("no desired component created time list")
+		stableName := activeComp.Spec.Name
+		outdatedComp, ok := o.getActiveOutdatedComponent(activeComp.Spec)
+		if !ok {
 			continue
 		}
 
-		descCreatedTime := s2hv1.SortByCreatedTimeDESC(desiredCompImageCreatedTime)
-		latestDesiredImage := descCreatedTime[0].Image
-		latestDesiredImageTime := descCreatedTime[0].ImageTime
-		if strings.EqualFold(latestDesiredImage, stableImage) {
-			outdatedComp := getOutdatedComponent(stableCompSpec, latestDesiredImageTime, 0)
-			atpCompStatus.OutdatedComponents[stableName] = outdatedComp
+		if outdatedComp.OutdatedDuration > 0 {
+			if o.isExceedOutdatedDuration(stableName, outdatedComp.OutdatedDuration) {
+				atpCompStatus.HasOutdatedComponent = true
+			} else {
+				outdatedComp.OutdatedDuration = 0
+			}
+		}
+
+		atpCompStatus.OutdatedComponents[stableName] = outdatedComp
+	}
+}
+
+// GetOutdatedDurations returns outdated durations of active components,
+// components which have no desired versions are ignored
+func (o Outdated) GetOutdatedDurations() map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for _, activeComp := range o.currentActiveComps {
+		outdatedComp, ok := o.getActiveOutdatedComponent(activeComp.Spec)
+		if !ok {
 			continue
 		}
 
-		for i := 1; i < len(descCreatedTime); i++ {
-			if !strings.EqualFold(descCreatedTime[i].Image, stableImage) {
-				continue
-			}
+		durations[activeComp.Spec.Name] = outdatedComp.OutdatedDuration
+	}
 
-			nextAtpStableDesiredTime := descCreatedTime[i-1].ImageTime.CreatedTime
-			lastAtpStableDesiredTime := nextAtpStableDesiredTime.Add(-1 * time.Minute)
-			outdatedDuration := o.calculateOutdatedDuration(lastAtpStableDesiredTime)
-			if o.isExceedOutdatedDuration(outdatedDuration) {
-				atpCompStatus.HasOutdatedComponent = true
-			} else {
-				outdatedDuration = 0
-			}
+	return durations
+}
+
+// GetExceededMaxOutdatedComponents returns sorted names of active components
+// which are outdated longer than their maximum outdated duration
+func (o Outdated) GetExceededMaxOutdatedComponents() []string {
+	return o.getComponentsOutdatedLongerThan(func(policy componentPolicy) time.Duration {
+		return policy.maxOutdatedDuration
+	})
+}
 
-			outdatedComp := getOutdatedComponent(activeComp.Spec, latestDesiredImageTime, outdatedDuration)
-			atpCompStatus.OutdatedComponents[stableName] = outdatedComp
+// GetWarningComponents returns sorted names of active components
+// which are outdated longer than their warning duration
+func (o Outdated) GetWarningComponents() []string {
+	return o.getComponentsOutdatedLongerThan(func(policy componentPolicy) time.Duration {
+		return policy.warningDuration
+	})
+}
+
+func (o Outdated) getComponentsOutdatedLongerThan(getThreshold func(policy componentPolicy) time.Duration) []string {
+	comps := make([]string, 0)
+	for compName, outdatedDuration := range o.GetOutdatedDurations() {
+		threshold := getThreshold(o.getComponentPolicy(compName))
+		// zero threshold means the policy is disabled
+		if threshold > 0 && outdatedDuration > threshold {
+			comps = append(comps, compName)
 		}
 	}
+
+	sort.Strings(comps)
+	return comps
 }
 
-func (o Outdated) isExceedOutdatedDuration(outdatedDuration time.Duration) bool {
+// getActiveOutdatedComponent returns outdated component of the active component comparing with its desired versions,
+// false will be returned if the active version is not found in the desired versions
+func (o Outdated) getActiveOutdatedComponent(stableCompSpec s2hv1.StableComponentSpec) (s2hv1.OutdatedComponent, bool) {
+	stableName := stableCompSpec.Name
+	stableImage := stringutils.ConcatImageString(stableCompSpec.Repository, stableCompSpec.Version)
+	desiredCompImageCreatedTime := o.desiredCompsImageTime[stableName]
+	if len(desiredCompImageCreatedTime) == 0 {
+		logger.Debug("no desired component created time list", "component", stableName)
+		return s2hv1.OutdatedComponent{}, false
+	}
+
+	descCreatedTime := s2hv1.SortByCreatedTimeDESC(desiredCompImageCreatedTime)
+	latestDesiredImage := descCreatedTime[0].Image
+	latestDesiredImageTime := descCreatedTime[0].ImageTime
+	if strings.EqualFold(latestDesiredImage, stableImage) {
+		return getOutdatedComponent(stableCompSpec, latestDesiredImageTime, 0), true
+	}
+
+	found := false
+	outdatedComp := s2hv1.OutdatedComponent{}
+	for i := 1; i < len(descCreatedTime); i++ {
+		if !strings.EqualFold(descCreatedTime[i].Image, stableImage) {
+			continue
+		}
+
+		nextAtpStableDesiredTime := descCreatedTime[i-1].ImageTime.CreatedTime
+		lastAtpStableDesiredTime := nextAtpStableDesiredTime.Add(-1 * time.Minute)
+		outdatedDuration := o.calculateOutdatedDuration(lastAtpStableDesiredTime)
+		outdatedComp = getOutdatedComponent(stableCompSpec, latestDesiredImageTime, outdatedDuration)
+		found = true
+	}
+
+	return outdatedComp, found
+}
+
+// componentPolicy represents outdated policies of a component after applying the component overrides
+type componentPolicy struct {
+	exceedDuration      time.Duration
+	maxOutdatedDuration time.Duration
+	warningDuration     time.Duration
+}
+
+func (o Outdated) getComponentPolicy(compName string) componentPolicy {
+	if o.cfg == nil || o.cfg.ActivePromotion == nil || o.cfg.ActivePromotion.OutdatedNotification == nil {
+		return componentPolicy{}
+	}
+
+	notiCfg := o.cfg.ActivePromotion.OutdatedNotification
+	policy := componentPolicy{
+		exceedDuration:      notiCfg.ExceedDuration.Duration,
+		maxOutdatedDuration: notiCfg.MaxOutdatedDuration.Duration,
+		warningDuration:     notiCfg.WarningDuration.Duration,
+	}
+
+	compPolicy, ok := notiCfg.Components[compName]
+	if !ok {
+		return policy
+	}
+
+	if compPolicy.ExceedDuration != nil {
+		policy.exceedDuration = compPolicy.ExceedDuration.Duration
+	}
+	if compPolicy.MaxOutdatedDuration != nil {
+		policy.maxOutdatedDuration = compPolicy.MaxOutdatedDuration.Duration
+	}
+	if compPolicy.WarningDuration != nil {
+		policy.warningDuration = compPolicy.WarningDuration.Duration
+	}
+
+	return policy
+}
+
+func (o Outdated) isExceedOutdatedDuration(compName string, outdatedDuration time.Duration) bool {
 	atpCfg := o.cfg.ActivePromotion
 	if atpCfg == nil || atpCfg.OutdatedNotification == nil {
 		return false
 	}
 
-	exceedDurationCfg := atpCfg.OutdatedNotification.ExceedDuration.Duration
-	return outdatedDuration > exceedDurationCfg
+	return outdatedDuration > o.getComponentPolicy(compName).exceedDuration
 }
 
 func (o Outdated) calculateOutdatedDuration(atpStableDesiredTime time.Time) time.Duration {
 	totalOutdatedDuration := o.nowTime.Sub(atpStableDesiredTime)
-	totalWeekendDuration := o.getWeekendDuration(atpStableDesiredTime)
-	totalOutdatedDuration = totalOutdatedDuration - totalWeekendDuration
+	totalExcludedDuration := o.getExcludedDuration(atpStableDesiredTime)
+	totalOutdatedDuration = totalOutdatedDuration - totalExcludedDuration
 	return totalOutdatedDuration.Round(time.Minute)
 }
 
-func (o Outdated) getWeekendDuration(atpStableDesiredTime time.Time) time.Duration {
+// getExcludedDuration returns duration of weekends and holidays between the given time and now
+func (o Outdated) getExcludedDuration(atpStableDesiredTime time.Time) time.Duration {
 	atpCfg := o.cfg.ActivePromotion
-	if atpCfg == nil || atpCfg.OutdatedNotification == nil || !atpCfg.OutdatedNotification.ExcludeWeekendCalculation {
+	if atpCfg == nil || atpCfg.OutdatedNotification == nil {
+		return time.Duration(0)
+	}
+
+	excludeWeekend := atpCfg.OutdatedNotification.ExcludeWeekendCalculation
+	holidays := getHolidays(atpCfg.OutdatedNotification.Holidays)
+	if !excludeWeekend && len(holidays) == 0 {
 		return time.Duration(0)
 	}
 
 	fromTime := atpStableDesiredTime
 	toTime := o.nowTime
 	toTimeEndOfDay := time.Date(toTime.Year(), toTime.Month(), toTime.Day(), 0, 0, 0, 0, time.UTC).Add(24 * time.Hour)
-	totalWeekendDuration := time.Duration(0)
+	totalExcludedDuration := time.Duration(0)
 	for fromTime.Before(toTimeEndOfDay) {
-		if fromTime.Weekday() == time.Sunday || fromTime.Weekday() == time.Saturday {
+		isWeekend := fromTime.Weekday() == time.Sunday || fromTime.Weekday() == time.Saturday
+		_, isHoliday := holidays[fromTime.Format(holidayLayout)]
+		if (excludeWeekend && isWeekend) || isHoliday {
 			year, month, day := fromTime.Date()
 			beginningOfDay := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 			endOfDay := beginningOfDay.Add(24 * time.Hour)
@@ -117,13 +226,28 @@ func (o Outdated) getWeekendDuration(atpStableDesiredTime time.Time) time.Durati
 				endOfDay = toTime
 			}
 
-			totalWeekendDuration += endOfDay.Sub(beginningOfDay)
+			totalExcludedDuration += endOfDay.Sub(beginningOfDay)
 		}
 
 		fromTime = fromTime.Add(24 * time.Hour)
 	}
 
-	return totalWeekendDuration
+	return totalExcludedDuration
+}
+
+// getHolidays returns a set of valid holiday dates, invalid dates are ignored
+func getHolidays(dates []string) map[string]struct{} {
+	holidays := make(map[string]struct{})
+	for _, date := range dates {
+		if _, err := time.Parse(holidayLayout, date); err != nil {
+			logger.Warn("invalid holiday date, it will be ignored", "date", date)
+			continue
+		}
+
+		holidays[date] = struct{}{}
+	}
+
+	return holidays
 }
 
 func getOutdatedComponent(
@@ -578,6 +578,123 @@ var _ = Describe("calculate outdated duration without weekend duration", func()
 	})
 })
 
+var _ = Describe("outdated policies of components", func() {
+	g := NewGomegaWithT(GinkgoT())
+	var comp1, repoComp1 = "comp1", "repo/comp1"
+	var comp2, repoComp2 = "comp2", "repo/comp2"
+	var v110, v113 = "1.1.0", "1.1.3"
+	nowMockTime := time.Date(2019, 10, 3, 9, 0, 0, 0, time.UTC)
+
+	newDesiredImageTime := func(repo, tag string, createdTime time.Time) s2hv1.DesiredImageTime {
+		return s2hv1.DesiredImageTime{
+			Image:       &s2hv1.Image{Repository: repo, Tag: tag},
+			CreatedTime: metav1.Time{Time: createdTime},
+		}
+	}
+	desiredComps := map[string]map[string]s2hv1.DesiredImageTime{
+		comp1: {
+			stringutils.ConcatImageString(repoComp1, v110): newDesiredImageTime(repoComp1, v110,
+				time.Date(2019, 10, 1, 2, 0, 0, 0, time.UTC)),
+			stringutils.ConcatImageString(repoComp1, v113): newDesiredImageTime(repoComp1, v113,
+				time.Date(2019, 10, 3, 2, 0, 0, 0, time.UTC)),
+		},
+		comp2: {
+			stringutils.ConcatImageString(repoComp2, v110): newDesiredImageTime(repoComp2, v110,
+				time.Date(2019, 10, 2, 2, 0, 0, 0, time.UTC)),
+			stringutils.ConcatImageString(repoComp2, v113): newDesiredImageTime(repoComp2, v113,
+				time.Date(2019, 10, 3, 6, 0, 0, 0, time.UTC)),
+		},
+	}
+	stableComps := map[string]s2hv1.StableComponent{
+		comp1: {Spec: s2hv1.StableComponentSpec{Name: comp1, Repository: repoComp1, Version: v110}},
+		comp2: {Spec: s2hv1.StableComponentSpec{Name: comp2, Repository: repoComp2, Version: v110}},
+	}
+
+	It("should return outdated durations of active components", func() {
+		oMock := newMock(&s2hv1.ConfigSpec{}, desiredComps, stableComps, nowMockTime)
+		g.Expect(oMock.GetOutdatedDurations()).To(Equal(map[string]time.Duration{
+			comp1: 7*time.Hour + time.Minute,
+			comp2: 3*time.Hour + time.Minute,
+		}))
+	})
+
+	It("should override exceed duration by component policy", func() {
+		cfg := &s2hv1.ConfigSpec{
+			ActivePromotion: &s2hv1.ConfigActivePromotion{
+				OutdatedNotification: &s2hv1.OutdatedNotification{
+					ExceedDuration: metav1.Duration{Duration: 24 * time.Hour},
+					Components: map[string]s2hv1.OutdatedComponentPolicy{
+						comp1: {ExceedDuration: &metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+		}
+		atpRpt := &s2hv1.ActivePromotionStatus{}
+		oMock := newMock(cfg, desiredComps, stableComps, nowMockTime)
+		oMock.SetOutdatedDuration(atpRpt)
+		g.Expect(atpRpt.HasOutdatedComponent).To(BeTrue(), "should have outdated components")
+		g.Expect(atpRpt.OutdatedComponents[comp1].OutdatedDuration).To(Equal(7*time.Hour + time.Minute))
+		g.Expect(atpRpt.OutdatedComponents[comp2].OutdatedDuration).To(Equal(time.Duration(0)))
+	})
+
+	It("should return components exceeding maximum outdated and warning durations", func() {
+		cfg := &s2hv1.ConfigSpec{
+			ActivePromotion: &s2hv1.ConfigActivePromotion{
+				OutdatedNotification: &s2hv1.OutdatedNotification{
+					MaxOutdatedDuration: metav1.Duration{Duration: 24 * time.Hour},
+					WarningDuration:     metav1.Duration{Duration: 2 * time.Hour},
+					Components: map[string]s2hv1.OutdatedComponentPolicy{
+						comp1: {MaxOutdatedDuration: &metav1.Duration{Duration: 6 * time.Hour}},
+						comp2: {WarningDuration: &metav1.Duration{Duration: 0}},
+					},
+				},
+			},
+		}
+		oMock := newMock(cfg, desiredComps, stableComps, nowMockTime)
+		g.Expect(oMock.GetExceededMaxOutdatedComponents()).To(Equal([]string{comp1}))
+		g.Expect(oMock.GetWarningComponents()).To(Equal([]string{comp1}))
+	})
+
+	It("should not return any component if policies are not set", func() {
+		oMock := newMock(&s2hv1.ConfigSpec{}, desiredComps, stableComps, nowMockTime)
+		g.Expect(oMock.GetExceededMaxOutdatedComponents()).To(BeEmpty())
+		g.Expect(oMock.GetWarningComponents()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("calculate outdated duration without holiday duration", func() {
+	g := NewGomegaWithT(GinkgoT())
+	desiredComps := make(map[string]map[string]s2hv1.DesiredImageTime)
+	var stableComps map[string]s2hv1.StableComponent
+	nowMockTime := time.Date(2019, 10, 7, 9, 0, 0, 0, time.UTC)
+	atpStableDesiredTime := time.Date(2019, 10, 3, 9, 0, 0, 0, time.UTC)
+
+	It("should exclude holidays from outdated duration", func() {
+		cfg := &s2hv1.ConfigSpec{
+			ActivePromotion: &s2hv1.ConfigActivePromotion{
+				OutdatedNotification: &s2hv1.OutdatedNotification{
+					Holidays: []string{"2019-10-04", "invalid"},
+				},
+			},
+		}
+		oMock := newMock(cfg, desiredComps, stableComps, nowMockTime)
+		g.Expect(oMock.calculateOutdatedDuration(atpStableDesiredTime)).To(Equal(72 * time.Hour))
+	})
+
+	It("should exclude both weekends and holidays from outdated duration", func() {
+		cfg := &s2hv1.ConfigSpec{
+			ActivePromotion: &s2hv1.ConfigActivePromotion{
+				OutdatedNotification: &s2hv1.OutdatedNotification{
+					ExcludeWeekendCalculation: true,
+					Holidays:                  []string{"2019-10-04", "2019-10-05"},
+				},
+			},
+		}
+		oMock := newMock(cfg, desiredComps, stableComps, nowMockTime)
+		g.Expect(oMock.calculateOutdatedDuration(atpStableDesiredTime)).To(Equal(24 * time.Hour))
+	})
+})
+
 func newMock(cfg *s2hv1.ConfigSpec,
 	desiredComps map[string]map[string]s2hv1.DesiredImageTime,
 	lastActiveComps map[string]s2hv1.StableComponent, nowMockTime time.Time) *Outdated {
@@ -7,12 +7,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"sync"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/tidwall/gjson"
+	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -1697,9 +1699,10 @@ var _ = Describe("[e2e] Main controller", func() {
 		Expect(atpRes.Status.PreviousActiveNamespace).To(BeEmpty())
 	}, 75)
 
-	It("should successfully create cronjob", func(done Done) {
+	It("should successfully poll scheduled component and remove legacy cronjob", func(done Done) {
 		defer close(done)
 		setupSamsahai(true)
+		go samsahaiCtrl.Start(chStop)
 
 		By("Creating Config that have Scheduler")
 		configRedis := mockConfigOnlyRedis
@@ -1727,32 +1730,60 @@ var _ = Describe("[e2e] Main controller", func() {
 		})
 		Expect(err).NotTo(HaveOccurred(), "Verify namespace and config error")
 
-		By("Verifying CronJob have been created")
-		err = wait.PollImmediate(verifyTime1s, verifyTime10s, func() (ok bool, err error) {
-			cronjobList := &batchv1beta1.CronJobList{}
-			cronjobLabel := labels.SelectorFromSet(map[string]string{"component": configRedis.Spec.Components[0].Name})
-			listOption := &rclient.ListOptions{Namespace: stgNamespace, LabelSelector: cronjobLabel}
-			if err := client.List(ctx, cronjobList, listOption); err != nil {
-				return false, err
+		By("Verifying next poll time has been set to Team status")
+		err = wait.PollImmediate(verifyTime1s, verifyTime45s, func() (ok bool, err error) {
+			teamComp := s2hv1.Team{}
+			if err := client.Get(ctx, types.NamespacedName{Name: team.Name}, &teamComp); err != nil {
+				return false, nil
 			}
 
-			if len(cronjobList.Items) == 0 || len(cronjobList.Items) != len(configRedis.Spec.Components[0].Schedules) {
+			polling, ok := teamComp.Status.ComponentVersionPolling[configRedis.Spec.Components[0].Name]
+			if !ok || polling.NextPollAt == nil {
 				return false, nil
 			}
 			return true, nil
 		})
-		Expect(err).NotTo(HaveOccurred(), "Verify creating CronJob error")
+		Expect(err).NotTo(HaveOccurred(), "Verify component version polling error")
+
+		By("Creating CronJob from previous version")
+		compName := configRedis.Spec.Components[0].Name
+		cronJobLabels := internal.GetDefaultLabels(team.Name)
+		cronJobLabels["cronjob-name"] = compName + "-checker-0x4xxx"
+		cronJobLabels["component"] = compName
+		cronJob := batchv1beta1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      compName + "-checker-0x4xxx",
+				Namespace: stgNamespace,
+				Labels:    cronJobLabels,
+			},
+			Spec: batchv1beta1.CronJobSpec{
+				Schedule: "0 4 * * *",
+				JobTemplate: batchv1beta1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{Name: "component-checker", Image: "quay.io/samsahai/curl:latest"},
+								},
+								RestartPolicy: "OnFailure",
+							},
+						},
+					},
+				},
+			},
+		}
+		Expect(client.Create(ctx, &cronJob)).To(BeNil())
 
-		By("Updating Config that have no Scheduler")
+		By("Updating Config")
 		configRedis = s2hv1.Config{}
 		_ = client.Get(ctx, types.NamespacedName{Name: teamName}, &configRedis)
-		configRedis.Spec.Components[0].Schedules = []string{}
+		configRedis.Spec.Components[0].Schedules = []string{"0 5 * * *"}
 		Expect(client.Update(ctx, &configRedis)).To(BeNil())
 
 		By("Verifying CronJob should be deleted")
 		err = wait.PollImmediate(verifyTime1s, verifyTime10s, func() (ok bool, err error) {
 			cronjobList := &batchv1beta1.CronJobList{}
-			cronjobLabel := labels.SelectorFromSet(map[string]string{"component": configRedis.Spec.Components[0].Name})
+			cronjobLabel := labels.SelectorFromSet(map[string]string{"component": compName})
 			listOption := &rclient.ListOptions{Namespace: stgNamespace, LabelSelector: cronjobLabel}
 			if err := client.List(ctx, cronjobList, listOption); err != nil {
 				return false, nil
@@ -1764,7 +1795,7 @@ var _ = Describe("[e2e] Main controller", func() {
 			return true, nil
 		})
 		Expect(err).NotTo(HaveOccurred(), "CronJob should be deleted")
-	}, 90)
+	}, 120)
 
 	It("should successfully apply/update team template", func(done Done) {
 		defer close(done)
@@ -1796,7 +1827,7 @@ var _ = Describe("[e2e] Main controller", func() {
 			if err = client.Get(ctx, types.NamespacedName{Name: mockTeam2.Name}, &teamUsingTemplate); err != nil {
 				return false, nil
 			}
-			if teamUsingTemplate.Status.Used.Credential == team.Status.Used.Credential ||
+			if reflect.DeepEqual(teamUsingTemplate.Status.Used.Credential, team.Status.Used.Credential) ||
 				teamUsingTemplate.Status.Used.StagingCtrl == team.Status.Used.StagingCtrl ||
 				len(teamUsingTemplate.Status.Used.Owners) == len(team.Status.Used.Owners) {
 				return true, nil
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -33,6 +34,8 @@ import (
 	"github.com/agoda-com/samsahai/internal/samsahai"
 	"github.com/agoda-com/samsahai/internal/staging"
 	"github.com/agoda-com/samsahai/internal/staging/deploy/helm3"
+	"github.com/agoda-com/samsahai/internal/staging/deploy/mock"
+	"github.com/agoda-com/samsahai/internal/staging/testrunner/teamcity"
 	httputil "github.com/agoda-com/samsahai/internal/util/http"
 	samsahairpc "github.com/agoda-com/samsahai/pkg/samsahai/rpc"
 )
@@ -565,7 +568,7 @@ var _ = Describe("[e2e] Staging controller", func() {
 		redisServiceName := fmt.Sprintf("%s-redis-master", namespace)
 
 		err = wait.PollImmediate(2*time.Second, deployTimeout, func() (ok bool, err error) {
-			queue, err := queue.EnsurePreActiveComponents(client, teamName, namespace, true)
+			queue, err := queue.EnsurePreActiveComponents(client, teamName, namespace, true, nil)
 			if err != nil {
 				logger.Error(err, "cannot ensure pre-active components")
 				return false, nil
@@ -592,7 +595,7 @@ var _ = Describe("[e2e] Staging controller", func() {
 		})
 		Expect(err).NotTo(HaveOccurred(), "Ensure Pre Active error")
 
-		q, err := queue.EnsurePreActiveComponents(client, teamName, namespace, true)
+		q, err := queue.EnsurePreActiveComponents(client, teamName, namespace, true, nil)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(q.IsDeploySuccess()).To(BeTrue())
 		Expect(q.IsTestSuccess()).To(BeTrue())
@@ -797,6 +800,114 @@ var _ = Describe("[e2e] Staging controller", func() {
 		Expect(err).NotTo(HaveOccurred(), "Should have waiting queue")
 	}, 200)
 
+	It("should resume deploying and testing queue after restarting", func(done Done) {
+		defer close(done)
+
+		By("Creating Config")
+		config := *mockConfig.DeepCopy()
+		mockEngine := "mock"
+		config.Status.Used.Staging.Deployment.Engine = &mockEngine
+		config.Status.Used.Staging.Deployment.TestRunner = &s2hv1.ConfigTestRunner{
+			Teamcity: &s2hv1.ConfigTeamcity{BuildTypeID: "build-type"},
+		}
+		Expect(client.Create(ctx, &config)).To(BeNil())
+
+		By("Verifying config has been created")
+		err = wait.PollImmediate(verifyTime1s, verifyTime10s, func() (ok bool, err error) {
+			config := &s2hv1.Config{}
+			err = client.Get(ctx, types.NamespacedName{Name: teamName}, config)
+			if err != nil {
+				return false, nil
+			}
+
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred(), "Verify config error")
+
+		var createCount int32
+		testRunner := &countingTestRunner{name: teamcity.TestRunnerName}
+		stagingCfgCtrl := configctrl.New(mgr)
+		stagingCtrl = staging.NewController(teamName, namespace, "", nil, mgr, queueCtrl,
+			stagingCfgCtrl, "", "", "", "", "", internal.StagingConfig{})
+		stagingCtrl.LoadTestRunner(testRunner)
+		stagingCtrl.LoadDeployEngine(mock.NewWithCallback(
+			func(refName string, comp *s2hv1.Component, parentComp *s2hv1.Component,
+				values map[string]interface{}, deployTimeout *time.Duration) {
+				atomic.AddInt32(&createCount, 1)
+			}, nil))
+
+		By("Creating queue which was being deployed before restarting")
+		redisVersion := "5.0.5-debian-9-r160"
+		deployingQueue := queue.NewQueue(teamName, namespace, redisCompName, "",
+			s2hv1.QueueComponents{{Name: redisCompName, Repository: "bitnami/redis", Version: redisVersion}},
+			s2hv1.QueueTypeUpgrade,
+		)
+		now := metav1.Now()
+		deployingQueue.Spec.NoOfOrder = 1
+		deployingQueue.Status = s2hv1.QueueStatus{
+			State:            s2hv1.Creating,
+			CreatedAt:        &now,
+			NoOfProcessed:    1,
+			DeployEngine:     mockEngine,
+			StagingNamespace: namespace,
+			QueueHistoryName: "redis-resume-deploying",
+		}
+		deployingQueue.Status.SetCondition(s2hv1.QueueDeployStarted, corev1.ConditionUnknown,
+			"queue is being deployed")
+		Expect(client.Create(ctx, deployingQueue)).To(BeNil())
+
+		go stagingCtrl.Start(chStop)
+
+		By("Verifying releases are checked instead of being redeployed")
+		err = wait.PollImmediate(verifyTime1s, verifyTime30s, func() (ok bool, err error) {
+			stableComp := &s2hv1.StableComponent{}
+			err = client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: redisCompName}, stableComp)
+			if err != nil || stableComp.Spec.Version != redisVersion {
+				return false, nil
+			}
+
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred(), "Resume deploying error")
+		Expect(atomic.LoadInt32(&createCount)).To(BeZero())
+		Expect(atomic.LoadInt32(&testRunner.triggered)).To(Equal(int32(1)))
+
+		By("Creating queue which was being tested before restarting")
+		redisVersion = "5.0.5-debian-9-r161"
+		testingQueue := queue.NewQueue(teamName, namespace, redisCompName, "",
+			s2hv1.QueueComponents{{Name: redisCompName, Repository: "bitnami/redis", Version: redisVersion}},
+			s2hv1.QueueTypeUpgrade,
+		)
+		testingQueue.Spec.NoOfOrder = 1
+		testingQueue.Status = s2hv1.QueueStatus{
+			State:            s2hv1.Testing,
+			CreatedAt:        &now,
+			StartTestingTime: &now,
+			NoOfProcessed:    1,
+			DeployEngine:     mockEngine,
+			StagingNamespace: namespace,
+			QueueHistoryName: "redis-resume-testing",
+		}
+		testingQueue.Status.SetCondition(s2hv1.QueueDeployStarted, corev1.ConditionTrue, "queue started to deploy")
+		testingQueue.Status.SetCondition(s2hv1.QueueDeployed, corev1.ConditionTrue, "queue deployment succeeded")
+		testingQueue.Status.TestRunner.Teamcity.SetTeamcity("", "build-id", "build-type", "")
+		Expect(client.Create(ctx, testingQueue)).To(BeNil())
+
+		By("Verifying the triggered build is polled instead of being triggered again")
+		err = wait.PollImmediate(verifyTime1s, verifyTime30s, func() (ok bool, err error) {
+			stableComp := &s2hv1.StableComponent{}
+			err = client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: redisCompName}, stableComp)
+			if err != nil || stableComp.Spec.Version != redisVersion {
+				return false, nil
+			}
+
+			return true, nil
+		})
+		Expect(err).NotTo(HaveOccurred(), "Resume testing error")
+		Expect(atomic.LoadInt32(&testRunner.triggered)).To(Equal(int32(1)))
+		Expect(testRunner.getResultBuildIDs()).To(ContainElement("build-id"))
+	}, 120)
+
 	It("should successfully get health check", func(done Done) {
 		defer close(done)
 
@@ -813,3 +924,37 @@ var _ = Describe("[e2e] Staging controller", func() {
 
 	}, 5)
 })
+
+// countingTestRunner counts triggered builds and always returns a successful result
+type countingTestRunner struct {
+	name      string
+	triggered int32
+
+	mtResult sync.Mutex
+	buildIDs []string
+}
+
+func (r *countingTestRunner) GetName() string {
+	return r.name
+}
+
+func (r *countingTestRunner) Trigger(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) error {
+	atomic.AddInt32(&r.triggered, 1)
+	currentQueue.Status.TestRunner.Teamcity.SetTeamcity("", "new-build-id", "build-type", "")
+	return nil
+}
+
+func (r *countingTestRunner) GetResult(testConfig *s2hv1.ConfigTestRunner, currentQueue *s2hv1.Queue) (
+	isResultSuccess bool, isBuildFinished bool, err error) {
+
+	r.mtResult.Lock()
+	defer r.mtResult.Unlock()
+	r.buildIDs = append(r.buildIDs, currentQueue.Status.TestRunner.Teamcity.BuildID)
+	return true, true, nil
+}
+
+func (r *countingTestRunner) getResultBuildIDs() []string {
+	r.mtResult.Lock()
+	defer r.mtResult.Unlock()
+	return r.buildIDs
+}